@@ -60,6 +60,35 @@ func main() {
 		),
 	)
 	rootContainer.AddChild(innerContainer2)
+
+	hudPanel := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(color.NRGBA{255, 255, 0, 255})),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				HorizontalPosition: widget.AnchorLayoutPositionEnd,
+				//Size the panel to 30% of the width, keeping it twice as wide as it is tall
+				WidthPercent: 30,
+				AspectRatio:  2,
+			}),
+		),
+	)
+	rootContainer.AddChild(hudPanel)
+
+	hudIcon := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(color.NRGBA{0, 255, 0, 255})),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				VerticalPosition: widget.AnchorLayoutPositionCenter,
+				//Place the icon to the left of the panel with an 8px gap, centered on it vertically
+				Sibling:     hudPanel,
+				SiblingSide: widget.AnchorLayoutSideLeft,
+				SiblingGap:  8,
+			}),
+			widget.WidgetOpts.MinSize(20, 20),
+		),
+	)
+	rootContainer.AddChild(hudIcon)
+
 	// construct the UI
 	ui := ebitenui.UI{
 		Container: rootContainer,
@@ -8,11 +8,9 @@ import (
 
 	"github.com/ebitenui/ebitenui"
 	"github.com/ebitenui/ebitenui/image"
-	"github.com/ebitenui/ebitenui/input"
 	"github.com/ebitenui/ebitenui/utilities/mobile"
 	"github.com/ebitenui/ebitenui/widget"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 	"golang.design/x/clipboard"
 	"golang.org/x/image/font/gofont/goregular"
@@ -80,6 +78,10 @@ func main() {
 			DisabledCaret: color.NRGBA{R: 200, G: 200, B: 200, A: 255},
 		}),
 
+		// Set the clipboard used for Ctrl+C, Ctrl+X and Ctrl+V. If this is not set, the input
+		// uses widget.DefaultClipboard, which only keeps the text within the game.
+		widget.TextInputOpts.Clipboard(systemClipboard{}),
+
 		// Set how much padding there is between the edge of the input and the text.
 		widget.TextInputOpts.Padding(widget.NewInsetsSimple(5)),
 
@@ -126,42 +128,21 @@ func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
 
 // Update implements Game.
 func (g *game) Update() error {
-	// Select all
-	if input.KeyPressed(ebiten.KeyControlLeft) && inpututil.IsKeyJustPressed(ebiten.KeyA) {
-		g.standardTextInput.SelectAll()
-	}
-
-	g.HandleCCP()
-
+	// The text input handles Ctrl+A, Ctrl+C, Ctrl+X and Ctrl+V by itself.
 	g.ui.Update()
 	return nil
 }
 
-func (g *game) HandleCCP() {
-	// Copy
-	if ebiten.IsKeyPressed(ebiten.KeyControlLeft) && inpututil.IsKeyJustPressed(ebiten.KeyC) {
-		text := g.standardTextInput.SelectedText()
-		if len(text) > 0 {
-			clipboard.Write(clipboard.FmtText, []byte(text))
-		}
-	}
+// systemClipboard implements widget.Clipboard using the operating system clipboard.
+type systemClipboard struct{}
 
-	// Cut
-	if ebiten.IsKeyPressed(ebiten.KeyControlLeft) && inpututil.IsKeyJustPressed(ebiten.KeyX) {
-		text := g.standardTextInput.SelectedText()
-		if len(text) > 0 {
-			clipboard.Write(clipboard.FmtText, []byte(text))
-			g.standardTextInput.DeleteSelectedText()
-		}
-	}
+func (c systemClipboard) ReadText() (string, error) {
+	return string(clipboard.Read(clipboard.FmtText)), nil
+}
 
-	// Paste
-	if ebiten.IsKeyPressed(ebiten.KeyControlLeft) && inpututil.IsKeyJustPressed(ebiten.KeyV) {
-		clipVal := string(clipboard.Read(clipboard.FmtText))
-		if len(clipVal) > 0 {
-			g.standardTextInput.Insert(clipVal)
-		}
-	}
+func (c systemClipboard) WriteText(text string) error {
+	clipboard.Write(clipboard.FmtText, []byte(text))
+	return nil
 }
 
 // Draw implements Ebiten's Draw method.
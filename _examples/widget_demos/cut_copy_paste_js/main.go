@@ -9,16 +9,12 @@ import (
 	"fmt"
 	"image/color"
 	"log"
-	"sync"
-	"syscall/js"
 
 	"github.com/ebitenui/ebitenui"
 	"github.com/ebitenui/ebitenui/image"
-	"github.com/ebitenui/ebitenui/input"
 	"github.com/ebitenui/ebitenui/internal/jsUtil"
 	"github.com/ebitenui/ebitenui/widget"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 	"golang.org/x/image/font/gofont/goregular"
 )
@@ -125,61 +121,12 @@ func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
 
 // Update implements Game.
 func (g *game) Update() error {
-	if input.KeyPressed(ebiten.KeyControlLeft) && inpututil.IsKeyJustPressed(ebiten.KeyA) {
-		g.standardTextInput.SelectAll()
-	}
-	g.HandleCCPJS()
-
+	// The text input handles Ctrl+A, Ctrl+C, Ctrl+X and Ctrl+V by itself. In JS builds
+	// widget.DefaultClipboard uses the browser clipboard.
 	g.ui.Update()
 	return nil
 }
 
-func (g *game) HandleCCPJS() {
-	// Copy
-	if ebiten.IsKeyPressed(ebiten.KeyControlLeft) && inpututil.IsKeyJustPressed(ebiten.KeyC) {
-		text := g.standardTextInput.SelectedText()
-		if len(text) > 0 {
-			js.Global().Get("navigator").Get("clipboard").Call("writeText", text)
-		}
-	}
-
-	// Cut
-	if ebiten.IsKeyPressed(ebiten.KeyControlLeft) && inpututil.IsKeyJustPressed(ebiten.KeyX) {
-		text := g.standardTextInput.SelectedText()
-		if len(text) > 0 {
-			js.Global().Get("navigator").Get("clipboard").Call("writeText", text)
-			g.standardTextInput.DeleteSelectedText()
-		}
-	}
-
-	// Paste
-	if ebiten.IsKeyPressed(ebiten.KeyControlLeft) && inpututil.IsKeyJustPressed(ebiten.KeyV) {
-		var wg sync.WaitGroup
-		wg.Add(1)
-		var result string
-		promise := js.Global().Get("navigator").Get("clipboard").Call("readText").Call("then", js.FuncOf(func(me js.Value, args []js.Value) interface{} {
-			result = args[0].String()
-			wg.Done()
-			return nil
-		}), js.FuncOf(func(me js.Value, args []js.Value) interface{} {
-			wg.Done()
-			return nil
-		}))
-
-		if !promise.Truthy() {
-			return
-		}
-
-		// Wait for promise to resolve
-		wg.Wait()
-
-		if len(result) > 0 {
-			g.standardTextInput.Insert(result)
-		}
-	}
-
-}
-
 // Draw implements Ebiten's Draw method.
 func (g *game) Draw(screen *ebiten.Image) {
 	// draw the UI onto the screen
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"image/color"
+	"log"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Game object used by ebiten
+type game struct {
+	ui *ebitenui.UI
+}
+
+/*
+The Flex Layout distributes the space of a row or column among its children by their grow and shrink factors.
+Resize the window to see the sidebar and content grow 1:3, and the footer items shrink.
+*/
+func main() {
+
+	// construct a new container that serves as the root of the UI hierarchy
+	rootContainer := widget.NewContainer(
+		// the container will use a plain color as its background
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(color.NRGBA{0x13, 0x1a, 0x22, 0xff})),
+		// the container will use a vertical flex layout to layout its children
+		widget.ContainerOpts.Layout(widget.NewFlexLayout(
+			widget.FlexLayoutOpts.Direction(widget.DirectionVertical),
+			//Set how much padding before displaying content
+			widget.FlexLayoutOpts.Padding(widget.NewInsetsSimple(30)),
+			//Set how far apart to space the children
+			widget.FlexLayoutOpts.Gap(10, 0),
+			//Stretch the children over the whole width
+			widget.FlexLayoutOpts.AlignItems(widget.FlexAlignStretch),
+		)),
+	)
+
+	// the header keeps its height
+	rootContainer.AddChild(newBox(color.NRGBA{255, 0, 0, 255}, widget.FlexLayoutData{Basis: 40}))
+
+	// the body takes all the height that is left
+	body := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewFlexLayout(
+			widget.FlexLayoutOpts.Gap(10, 0),
+			widget.FlexLayoutOpts.AlignItems(widget.FlexAlignStretch),
+		)),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.FlexLayoutData{Grow: 1}),
+		),
+	)
+	rootContainer.AddChild(body)
+
+	// the sidebar takes 1 part of the width, but no less than 60 pixels
+	body.AddChild(newBox(color.NRGBA{0, 255, 0, 255}, widget.FlexLayoutData{Grow: 1, Shrink: 1, MinSize: 60}))
+	// the content takes 3 parts of the width
+	body.AddChild(newBox(color.NRGBA{0, 0, 255, 255}, widget.FlexLayoutData{Grow: 3, Shrink: 1}))
+
+	// the footer centers its items, and the first one shrinks before the others
+	footer := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewFlexLayout(
+			widget.FlexLayoutOpts.Gap(10, 0),
+			widget.FlexLayoutOpts.Justify(widget.FlexJustifySpaceBetween),
+			widget.FlexLayoutOpts.AlignItems(widget.FlexAlignCenter),
+		)),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.FlexLayoutData{Basis: 40}),
+		),
+	)
+	rootContainer.AddChild(footer)
+
+	footer.AddChild(newBox(color.NRGBA{255, 255, 0, 255}, widget.FlexLayoutData{Basis: 150, Shrink: 1}))
+	footer.AddChild(newBox(color.NRGBA{255, 255, 0, 255}, widget.FlexLayoutData{Basis: 80}))
+	footer.AddChild(newBox(color.NRGBA{255, 255, 0, 255}, widget.FlexLayoutData{Basis: 80}))
+
+	// construct the UI
+	ui := ebitenui.UI{
+		Container: rootContainer,
+	}
+
+	// Ebiten setup
+	ebiten.SetWindowSize(400, 400)
+	ebiten.SetWindowTitle("Ebiten UI - Flex Layout")
+	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
+
+	game := game{
+		ui: &ui,
+	}
+
+	// run Ebiten main loop
+	err := ebiten.RunGame(&game)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Layout implements Game.
+func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Update implements Game.
+func (g *game) Update() error {
+	// update the UI
+	g.ui.Update()
+	return nil
+}
+
+// Draw implements Ebiten's Draw method.
+func (g *game) Draw(screen *ebiten.Image) {
+	// draw the UI onto the screen
+	g.ui.Draw(screen)
+}
+
+// newBox returns a plain colored container laid out according to ld.
+func newBox(c color.NRGBA, ld widget.FlexLayoutData) *widget.Container {
+	return widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(c)),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(ld),
+			widget.WidgetOpts.MinSize(20, 20),
+		),
+	)
+}
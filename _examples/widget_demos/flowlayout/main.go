@@ -0,0 +1,96 @@
+package main
+
+import (
+	"image/color"
+	"log"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Game object used by ebiten
+type game struct {
+	ui *ebitenui.UI
+}
+
+/*
+The Flow Layout places children from left to right and wraps them onto a new row when the width runs out.
+Resize the window to see the children reflow.
+*/
+func main() {
+
+	// construct a new container that serves as the root of the UI hierarchy
+	rootContainer := widget.NewContainer(
+		// the container will use a plain color as its background
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(color.NRGBA{0x13, 0x1a, 0x22, 0xff})),
+		// the container will use a flow layout to layout its children
+		widget.ContainerOpts.Layout(widget.NewFlowLayout(
+			//Set how much padding before displaying content
+			widget.FlowLayoutOpts.Padding(widget.NewInsetsSimple(30)),
+			//Set how far apart to space the children in a row, and the rows
+			widget.FlowLayoutOpts.Spacing(10, 15),
+			//Spread the children of each full row over the whole width
+			widget.FlowLayoutOpts.Alignment(widget.FlowLayoutAlignmentJustify),
+		)),
+	)
+
+	colors := []color.NRGBA{
+		{255, 0, 0, 255},
+		{0, 255, 0, 255},
+		{0, 0, 255, 255},
+		{255, 255, 0, 255},
+	}
+	for i := 0; i < 24; i++ {
+		innerContainer := widget.NewContainer(
+			widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(colors[i%len(colors)])),
+			widget.ContainerOpts.WidgetOpts(
+				widget.WidgetOpts.LayoutData(widget.FlowLayoutData{
+					//Specify where within the row this element should be positioned vertically.
+					Position: widget.FlowLayoutPositionCenter,
+				}),
+				widget.WidgetOpts.MinSize(40+(i%5)*15, 30+(i%3)*10),
+			),
+		)
+		rootContainer.AddChild(innerContainer)
+	}
+
+	// construct the UI
+	ui := ebitenui.UI{
+		Container: rootContainer,
+	}
+
+	// Ebiten setup
+	ebiten.SetWindowSize(400, 400)
+	ebiten.SetWindowTitle("Ebiten UI - Flow Layout")
+	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
+
+	game := game{
+		ui: &ui,
+	}
+
+	// run Ebiten main loop
+	err := ebiten.RunGame(&game)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Layout implements Game.
+func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Update implements Game.
+func (g *game) Update() error {
+	// update the UI
+	g.ui.Update()
+	return nil
+}
+
+// Draw implements Ebiten's Draw method.
+func (g *game) Draw(screen *ebiten.Image) {
+	// draw the UI onto the screen
+	g.ui.Draw(screen)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/themes"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Game object used by ebiten.
+type game struct {
+	ui *ebitenui.UI
+}
+
+func main() {
+	// Ebiten setup
+	ebiten.SetWindowSize(800, 600)
+	ebiten.SetWindowTitle("Ebiten UI - Touch Gestures")
+
+	// construct a new container that serves as the root of the UI hierarchy
+	rootContainer := widget.NewPanel(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(2),
+			widget.GridLayoutOpts.Stretch([]bool{true, true}, []bool{false, true}),
+			widget.GridLayoutOpts.Padding(widget.NewInsetsSimple(20)),
+			widget.GridLayoutOpts.Spacing(20, 20),
+		)),
+	)
+
+	status := widget.NewText(widget.TextOpts.TextLabel("Touch the square, or drag the list"))
+	rootContainer.AddChild(status, widget.NewContainer())
+
+	// The square reports all gestures. Pinching it scales it, panning it with two fingers moves it.
+	square := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(color.NRGBA{60, 120, 200, 255})),
+	)
+	square.GetWidget().Transform = widget.NewRenderTransform()
+	square.GetWidget().TapEvent.AddHandler(func(_ interface{}) {
+		status.Label = "Tap"
+	})
+	square.GetWidget().TouchLongPressEvent.AddHandler(func(_ interface{}) {
+		status.Label = "Long press"
+	})
+	square.GetWidget().SwipeEvent.AddHandler(func(args interface{}) {
+		a := args.(*widget.WidgetSwipeEventArgs)
+		status.Label = fmt.Sprintf("Swipe by %.0f, %.0f", a.DeltaX, a.DeltaY)
+	})
+	square.GetWidget().PanEvent.AddHandler(func(args interface{}) {
+		if a := args.(*widget.WidgetPanEventArgs); a.Touches == 2 {
+			square.GetWidget().Transform.OffsetX += a.DeltaX
+			square.GetWidget().Transform.OffsetY += a.DeltaY
+		}
+	})
+	square.GetWidget().PinchEvent.AddHandler(func(args interface{}) {
+		a := args.(*widget.WidgetPinchEventArgs)
+		square.GetWidget().Transform.ScaleX *= a.Scale
+		square.GetWidget().Transform.ScaleY *= a.Scale
+		status.Label = fmt.Sprintf("Pinch to %.2f", square.GetWidget().Transform.ScaleX)
+	})
+	rootContainer.AddChild(square)
+
+	// Lists and scroll containers scroll when their content is dragged, and keep moving when flicked.
+	entries := make([]any, 0, 100)
+	for i := 1; i <= 100; i++ {
+		entries = append(entries, fmt.Sprintf("Entry %d", i))
+	}
+	rootContainer.AddChild(widget.NewList(
+		widget.ListOpts.Entries(entries),
+		widget.ListOpts.EntryLabelFunc(func(e any) string {
+			return e.(string)
+		}),
+	))
+
+	// construct the UI
+	ui := ebitenui.UI{
+		Container:    rootContainer,
+		PrimaryTheme: themes.GetBasicDarkTheme(),
+	}
+
+	game := game{
+		ui: &ui,
+	}
+
+	// run Ebiten main loop
+	err := ebiten.RunGame(&game)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Layout implements Game.
+func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Update implements Game.
+func (g *game) Update() error {
+	// update the UI
+	g.ui.Update()
+	return nil
+}
+
+// Draw implements Ebiten's Draw method.
+func (g *game) Draw(screen *ebiten.Image) {
+	// draw the UI onto the screen
+	g.ui.Draw(screen)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/themes"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Game object used by ebiten
+type game struct {
+	ui *ebitenui.UI
+}
+
+/*
+The inspector helps finding out why widgets are laid out the way they are.
+Press F11 to outline all widgets in the color of their layout and hover them to see their details.
+Press F12 to open the inspector window, select a widget in the tree and change its properties.
+*/
+func main() {
+
+	// construct a new container that serves as the root of the UI hierarchy
+	rootContainer := widget.NewPanel(
+		// the container will use an anchor layout to layout its children
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout(
+			widget.AnchorLayoutOpts.Padding(widget.NewInsetsSimple(30)),
+		)),
+	)
+
+	form := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(2),
+			widget.GridLayoutOpts.Spacing(10, 10),
+			widget.GridLayoutOpts.Stretch([]bool{false, true}, nil),
+		)),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.ID("form"),
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				StretchHorizontal: true,
+			}),
+		),
+	)
+	rootContainer.AddChild(form)
+
+	form.AddChild(widget.NewLabel(widget.LabelOpts.LabelText("Name")))
+	form.AddChild(widget.NewTextInput(widget.TextInputOpts.WidgetOpts(widget.WidgetOpts.ID("name"))))
+	form.AddChild(widget.NewLabel(widget.LabelOpts.LabelText("Remember me")))
+	form.AddChild(widget.NewCheckbox(widget.CheckboxOpts.WidgetOpts(widget.WidgetOpts.ID("remember"))))
+
+	buttons := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Spacing(10),
+		)),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				HorizontalPosition: widget.AnchorLayoutPositionEnd,
+				VerticalPosition:   widget.AnchorLayoutPositionEnd,
+			}),
+		),
+	)
+	rootContainer.AddChild(buttons)
+
+	buttons.AddChild(widget.NewButton(
+		widget.ButtonOpts.TextLabel("Cancel"),
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.CustomData("cancel the form")),
+	))
+	buttons.AddChild(widget.NewButton(
+		widget.ButtonOpts.TextLabel("OK"),
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.CustomData("submit the form")),
+	))
+
+	// construct the UI
+	ui := ebitenui.UI{
+		Container:    rootContainer,
+		PrimaryTheme: themes.GetBasicDarkTheme(),
+	}
+
+	// F11 toggles the debug overlay, F12 the inspector window.
+	_, err := ui.AddShortcut(input.KeyChord{Key: ebiten.KeyF11}, func(_ *ebitenui.ShortcutEventArgs) {
+		ui.SetDebugMode(!ui.GetDebugMode())
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	_, err = ui.AddShortcut(input.KeyChord{Key: ebiten.KeyF12}, func(_ *ebitenui.ShortcutEventArgs) {
+		ui.ToggleInspector(nil)
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Ebiten setup
+	ebiten.SetWindowSize(900, 600)
+	ebiten.SetWindowTitle("Ebiten UI - Inspector")
+	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
+
+	game := game{
+		ui: &ui,
+	}
+
+	// run Ebiten main loop
+	err = ebiten.RunGame(&game)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Layout implements Game.
+func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Update implements Game.
+func (g *game) Update() error {
+	// update the UI
+	g.ui.Update()
+	return nil
+}
+
+// Draw implements Ebiten's Draw method.
+func (g *game) Draw(screen *ebiten.Image) {
+	// draw the UI onto the screen
+	g.ui.Draw(screen)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/themes"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Game object used by ebiten.
+type game struct {
+	ui *ebitenui.UI
+}
+
+func main() {
+	// Ebiten setup
+	ebiten.SetWindowSize(800, 500)
+	ebiten.SetWindowTitle("Ebiten UI - Menu")
+
+	// construct a new container that serves as the root of the UI hierarchy
+	rootContainer := widget.NewPanel(
+		// the menu bar is at the top, the rest of the window is filled by the content
+		widget.ContainerOpts.Layout(widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(1),
+			widget.GridLayoutOpts.Stretch([]bool{true}, []bool{false, true}),
+		)),
+	)
+
+	// Submenus are menus that are set as the Submenu of an item.
+	recent := widget.NewMenu(
+		widget.MenuOpts.Items(
+			&widget.MenuItem{Label: "level1.map"},
+			&widget.MenuItem{Label: "level2.map"},
+		),
+	)
+
+	// Key chords can be shown as accelerators and bound as shortcuts of the UI below.
+	newChord := input.MustParseKeyChord("Ctrl+N")
+	openChord := input.MustParseKeyChord("Ctrl+O")
+
+	// construct a menu bar. All visual parameters of the bar and its menus are taken from the theme.
+	menuBar := widget.NewMenuBar(
+		widget.MenuBarOpts.Menu("File", widget.NewMenu(
+			widget.MenuOpts.Items(
+				&widget.MenuItem{Label: "New", Accelerator: newChord.String()},
+				&widget.MenuItem{Label: "Open...", Accelerator: openChord.String()},
+				&widget.MenuItem{Label: "Open Recent", Submenu: recent},
+				&widget.MenuItem{Separator: true},
+				&widget.MenuItem{Label: "Save", Accelerator: "Ctrl+S", Disabled: true},
+				&widget.MenuItem{Separator: true},
+				&widget.MenuItem{Label: "Quit", ActivatedHandler: func(_ *widget.MenuItemActivatedEventArgs) {
+					fmt.Println("Quit selected")
+				}},
+			),
+		)),
+		widget.MenuBarOpts.Menu("View", widget.NewMenu(
+			widget.MenuOpts.Items(
+				&widget.MenuItem{Label: "Show Grid", Checkable: true, Checked: true},
+				&widget.MenuItem{Label: "Show Minimap", Checkable: true},
+			),
+		)),
+
+		// This handler is called for the items of all menus of the bar.
+		widget.MenuBarOpts.ItemActivatedHandler(func(args *widget.MenuItemActivatedEventArgs) {
+			fmt.Println("Item Activated: ", args.Item.Label, args.Item.Checked)
+		}),
+	)
+	rootContainer.AddChild(menuBar)
+
+	// A widget with a popup menu opens it when it is right-clicked.
+	content := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(image.NewNineSliceColor(color.NRGBA{30, 30, 30, 255})),
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout()),
+		widget.ContainerOpts.WidgetOpts(
+			widget.WidgetOpts.PopupMenu(widget.NewMenu(
+				widget.MenuOpts.Items(
+					&widget.MenuItem{Label: "Cut", Accelerator: "Ctrl+X"},
+					&widget.MenuItem{Label: "Copy", Accelerator: "Ctrl+C"},
+					&widget.MenuItem{Label: "Paste", Accelerator: "Ctrl+V"},
+				),
+				widget.MenuOpts.ItemActivatedHandler(func(args *widget.MenuItemActivatedEventArgs) {
+					fmt.Println("Popup Item Activated: ", args.Item.Label)
+				}),
+			)),
+		),
+	)
+	content.AddChild(widget.NewText(
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+			HorizontalPosition: widget.AnchorLayoutPositionCenter,
+			VerticalPosition:   widget.AnchorLayoutPositionCenter,
+		})),
+		widget.TextOpts.TextLabel("Right-click here"),
+	))
+	rootContainer.AddChild(content)
+
+	// construct the UI
+	ui := ebitenui.UI{
+		Container:    rootContainer,
+		PrimaryTheme: themes.GetBasicDarkTheme(),
+	}
+
+	// Shortcuts are global unless they are scoped to a window or a widget with ShortcutOpts.
+	for _, chord := range []input.KeyChord{newChord, openChord} {
+		_, err := ui.AddShortcut(chord, func(args *ebitenui.ShortcutEventArgs) {
+			fmt.Println("Shortcut pressed: ", args.Chord)
+		}, ebitenui.ShortcutOpts.SuppressWhileTyping())
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	game := game{
+		ui: &ui,
+	}
+
+	// run Ebiten main loop
+	err := ebiten.RunGame(&game)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Layout implements Game.
+func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Update implements Game.
+func (g *game) Update() error {
+	// update the UI
+	g.ui.Update()
+	return nil
+}
+
+// Draw implements Ebiten's Draw method.
+func (g *game) Draw(screen *ebiten.Image) {
+	// draw the UI onto the screen
+	g.ui.Draw(screen)
+}
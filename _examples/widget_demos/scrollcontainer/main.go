@@ -6,6 +6,7 @@ import (
 	"image/color"
 	"log"
 	"math"
+	"time"
 
 	"github.com/ebitenui/ebitenui"
 	"github.com/ebitenui/ebitenui/image"
@@ -93,6 +94,11 @@ func main() {
 		widget.ScrollContainerOpts.Content(content),
 		// Tell the container to stretch the content width to match available space
 		widget.ScrollContainerOpts.StretchContentWidth(),
+		// Animate scrolling instead of jumping
+		widget.ScrollContainerOpts.SmoothScroll(150*time.Millisecond),
+		// Let the content be dragged with the mouse, and bounce back when it is dragged past its edges
+		widget.ScrollContainerOpts.DragToScroll(),
+		widget.ScrollContainerOpts.Overscroll(60),
 		// Set the background images for the scrollable container
 		widget.ScrollContainerOpts.Image(&widget.ScrollContainerImage{
 			Idle: image.NewNineSliceColor(color.NRGBA{0x13, 0x1a, 0x22, 0xff}),
@@ -113,6 +119,10 @@ func main() {
 		widget.SliderOpts.PageSizeFunc(pageSizeFunc),
 		// On change update scroll location based on the Slider's value
 		widget.SliderOpts.ChangedHandler(func(args *widget.SliderChangedEventArgs) {
+			// The slider follows the scrollContainer while it animates or is dragged
+			if scrollContainer.Scrolling() && args.Slider.Current == int(math.Round(scrollContainer.ScrollTop*1000)) {
+				return
+			}
 			scrollContainer.ScrollTop = float64(args.Slider.Current) / 1000
 		}),
 		widget.SliderOpts.Images(
@@ -129,10 +139,17 @@ func main() {
 			},
 		),
 	)
-	// Set the slider's position if the scrollContainer is scrolled by other means than the slider
+	// Smoothly scroll the scrollContainer with the mouse wheel, continuing from where it is already headed
 	scrollContainer.GetWidget().ScrolledEvent.AddHandler(func(args interface{}) {
 		if a, ok := args.(*widget.WidgetScrolledEventArgs); ok {
-			vSlider.Current -= int(math.Round(a.Y * float64(pageSizeFunc())))
+			left, top := scrollContainer.ScrollTarget()
+			scrollContainer.ScrollToPosition(left, top-a.Y*float64(pageSizeFunc())/1000, true)
+		}
+	})
+	// Keep the slider in sync when the scrollContainer scrolls by itself, e.g. to bring a focused button into view
+	scrollContainer.ScrollChangedEvent.AddHandler(func(args interface{}) {
+		if a, ok := args.(*widget.ScrollContainerScrollChangedEventArgs); ok {
+			vSlider.Current = int(math.Round(a.ScrollTop * 1000))
 		}
 	})
 
@@ -141,6 +158,8 @@ func main() {
 	// construct the UI
 	ui := ebitenui.UI{
 		Container: rootContainer,
+		// Move focus between the buttons with the arrow keys or a gamepad's D-pad
+		EnableSpatialNavigation: true,
 	}
 
 	// Ebiten setup
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/themes"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Score is the data shown in each row of the table.
+type Score struct {
+	Player string
+	Level  int
+	Points int
+}
+
+// Game object used by ebiten.
+type game struct {
+	ui *ebitenui.UI
+}
+
+func main() {
+	// Ebiten setup
+	ebiten.SetWindowSize(600, 400)
+	ebiten.SetWindowTitle("Ebiten UI - Table")
+
+	entries := []any{
+		&Score{"alice", 12, 5400},
+		&Score{"bob", 9, 3100},
+		&Score{"carol", 15, 7200},
+		&Score{"dave", 3, 800},
+		&Score{"erin", 11, 5100},
+		&Score{"frank", 7, 2600},
+		&Score{"grace", 14, 6900},
+		&Score{"heidi", 5, 1500},
+		&Score{"ivan", 10, 4300},
+		&Score{"judy", 13, 6100},
+	}
+
+	// construct a new container that serves as the root of the UI hierarchy
+	rootContainer := widget.NewPanel(
+		// the container will use an anchor layout to layout its single child widget
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout(
+			widget.AnchorLayoutOpts.Padding(widget.NewInsetsSimple(20)),
+		)),
+	)
+
+	// construct a table. All visual parameters are taken from the theme.
+	table := widget.NewTable(
+		widget.TableOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				StretchHorizontal: true,
+				StretchVertical:   true,
+			}),
+		),
+
+		// Each column has a header, a width, a function that returns the cell text
+		// and optionally a function that sorts the rows by the column.
+		widget.TableOpts.Columns(
+			&widget.TableColumn{
+				Header:  "Player",
+				Width:   150,
+				Stretch: true,
+				CellLabelFunc: func(e any) string {
+					return e.(*Score).Player
+				},
+				SortFunc: func(a, b any) int {
+					return strings.Compare(a.(*Score).Player, b.(*Score).Player)
+				},
+			},
+			&widget.TableColumn{
+				Header: "Level",
+				Width:  100,
+				CellLabelFunc: func(e any) string {
+					return strconv.Itoa(e.(*Score).Level)
+				},
+				SortFunc: func(a, b any) int {
+					return cmp.Compare(a.(*Score).Level, b.(*Score).Level)
+				},
+			},
+			&widget.TableColumn{
+				Header: "Points",
+				Width:  120,
+				CellLabelFunc: func(e any) string {
+					return strconv.Itoa(e.(*Score).Points)
+				},
+				SortFunc: func(a, b any) int {
+					return cmp.Compare(a.(*Score).Points, b.(*Score).Points)
+				},
+			},
+		),
+		widget.TableOpts.Entries(entries),
+
+		// Start with the highest score at the top.
+		widget.TableOpts.SortBy(2, widget.TableSortDescending),
+
+		widget.TableOpts.EntrySelectedHandler(func(args *widget.TableEntrySelectedEventArgs) {
+			fmt.Println("Entry Selected: ", *args.Entry.(*Score))
+		}),
+		widget.TableOpts.ColumnResizedHandler(func(args *widget.TableColumnResizedEventArgs) {
+			fmt.Println("Column Resized: ", args.Column, args.Width)
+		}),
+	)
+	rootContainer.AddChild(table)
+
+	// construct the UI
+	ui := ebitenui.UI{
+		Container:    rootContainer,
+		PrimaryTheme: themes.GetBasicDarkTheme(),
+	}
+
+	game := game{
+		ui: &ui,
+	}
+
+	// run Ebiten main loop
+	err := ebiten.RunGame(&game)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Layout implements Game.
+func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Update implements Game.
+func (g *game) Update() error {
+	// update the UI
+	g.ui.Update()
+	return nil
+}
+
+// Draw implements Ebiten's Draw method.
+func (g *game) Draw(screen *ebiten.Image) {
+	// draw the UI onto the screen
+	g.ui.Draw(screen)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/themes"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Game object used by ebiten.
+type game struct {
+	ui *ebitenui.UI
+}
+
+func main() {
+	// Ebiten setup
+	ebiten.SetWindowSize(500, 400)
+	ebiten.SetWindowTitle("Ebiten UI - TextEditor")
+
+	// construct a new container that serves as the root of the UI hierarchy
+	rootContainer := widget.NewPanel(
+		// the container will use an anchor layout to layout its single child widget
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout(
+			widget.AnchorLayoutOpts.Padding(widget.NewInsetsSimple(20)),
+		)),
+	)
+
+	// construct a text editor. All visual parameters are taken from the theme.
+	textEditor := widget.NewTextEditor(
+		widget.TextEditorOpts.ContainerOpts(
+			widget.ContainerOpts.WidgetOpts(
+				widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+					StretchHorizontal: true,
+					StretchVertical:   true,
+				}),
+			),
+		),
+
+		// This text is displayed if the editor is empty
+		widget.TextEditorOpts.Placeholder("Type something..."),
+
+		// Set the initial text. Lines that are too wide for the editor are wrapped.
+		widget.TextEditorOpts.Text("Hello World!\n\nThis is a multi-line text editor. "+
+			"Long lines are wrapped at the edge of the editor, Enter inserts a new line and "+
+			"the arrow, Home, End, Page Up and Page Down keys move the caret."),
+
+		// This is called whenever there is a change to the text
+		widget.TextEditorOpts.ChangedHandler(func(args *widget.TextEditorChangedEventArgs) {
+			fmt.Println("Text Changed: ", args.InputText)
+		}),
+	)
+	rootContainer.AddChild(textEditor)
+
+	// construct the UI
+	ui := ebitenui.UI{
+		Container:    rootContainer,
+		PrimaryTheme: themes.GetBasicDarkTheme(),
+	}
+
+	game := game{
+		ui: &ui,
+	}
+
+	// run Ebiten main loop
+	err := ebiten.RunGame(&game)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Layout implements Game.
+func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Update implements Game.
+func (g *game) Update() error {
+	// update the UI
+	g.ui.Update()
+	return nil
+}
+
+// Draw implements Ebiten's Draw method.
+func (g *game) Draw(screen *ebiten.Image) {
+	// draw the UI onto the screen
+	g.ui.Draw(screen)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/themes"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Node is the data shown by each row of the tree.
+type Node struct {
+	Name     string
+	Children []*Node
+}
+
+// Game object used by ebiten.
+type game struct {
+	ui *ebitenui.UI
+}
+
+func main() {
+	// Ebiten setup
+	ebiten.SetWindowSize(600, 400)
+	ebiten.SetWindowTitle("Ebiten UI - TreeView")
+
+	root := &Node{Children: []*Node{
+		{Name: "assets", Children: []*Node{
+			{Name: "fonts", Children: []*Node{{Name: "notosans.ttf"}}},
+			{Name: "images", Children: []*Node{{Name: "player.png"}, {Name: "tiles.png"}}},
+			{Name: "sounds", Children: []*Node{{Name: "jump.wav"}}},
+		}},
+		{Name: "src", Children: []*Node{{Name: "main.go"}, {Name: "game.go"}}},
+		{Name: "go.mod"},
+		{Name: "README.md"},
+	}}
+
+	// construct a new container that serves as the root of the UI hierarchy
+	rootContainer := widget.NewPanel(
+		// the container will use an anchor layout to layout its single child widget
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout(
+			widget.AnchorLayoutOpts.Padding(widget.NewInsetsSimple(20)),
+		)),
+	)
+
+	// construct a tree view. All visual parameters are taken from the theme.
+	tree := widget.NewTreeView(
+		widget.TreeViewOpts.WidgetOpts(
+			widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+				StretchHorizontal: true,
+				StretchVertical:   true,
+			}),
+		),
+		widget.TreeViewOpts.Roots(nodes(root)...),
+
+		// Children are only requested when a node is expanded for the first time.
+		widget.TreeViewOpts.ChildrenFunc(func(node any) []any {
+			fmt.Println("Loading children of", node.(*Node).Name)
+			return nodes(node.(*Node))
+		}),
+		widget.TreeViewOpts.HasChildrenFunc(func(node any) bool {
+			return len(node.(*Node).Children) > 0
+		}),
+		widget.TreeViewOpts.LabelFunc(func(node any) string {
+			return node.(*Node).Name
+		}),
+
+		// Nodes can be reordered with drag and drop. The handler applies the move to the data.
+		widget.TreeViewOpts.Draggable(),
+		widget.TreeViewOpts.NodeMovedHandler(func(args *widget.TreeViewNodeMovedEventArgs) {
+			node := args.Node.(*Node)
+			oldParent, newParent := root, root
+			if args.OldParent != nil {
+				oldParent = args.OldParent.(*Node)
+			}
+			if args.NewParent != nil {
+				newParent = args.NewParent.(*Node)
+			}
+			oldParent.Children = slices.DeleteFunc(oldParent.Children, func(n *Node) bool { return n == node })
+			newParent.Children = slices.Insert(newParent.Children, args.Index, node)
+			fmt.Println("Node Moved: ", node.Name, "to", newParent.Name, args.Index)
+		}),
+
+		widget.TreeViewOpts.NodeSelectedHandler(func(args *widget.TreeViewNodeSelectedEventArgs) {
+			fmt.Println("Node Selected: ", args.Node.(*Node).Name)
+		}),
+		widget.TreeViewOpts.ExpandedChangedHandler(func(args *widget.TreeViewExpandedChangedEventArgs) {
+			fmt.Println("Expanded Changed: ", args.Node.(*Node).Name, args.Expanded)
+		}),
+	)
+	rootContainer.AddChild(tree)
+
+	// construct the UI
+	ui := ebitenui.UI{
+		Container:    rootContainer,
+		PrimaryTheme: themes.GetBasicDarkTheme(),
+	}
+
+	game := game{
+		ui: &ui,
+	}
+
+	// run Ebiten main loop
+	err := ebiten.RunGame(&game)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// nodes returns the children of n as the nodes of the tree view.
+func nodes(n *Node) []any {
+	result := make([]any, len(n.Children))
+	for i, c := range n.Children {
+		result[i] = c
+	}
+	return result
+}
+
+// Layout implements Game.
+func (g *game) Layout(outsideWidth int, outsideHeight int) (int, int) {
+	return outsideWidth, outsideHeight
+}
+
+// Update implements Game.
+func (g *game) Update() error {
+	// update the UI
+	g.ui.Update()
+	return nil
+}
+
+// Draw implements Ebiten's Draw method.
+func (g *game) Draw(screen *ebiten.Image) {
+	// draw the UI onto the screen
+	g.ui.Draw(screen)
+}
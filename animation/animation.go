@@ -0,0 +1,163 @@
+// Package animation tweens values over time, such as the position offset, opacity or scale of a widget,
+// the value of a ProgressBar or the current value of a Slider.
+//
+// An Animation is built from Tweens, which can be combined with Sequence, Parallel, Delay, Call and Repeat,
+// and is then started with Play:
+//
+//	animation.Play(animation.Sequence(
+//		animation.Opacity(dialog, 0, 1, 200*time.Millisecond),
+//		animation.Delay(2*time.Second),
+//		animation.Opacity(dialog, 1, 0, 200*time.Millisecond, animation.TweenOpts.Easing(animation.QuadIn)),
+//	))
+//
+// Animations started with Play are advanced by ebitenui.UI.Update. Time is read from the clock package,
+// so tests can step animations deterministically by using a clock.Fake.
+package animation
+
+import (
+	"time"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/utilities/clock"
+)
+
+// Forever can be passed to TweenOpts.Loops and Repeat to repeat an animation until it is stopped.
+const Forever = -1
+
+// An Animation changes something over time.
+type Animation interface {
+	// Advance moves the animation forward by dt. It returns whether the animation has finished and,
+	// if it has, the part of dt that was left over, so that a following animation can use it.
+	Advance(dt time.Duration) (time.Duration, bool)
+
+	// Reset rewinds the animation so that it can be played again.
+	Reset()
+}
+
+// A Player advances the animations that are playing.
+type Player struct {
+	playbacks []*Playback
+}
+
+// A Playback is an animation that has been started with Play.
+type Playback struct {
+	// CompletedEvent fires with *PlaybackCompletedEventArgs when the animation has finished.
+	// It does not fire if the playback is stopped.
+	CompletedEvent *event.Event
+
+	animation Animation
+	last      time.Time
+	paused    bool
+	done      bool
+}
+
+// PlaybackCompletedEventArgs are the arguments for completion events of a Playback.
+type PlaybackCompletedEventArgs struct {
+	Playback *Playback
+}
+
+// PlaybackCompletedHandlerFunc is a function that handles completion events of a Playback.
+type PlaybackCompletedHandlerFunc func(args *PlaybackCompletedEventArgs)
+
+// DefaultPlayer is the Player used by Play and Update. It is shared by all UIs, each of which updates it.
+var DefaultPlayer = NewPlayer()
+
+// NewPlayer returns an empty Player.
+func NewPlayer() *Player {
+	return &Player{}
+}
+
+// Play starts a on DefaultPlayer.
+func Play(a Animation) *Playback {
+	return DefaultPlayer.Play(a)
+}
+
+// Update advances the animations of DefaultPlayer. It is called by ebitenui.UI.Update.
+func Update() {
+	DefaultPlayer.Update()
+}
+
+// Play starts a. The animation begins at the current time of the clock and is advanced by Update.
+func (p *Player) Play(a Animation) *Playback {
+	pb := &Playback{
+		CompletedEvent: &event.Event{},
+		animation:      a,
+		last:           clock.Now(),
+	}
+	p.playbacks = append(p.playbacks, pb)
+	return pb
+}
+
+// Update advances all playing animations by the time that has passed since they were last advanced.
+// Finished and stopped animations are removed.
+func (p *Player) Update() {
+	now := clock.Now()
+
+	playbacks := p.playbacks
+	p.playbacks = nil
+	for _, pb := range playbacks {
+		if pb.done {
+			continue
+		}
+		if !pb.paused {
+			dt := now.Sub(pb.last)
+			pb.last = now
+			if _, done := pb.animation.Advance(dt); done {
+				pb.done = true
+				pb.CompletedEvent.Fire(&PlaybackCompletedEventArgs{
+					Playback: pb,
+				})
+				continue
+			}
+		}
+		// Animations started while advancing others have been appended to p.playbacks already.
+		p.playbacks = append(p.playbacks, pb)
+	}
+}
+
+// Playing returns the number of animations that have not finished or been stopped.
+func (p *Player) Playing() int {
+	n := 0
+	for _, pb := range p.playbacks {
+		if !pb.done {
+			n++
+		}
+	}
+	return n
+}
+
+// StopAll stops all animations. Their targets keep their current values.
+func (p *Player) StopAll() {
+	for _, pb := range p.playbacks {
+		pb.done = true
+	}
+	p.playbacks = nil
+}
+
+// Stop stops the animation. Its targets keep their current values.
+func (pb *Playback) Stop() {
+	pb.done = true
+}
+
+// Pause stops advancing the animation until Resume is called.
+func (pb *Playback) Pause() {
+	pb.paused = true
+}
+
+// Resume continues a paused animation from where it was paused.
+func (pb *Playback) Resume() {
+	if pb.paused {
+		pb.paused = false
+		pb.last = clock.Now()
+	}
+}
+
+// IsPaused returns whether the animation is paused.
+func (pb *Playback) IsPaused() bool {
+	return pb.paused
+}
+
+// IsDone returns whether the animation has finished or has been stopped.
+func (pb *Playback) IsDone() bool {
+	return pb.done
+}
@@ -0,0 +1,192 @@
+package animation
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/utilities/clock"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/matryer/is"
+)
+
+func TestTween(t *testing.T) {
+	is := is.New(t)
+
+	var v float64
+	tw := Float(func(f float64) { v = f }, 10, 20, time.Second)
+
+	_, done := tw.Advance(500 * time.Millisecond)
+	is.True(!done)
+	is.Equal(v, 15.0)
+
+	rest, done := tw.Advance(700 * time.Millisecond)
+	is.True(done)
+	is.Equal(rest, 200*time.Millisecond)
+	is.Equal(v, 20.0)
+}
+
+func TestTween_DelayAndEasing(t *testing.T) {
+	is := is.New(t)
+
+	var v float64
+	tw := Float(func(f float64) { v = f }, 0, 1, time.Second,
+		TweenOpts.Delay(time.Second),
+		TweenOpts.Easing(QuadIn))
+
+	tw.Advance(time.Second)
+	is.Equal(v, 0.0)
+
+	tw.Advance(500 * time.Millisecond)
+	is.Equal(v, 0.25)
+}
+
+func TestTween_LoopsYoyo(t *testing.T) {
+	is := is.New(t)
+
+	var v float64
+	tw := Float(func(f float64) { v = f }, 0, 100, time.Second,
+		TweenOpts.Loops(3),
+		TweenOpts.Yoyo(true))
+
+	tw.Advance(1250 * time.Millisecond)
+	is.Equal(v, 75.0) // second loop, backwards
+
+	_, done := tw.Advance(time.Second)
+	is.True(!done)
+	is.Equal(v, 25.0) // third loop, forwards
+
+	_, done = tw.Advance(time.Second)
+	is.True(done)
+	is.Equal(v, 100.0)
+}
+
+func TestTween_CompletedEvent(t *testing.T) {
+	is := is.New(t)
+
+	var args *TweenCompletedEventArgs
+	tw := NewTween(time.Second, func(float64) {}, TweenOpts.CompletedHandler(func(a *TweenCompletedEventArgs) {
+		args = a
+	}))
+	event.ExecuteDeferred()
+
+	tw.Advance(time.Second)
+	event.ExecuteDeferred()
+
+	is.True(args != nil)
+	is.Equal(args.Tween, tw)
+}
+
+func TestSequence(t *testing.T) {
+	is := is.New(t)
+
+	var log []string
+	var a, b float64
+	s := Sequence(
+		Float(func(f float64) { a = f }, 0, 1, time.Second),
+		Call(func() { log = append(log, "call") }),
+		Delay(time.Second),
+		Float(func(f float64) { b = f }, 0, 1, time.Second),
+	)
+
+	s.Advance(1500 * time.Millisecond)
+	is.Equal(a, 1.0)
+	is.Equal(log, []string{"call"})
+	is.Equal(b, 0.0)
+
+	_, done := s.Advance(time.Second)
+	is.True(!done)
+	is.Equal(b, 0.5)
+
+	_, done = s.Advance(time.Second)
+	is.True(done)
+	is.Equal(b, 1.0)
+	is.Equal(log, []string{"call"})
+}
+
+func TestParallelAndRepeat(t *testing.T) {
+	is := is.New(t)
+
+	var a, b float64
+	r := Repeat(Parallel(
+		Float(func(f float64) { a = f }, 0, 1, time.Second),
+		Float(func(f float64) { b = f }, 0, 1, 2*time.Second),
+	), 2)
+
+	r.Advance(time.Second)
+	is.Equal(a, 1.0)
+	is.Equal(b, 0.5)
+
+	r.Advance(1500 * time.Millisecond) // second run, 0.5s in
+	is.Equal(a, 0.5)
+	is.Equal(b, 0.25)
+
+	rest, done := r.Advance(2 * time.Second)
+	is.True(done)
+	is.Equal(rest, 500*time.Millisecond)
+	is.Equal(b, 1.0)
+}
+
+func TestPlayer(t *testing.T) {
+	is := is.New(t)
+
+	c := clock.NewFake(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	clock.Set(c)
+	defer clock.Set(nil)
+
+	p := NewPlayer()
+	var v float64
+	pb := p.Play(Float(func(f float64) { v = f }, 0, 1, time.Second))
+	completed := false
+	pb.CompletedEvent.AddHandler(func(interface{}) {
+		completed = true
+	})
+	event.ExecuteDeferred()
+
+	c.Advance(250 * time.Millisecond)
+	p.Update()
+	is.Equal(v, 0.25)
+
+	pb.Pause()
+	c.Advance(time.Hour)
+	p.Update()
+	is.Equal(v, 0.25)
+
+	pb.Resume()
+	c.Advance(250 * time.Millisecond)
+	p.Update()
+	is.Equal(v, 0.5)
+	is.Equal(p.Playing(), 1)
+
+	c.Advance(time.Second)
+	p.Update()
+	event.ExecuteDeferred()
+	is.Equal(v, 1.0)
+	is.True(pb.IsDone())
+	is.True(completed)
+	is.Equal(p.Playing(), 0)
+}
+
+func TestTargets(t *testing.T) {
+	is := is.New(t)
+
+	w := widget.NewContainer()
+	Parallel(
+		Offset(w, 0, 0, 10, -10, time.Second),
+		Opacity(w, 0, 1, time.Second),
+		Scale(w, 1, 2, time.Second),
+	).Advance(500 * time.Millisecond)
+
+	is.Equal(*w.GetWidget().Transform, widget.RenderTransform{
+		OffsetX: 5,
+		OffsetY: -5,
+		ScaleX:  1.5,
+		ScaleY:  1.5,
+		Opacity: 0.5,
+	})
+
+	var c color.Color
+	Color(func(v color.Color) { c = v }, color.Black, color.White, time.Second).Advance(500 * time.Millisecond)
+	is.Equal(c, color.NRGBA{128, 128, 128, 255})
+}
@@ -0,0 +1,153 @@
+package animation
+
+import "time"
+
+type sequence struct {
+	animations []Animation
+	current    int
+}
+
+// Sequence plays animations one after another.
+func Sequence(animations ...Animation) Animation {
+	return &sequence{animations: animations}
+}
+
+func (s *sequence) Advance(dt time.Duration) (time.Duration, bool) {
+	for s.current < len(s.animations) {
+		rest, done := s.animations[s.current].Advance(dt)
+		if !done {
+			return 0, false
+		}
+		s.current++
+		dt = rest
+	}
+	return dt, true
+}
+
+func (s *sequence) Reset() {
+	for _, a := range s.animations {
+		a.Reset()
+	}
+	s.current = 0
+}
+
+type parallel struct {
+	animations []Animation
+	done       []bool
+}
+
+// Parallel plays animations at the same time. It finishes when all of them have finished.
+func Parallel(animations ...Animation) Animation {
+	return &parallel{
+		animations: animations,
+		done:       make([]bool, len(animations)),
+	}
+}
+
+func (p *parallel) Advance(dt time.Duration) (time.Duration, bool) {
+	allDone := true
+	rest := dt
+	for i, a := range p.animations {
+		if p.done[i] {
+			continue
+		}
+		r, done := a.Advance(dt)
+		if !done {
+			allDone = false
+			continue
+		}
+		p.done[i] = true
+		// What is left over is determined by the animation that finished last.
+		rest = min(rest, r)
+	}
+	if !allDone {
+		return 0, false
+	}
+	return rest, true
+}
+
+func (p *parallel) Reset() {
+	for i, a := range p.animations {
+		a.Reset()
+		p.done[i] = false
+	}
+}
+
+type delay struct {
+	duration time.Duration
+	elapsed  time.Duration
+}
+
+// Delay waits for d. It is mostly useful in a Sequence.
+func Delay(d time.Duration) Animation {
+	return &delay{duration: d}
+}
+
+func (d *delay) Advance(dt time.Duration) (time.Duration, bool) {
+	d.elapsed += dt
+	if d.elapsed < d.duration {
+		return 0, false
+	}
+	return d.elapsed - d.duration, true
+}
+
+func (d *delay) Reset() {
+	d.elapsed = 0
+}
+
+type call struct {
+	f    func()
+	done bool
+}
+
+// Call calls f once and finishes immediately. It is mostly useful in a Sequence.
+func Call(f func()) Animation {
+	return &call{f: f}
+}
+
+func (c *call) Advance(dt time.Duration) (time.Duration, bool) {
+	if !c.done {
+		c.done = true
+		c.f()
+	}
+	return dt, true
+}
+
+func (c *call) Reset() {
+	c.done = false
+}
+
+type repeat struct {
+	animation Animation
+	times     int
+	count     int
+}
+
+// Repeat plays a the given number of times, or until it is stopped if times is Forever.
+func Repeat(a Animation, times int) Animation {
+	return &repeat{animation: a, times: times}
+}
+
+func (r *repeat) Advance(dt time.Duration) (time.Duration, bool) {
+	for {
+		rest, done := r.animation.Advance(dt)
+		if !done {
+			return 0, false
+		}
+		r.count++
+		if r.times != Forever && r.count >= r.times {
+			return rest, true
+		}
+		if r.times == Forever && rest == dt {
+			// The animation takes no time, repeating it forever would never return.
+			return 0, false
+		}
+		r.animation.Reset()
+		dt = rest
+	}
+}
+
+func (r *repeat) Reset() {
+	r.animation.Reset()
+	r.count = 0
+}
@@ -0,0 +1,89 @@
+package animation
+
+import "math"
+
+// Easing maps the linear progress of a tween, from 0 to 1, to the progress applied to its target.
+// Easings may return values outside of [0, 1] to overshoot the target, like BackOut.
+type Easing func(t float64) float64
+
+// Linear changes the value at a constant rate.
+func Linear(t float64) float64 {
+	return t
+}
+
+// QuadIn starts slowly and accelerates.
+func QuadIn(t float64) float64 {
+	return t * t
+}
+
+// QuadOut starts quickly and decelerates.
+func QuadOut(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// QuadInOut accelerates until the middle, then decelerates.
+func QuadInOut(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 2)/2
+}
+
+// CubicIn starts slowly and accelerates, more strongly than QuadIn.
+func CubicIn(t float64) float64 {
+	return t * t * t
+}
+
+// CubicOut starts quickly and decelerates, more strongly than QuadOut.
+func CubicOut(t float64) float64 {
+	return 1 - math.Pow(1-t, 3)
+}
+
+// CubicInOut accelerates until the middle, then decelerates, more strongly than QuadInOut.
+func CubicInOut(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 3)/2
+}
+
+// SineIn starts slowly and accelerates along a sine curve.
+func SineIn(t float64) float64 {
+	return 1 - math.Cos(t*math.Pi/2)
+}
+
+// SineOut starts quickly and decelerates along a sine curve.
+func SineOut(t float64) float64 {
+	return math.Sin(t * math.Pi / 2)
+}
+
+// SineInOut accelerates until the middle, then decelerates along a sine curve.
+func SineInOut(t float64) float64 {
+	return -(math.Cos(math.Pi*t) - 1) / 2
+}
+
+// BackOut overshoots the target slightly and then settles on it.
+func BackOut(t float64) float64 {
+	const c1 = 1.70158
+	const c3 = c1 + 1
+	return 1 + c3*math.Pow(t-1, 3) + c1*math.Pow(t-1, 2)
+}
+
+// BounceOut bounces off the target a few times before settling on it.
+func BounceOut(t float64) float64 {
+	const n1 = 7.5625
+	const d1 = 2.75
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	default:
+		t -= 2.625 / d1
+		return n1*t*t + 0.984375
+	}
+}
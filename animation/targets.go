@@ -0,0 +1,94 @@
+package animation
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/ebitenui/ebitenui/widget"
+)
+
+// Float returns a Tween that calls set with values from from to to.
+func Float(set func(v float64), from float64, to float64, d time.Duration, opts ...TweenOpt) *Tween {
+	return NewTween(d, func(p float64) {
+		set(lerp(from, to, p))
+	}, opts...)
+}
+
+// Int returns a Tween that calls set with values from from to to, rounded to the nearest integer.
+func Int(set func(v int), from int, to int, d time.Duration, opts ...TweenOpt) *Tween {
+	return NewTween(d, func(p float64) {
+		set(int(math.Round(lerp(float64(from), float64(to), p))))
+	}, opts...)
+}
+
+// Color returns a Tween that calls set with colors from from to to.
+func Color(set func(c color.Color), from color.Color, to color.Color, d time.Duration, opts ...TweenOpt) *Tween {
+	f := color.NRGBAModel.Convert(from).(color.NRGBA)
+	t := color.NRGBAModel.Convert(to).(color.NRGBA)
+	return NewTween(d, func(p float64) {
+		set(color.NRGBA{
+			R: lerpChannel(f.R, t.R, p),
+			G: lerpChannel(f.G, t.G, p),
+			B: lerpChannel(f.B, t.B, p),
+			A: lerpChannel(f.A, t.A, p),
+		})
+	}, opts...)
+}
+
+// Offset returns a Tween that moves w from the offset (fromX, fromY) to (toX, toY), relative to the position
+// its layout gave it. Layout and input handling are not affected, see widget.RenderTransform.
+func Offset(w widget.HasWidget, fromX float64, fromY float64, toX float64, toY float64, d time.Duration, opts ...TweenOpt) *Tween {
+	return NewTween(d, func(p float64) {
+		t := transform(w)
+		t.OffsetX = lerp(fromX, toX, p)
+		t.OffsetY = lerp(fromY, toY, p)
+	}, opts...)
+}
+
+// Opacity returns a Tween that fades w from opacity from to to, where 0 is invisible and 1 is opaque.
+func Opacity(w widget.HasWidget, from float64, to float64, d time.Duration, opts ...TweenOpt) *Tween {
+	return NewTween(d, func(p float64) {
+		transform(w).Opacity = lerp(from, to, p)
+	}, opts...)
+}
+
+// Scale returns a Tween that scales w around its center from from to to.
+func Scale(w widget.HasWidget, from float64, to float64, d time.Duration, opts ...TweenOpt) *Tween {
+	return NewTween(d, func(p float64) {
+		t := transform(w)
+		t.ScaleX = lerp(from, to, p)
+		t.ScaleY = t.ScaleX
+	}, opts...)
+}
+
+// ProgressBarValue returns a Tween that moves the current value of pb from from to to.
+func ProgressBarValue(pb *widget.ProgressBar, from int, to int, d time.Duration, opts ...TweenOpt) *Tween {
+	return Int(func(v int) {
+		pb.SetCurrent(v)
+	}, from, to, d, opts...)
+}
+
+// SliderCurrent returns a Tween that moves the current value of s from from to to.
+// The slider fires its ChangedEvent as usual.
+func SliderCurrent(s *widget.Slider, from int, to int, d time.Duration, opts ...TweenOpt) *Tween {
+	return Int(func(v int) {
+		s.Current = v
+	}, from, to, d, opts...)
+}
+
+func transform(w widget.HasWidget) *widget.RenderTransform {
+	ww := w.GetWidget()
+	if ww.Transform == nil {
+		ww.Transform = widget.NewRenderTransform()
+	}
+	return ww.Transform
+}
+
+func lerp(from float64, to float64, p float64) float64 {
+	return from + (to-from)*p
+}
+
+func lerpChannel(from uint8, to uint8, p float64) uint8 {
+	return uint8(math.Round(max(0, min(255, lerp(float64(from), float64(to), p)))))
+}
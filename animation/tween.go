@@ -0,0 +1,158 @@
+package animation
+
+import (
+	"time"
+
+	"github.com/ebitenui/ebitenui/event"
+)
+
+// A Tween moves a value from a start to an end over a duration. The value itself is applied by a function
+// that receives the eased progress, which is 0 at the start and 1 at the end.
+type Tween struct {
+	// CompletedEvent fires with *TweenCompletedEventArgs when the tween has finished its last loop.
+	CompletedEvent *event.Event
+
+	duration time.Duration
+	delay    time.Duration
+	easing   Easing
+	loops    int
+	yoyo     bool
+	apply    func(progress float64)
+
+	elapsed time.Duration
+	run     int
+	started bool
+	done    bool
+}
+
+// TweenOpt is a function that configures t.
+type TweenOpt func(t *Tween)
+
+// TweenCompletedEventArgs are the arguments for completion events of a Tween.
+type TweenCompletedEventArgs struct {
+	Tween *Tween
+}
+
+// TweenCompletedHandlerFunc is a function that handles completion events of a Tween.
+type TweenCompletedHandlerFunc func(args *TweenCompletedEventArgs)
+
+type TweenOptions struct {
+}
+
+// TweenOpts contains functions that configure a Tween.
+var TweenOpts TweenOptions
+
+// NewTween returns a Tween that takes duration d and calls apply with the eased progress every time it advances.
+// Tween constructors for common targets, like Float or Opacity, are usually more convenient.
+func NewTween(d time.Duration, apply func(progress float64), opts ...TweenOpt) *Tween {
+	t := &Tween{
+		CompletedEvent: &event.Event{},
+
+		duration: d,
+		easing:   Linear,
+		loops:    1,
+		apply:    apply,
+	}
+
+	for _, o := range opts {
+		o(t)
+	}
+
+	return t
+}
+
+// Delay waits for d before the tween starts. The delay is only applied once, not for every loop.
+func (o TweenOptions) Delay(d time.Duration) TweenOpt {
+	return func(t *Tween) {
+		t.delay = d
+	}
+}
+
+// Easing sets the easing curve of the tween. The default is Linear.
+func (o TweenOptions) Easing(e Easing) TweenOpt {
+	return func(t *Tween) {
+		t.easing = e
+	}
+}
+
+// Loops plays the tween n times, or until it is stopped if n is Forever. The default is 1.
+func (o TweenOptions) Loops(n int) TweenOpt {
+	return func(t *Tween) {
+		t.loops = n
+	}
+}
+
+// Yoyo plays every other loop backwards, so that the value moves back and forth.
+func (o TweenOptions) Yoyo(yoyo bool) TweenOpt {
+	return func(t *Tween) {
+		t.yoyo = yoyo
+	}
+}
+
+// CompletedHandler adds a handler for the CompletedEvent of the tween.
+func (o TweenOptions) CompletedHandler(f TweenCompletedHandlerFunc) TweenOpt {
+	return func(t *Tween) {
+		t.CompletedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TweenCompletedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// Advance implements Animation.
+func (t *Tween) Advance(dt time.Duration) (time.Duration, bool) {
+	if t.done {
+		return dt, true
+	}
+
+	t.elapsed += dt
+	if !t.started {
+		if t.elapsed < t.delay {
+			return 0, false
+		}
+		t.started = true
+		t.elapsed -= t.delay
+	}
+
+	for t.elapsed >= t.duration {
+		if t.loops != Forever && t.run+1 >= t.loops {
+			t.apply(t.progress(t.run, 1))
+			t.done = true
+			t.CompletedEvent.Fire(&TweenCompletedEventArgs{
+				Tween: t,
+			})
+			return t.elapsed - t.duration, true
+		}
+		if t.duration <= 0 {
+			// An empty tween that loops forever would never consume any time.
+			t.apply(t.progress(t.run, 1))
+			return 0, false
+		}
+		t.elapsed -= t.duration
+		t.run++
+	}
+
+	t.apply(t.progress(t.run, float64(t.elapsed)/float64(t.duration)))
+	return 0, false
+}
+
+// Reset implements Animation.
+func (t *Tween) Reset() {
+	t.elapsed = 0
+	t.run = 0
+	t.started = false
+	t.done = false
+}
+
+// IsDone returns whether the tween has finished its last loop.
+func (t *Tween) IsDone() bool {
+	return t.done
+}
+
+func (t *Tween) progress(run int, p float64) float64 {
+	if t.yoyo && run%2 == 1 {
+		p = 1 - p
+	}
+	return t.easing(p)
+}
@@ -88,6 +88,11 @@ func (e *Event) Fire(args interface{}) {
 	})
 }
 
+// HasHandlers returns whether any handlers are registered with e.
+func (e *Event) HasHandlers() bool {
+	return len(e.handlers) > 0
+}
+
 func (e *Event) handle(args interface{}) {
 	for _, h := range e.handlers {
 		h.h(args)
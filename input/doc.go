@@ -3,4 +3,9 @@
 //
 // Widget implementations should always use this package to handle user input rather than using
 // Ebiten functions directly.
+//
+// The input itself is read from a Source. By default this is an EbitenSource that polls Ebitengine,
+// but a UI can be given any other Source, e.g. a FakeSource to script input in tests.
+//
+// Only the input itself depends on the Source. The input layer stack is global and shared by all UIs.
 package input
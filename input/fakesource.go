@@ -0,0 +1,182 @@
+package input
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// FakeSource is a Source whose input is set by code instead of devices, for tests and replays.
+//
+// State set directly, like a pressed key or the cursor position, is reported until it is changed.
+// Typed characters and wheel movement are reported for a single frame, the one following the call.
+// Steps queued with Enqueue are run one per frame, which allows scripting input frame by frame.
+type FakeSource struct {
+	sourceState
+
+	cursor         image.Point
+	mouseButtons   map[ebiten.MouseButton]bool
+	keys           map[ebiten.Key]bool
+	gamepadButtons map[ebiten.StandardGamepadButton]bool
+	touches        map[ebiten.TouchID]image.Point
+	touchIDs       []ebiten.TouchID
+
+	pendingChars  []rune
+	pendingWheelX float64
+	pendingWheelY float64
+	chars         []rune
+	wheelX        float64
+	wheelY        float64
+
+	steps []FakeSourceStep
+}
+
+// FakeSourceStep changes the state of a FakeSource for one frame.
+type FakeSourceStep func(s *FakeSource)
+
+// NewFakeSource returns a FakeSource without any input.
+func NewFakeSource() *FakeSource {
+	return &FakeSource{
+		mouseButtons:   make(map[ebiten.MouseButton]bool),
+		keys:           make(map[ebiten.Key]bool),
+		gamepadButtons: make(map[ebiten.StandardGamepadButton]bool),
+		touches:        make(map[ebiten.TouchID]image.Point),
+	}
+}
+
+// Enqueue appends steps to the script. Every Update runs the next step before reporting the new frame.
+func (s *FakeSource) Enqueue(steps ...FakeSourceStep) {
+	s.steps = append(s.steps, steps...)
+}
+
+// Pending returns the number of queued steps that have not been run yet.
+func (s *FakeSource) Pending() int {
+	return len(s.steps)
+}
+
+// SetCursorPosition moves the cursor to x, y.
+func (s *FakeSource) SetCursorPosition(x int, y int) {
+	s.cursor = image.Point{x, y}
+}
+
+// PressMouseButton presses mouse button b until ReleaseMouseButton is called.
+func (s *FakeSource) PressMouseButton(b ebiten.MouseButton) {
+	s.mouseButtons[b] = true
+}
+
+// ReleaseMouseButton releases mouse button b.
+func (s *FakeSource) ReleaseMouseButton(b ebiten.MouseButton) {
+	delete(s.mouseButtons, b)
+}
+
+// PressKey presses key k until ReleaseKey is called.
+func (s *FakeSource) PressKey(k ebiten.Key) {
+	s.keys[k] = true
+}
+
+// ReleaseKey releases key k.
+func (s *FakeSource) ReleaseKey(k ebiten.Key) {
+	delete(s.keys, k)
+}
+
+// PressGamepadButton presses standard gamepad button b until ReleaseGamepadButton is called.
+func (s *FakeSource) PressGamepadButton(b ebiten.StandardGamepadButton) {
+	s.gamepadButtons[b] = true
+}
+
+// ReleaseGamepadButton releases standard gamepad button b.
+func (s *FakeSource) ReleaseGamepadButton(b ebiten.StandardGamepadButton) {
+	delete(s.gamepadButtons, b)
+}
+
+// Type reports the characters of text as typed during the next frame.
+func (s *FakeSource) Type(text string) {
+	s.pendingChars = append(s.pendingChars, []rune(text)...)
+}
+
+// Scroll reports the wheel movement x, y during the next frame.
+func (s *FakeSource) Scroll(x float64, y float64) {
+	s.pendingWheelX += x
+	s.pendingWheelY += y
+}
+
+// Touch starts touch id at x, y, or moves it there if it already exists.
+func (s *FakeSource) Touch(id ebiten.TouchID, x int, y int) {
+	if _, ok := s.touches[id]; !ok {
+		s.touchIDs = append(s.touchIDs, id)
+	}
+	s.touches[id] = image.Point{x, y}
+}
+
+// ReleaseTouch ends touch id.
+func (s *FakeSource) ReleaseTouch(id ebiten.TouchID) {
+	if _, ok := s.touches[id]; !ok {
+		return
+	}
+	delete(s.touches, id)
+	for i, t := range s.touchIDs {
+		if t == id {
+			s.touchIDs = append(s.touchIDs[:i], s.touchIDs[i+1:]...)
+			break
+		}
+	}
+}
+
+func (s *FakeSource) Update() {
+	if len(s.steps) > 0 {
+		step := s.steps[0]
+		s.steps = s.steps[1:]
+		step(s)
+	}
+
+	s.chars = append(s.chars[:0], s.pendingChars...)
+	s.pendingChars = s.pendingChars[:0]
+	s.wheelX, s.wheelY = s.pendingWheelX, s.pendingWheelY
+	s.pendingWheelX, s.pendingWheelY = 0, 0
+}
+
+func (s *FakeSource) CursorPosition() (int, int) {
+	return s.cursor.X, s.cursor.Y
+}
+
+func (s *FakeSource) MouseButtonPressed(b ebiten.MouseButton) bool {
+	return s.mouseButtons[b]
+}
+
+func (s *FakeSource) Wheel() (float64, float64) {
+	return s.wheelX, s.wheelY
+}
+
+func (s *FakeSource) AppendInputChars(chars []rune) []rune {
+	return append(chars, s.chars...)
+}
+
+// KeyPressed returns whether key k is pressed. Like Ebitengine, it reports the modifier keys
+// KeyAlt, KeyControl, KeyMeta and KeyShift as pressed if either their left or right key is pressed.
+func (s *FakeSource) KeyPressed(k ebiten.Key) bool {
+	switch k {
+	case ebiten.KeyAlt:
+		return s.keys[k] || s.keys[ebiten.KeyAltLeft] || s.keys[ebiten.KeyAltRight]
+	case ebiten.KeyControl:
+		return s.keys[k] || s.keys[ebiten.KeyControlLeft] || s.keys[ebiten.KeyControlRight]
+	case ebiten.KeyMeta:
+		return s.keys[k] || s.keys[ebiten.KeyMetaLeft] || s.keys[ebiten.KeyMetaRight]
+	case ebiten.KeyShift:
+		return s.keys[k] || s.keys[ebiten.KeyShiftLeft] || s.keys[ebiten.KeyShiftRight]
+	default:
+		return s.keys[k]
+	}
+}
+
+func (s *FakeSource) GamepadButtonPressed(b ebiten.StandardGamepadButton) bool {
+	return s.gamepadButtons[b]
+}
+
+func (s *FakeSource) AppendTouchIDs(touches []ebiten.TouchID) []ebiten.TouchID {
+	return append(touches, s.touchIDs...)
+}
+
+func (s *FakeSource) TouchPosition(id ebiten.TouchID) (int, int) {
+	p := s.touches[id]
+	return p.X, p.Y
+}
@@ -47,7 +47,9 @@ var CursorManagementEnabled = true
 // This variable indicates if the UI has currently being hovered over
 var UIHovered = false
 
-var currentCursorUpdater CursorUpdater = internalinput.InputHandler
+// currentCursorUpdater is nil unless a custom CursorUpdater has been set,
+// in which case the handler of the current Source is used.
+var currentCursorUpdater CursorUpdater
 var windowSize image.Point
 
 // If the system cannot find a cursor image, it will revert to the system defaults.
@@ -62,12 +64,16 @@ var windowSize image.Point
 //	CURSOR_TEXT      : "Cursor_Text"
 //	CURSOR_CROSSHAIR : "Cursor_Crosshair"
 func SetCursorUpdater(cursorUpdater CursorUpdater) {
-	if cursorUpdater == nil {
-		cursorUpdater = internalinput.InputHandler
-	}
 	currentCursorUpdater = cursorUpdater
 }
 
+func cursorUpdater() CursorUpdater {
+	if currentCursorUpdater == nil {
+		return internalinput.InputHandler
+	}
+	return currentCursorUpdater
+}
+
 const (
 	CURSOR_DEFAULT   = "Cursor_Default"
 	CURSOR_EWRESIZE  = "Cursor_EWResize"
@@ -94,19 +100,19 @@ func SetCursorImageWithOffset(name string, cursorImage *ebiten.Image, offset ima
 
 // MouseButtonPressed returns whether mouse button b is currently pressed.
 func MouseButtonPressed(b ebiten.MouseButton) bool {
-	return currentCursorUpdater.MouseButtonPressed(b)
+	return cursorUpdater().MouseButtonPressed(b)
 }
 
 // MouseButtonJustPressed returns whether mouse button b has just been pressed.
 // It only returns true during the first frame that the button is pressed.
 func MouseButtonJustPressed(b ebiten.MouseButton) bool {
-	return currentCursorUpdater.MouseButtonJustPressed(b)
+	return cursorUpdater().MouseButtonJustPressed(b)
 }
 
 // MouseButtonJustPressed returns whether mouse button b has just been pressed.
 // It only returns true during the first frame that the button is pressed.
 func MouseButtonJustReleased(b ebiten.MouseButton) bool {
-	return currentCursorUpdater.MouseButtonJustReleased(b)
+	return cursorUpdater().MouseButtonJustReleased(b)
 }
 
 // MouseButtonPressedLayer returns whether mouse button b is currently pressed if input layer l is
@@ -144,7 +150,7 @@ func MouseButtonJustReleasedLayer(b ebiten.MouseButton, l *Layer) bool {
 
 // CursorPosition returns the current cursor position.
 func CursorPosition() (int, int) {
-	return currentCursorUpdater.CursorPosition()
+	return cursorUpdater().CursorPosition()
 }
 
 // Wheel returns current mouse wheel movement.
@@ -179,6 +185,20 @@ func KeyPressed(k ebiten.Key) bool {
 	return ok && p
 }
 
+// GamepadButtonPressed returns whether standard gamepad button b is currently pressed on any
+// connected gamepad with a standard layout.
+func GamepadButtonPressed(b ebiten.StandardGamepadButton) bool {
+	p, ok := internalinput.InputHandler.GamepadButtonPressed[b]
+	return ok && p
+}
+
+// KeyJustPressed returns whether key k has just been pressed.
+// It only returns true during the first frame that the key is pressed.
+func KeyJustPressed(k ebiten.Key) bool {
+	p, ok := internalinput.InputHandler.KeyJustPressed[k]
+	return ok && p
+}
+
 // AnyKeyPressed returns whether any key is currently pressed.
 func AnyKeyPressed() bool {
 	return internalinput.InputHandler.AnyKeyPressed
@@ -191,42 +211,42 @@ func GetWindowSize() image.Point {
 
 func Update() {
 	SetCursorShape(CURSOR_DEFAULT)
-	currentCursorUpdater.Update()
-	if currentCursorUpdater != internalinput.InputHandler {
-		internalinput.InputHandler.Update()
+	if currentCursorUpdater != nil {
+		currentCursorUpdater.Update()
 	}
+	internalinput.InputHandler.Update()
 	internalinput.InternalUIHovered = false
 }
 
 func AfterUpdate() {
-	currentCursorUpdater.AfterUpdate()
-	if currentCursorUpdater != internalinput.InputHandler {
-		internalinput.InputHandler.AfterUpdate()
+	if currentCursorUpdater != nil {
+		currentCursorUpdater.AfterUpdate()
 	}
+	internalinput.InputHandler.AfterUpdate()
 }
 
 func Draw(screen *ebiten.Image) {
 	windowSize = screen.Bounds().Max
-	currentCursorUpdater.Draw(screen)
+	cursorUpdater().Draw(screen)
 }
 
 func AfterDraw(screen *ebiten.Image) {
-	currentCursorUpdater.AfterDraw(screen)
+	cursorUpdater().AfterDraw(screen)
 	UIHovered = internalinput.InternalUIHovered
 	if CursorManagementEnabled {
 		// Process Cursor
-		posX, posY := currentCursorUpdater.CursorPosition()
+		posX, posY := cursorUpdater().CursorPosition()
 		//If cursor outside the window do nothing
 		if posX < 0 || posY < 0 || posX > windowSize.X || posY > windowSize.Y {
 			return
 		}
-		cursorImage := currentCursorUpdater.GetCursorImage(currentCursor)
+		cursorImage := cursorUpdater().GetCursorImage(currentCursor)
 		// If we have a cursor image hide current cursor and use it
 		if cursorImage != nil {
 			if ebiten.CursorMode() != ebiten.CursorModeHidden {
 				ebiten.SetCursorMode(ebiten.CursorModeHidden)
 			}
-			cursorOffset := currentCursorUpdater.GetCursorOffset(currentCursor)
+			cursorOffset := cursorUpdater().GetCursorOffset(currentCursor)
 			op := &ebiten.DrawImageOptions{}
 			op.GeoM.Translate(float64(posX+cursorOffset.X), float64(posY+cursorOffset.Y))
 			screen.DrawImage(cursorImage, op)
@@ -0,0 +1,118 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Modifiers is a set of modifier keys that are held as part of a KeyChord.
+type Modifiers uint8
+
+const (
+	ModifierControl Modifiers = 1 << iota
+	ModifierShift
+	ModifierAlt
+	ModifierMeta
+)
+
+// modifierKeys lists the modifiers in the order they are written in chords.
+var modifierKeys = []struct {
+	modifier Modifiers
+	key      ebiten.Key
+	name     string
+	aliases  []string
+}{
+	{ModifierControl, ebiten.KeyControl, "Ctrl", []string{"ctrl", "control"}},
+	{ModifierAlt, ebiten.KeyAlt, "Alt", []string{"alt", "option"}},
+	{ModifierShift, ebiten.KeyShift, "Shift", []string{"shift"}},
+	{ModifierMeta, ebiten.KeyMeta, "Meta", []string{"meta", "cmd", "super"}},
+}
+
+// KeyChord is a key that is pressed while holding exactly the given modifiers, for example Ctrl+S.
+type KeyChord struct {
+	Key       ebiten.Key
+	Modifiers Modifiers
+}
+
+// ParseKeyChord parses chords like "Ctrl+S", "Alt+1" or "Shift+F5". Modifiers are separated from
+// each other and from the key by "+", key names are those understood by ebiten.Key.UnmarshalText.
+// Both are case-insensitive.
+func ParseKeyChord(s string) (KeyChord, error) {
+	parts := strings.Split(s, "+")
+	chord := KeyChord{}
+
+	for _, p := range parts[:len(parts)-1] {
+		m, ok := parseModifier(strings.TrimSpace(p))
+		if !ok {
+			return KeyChord{}, fmt.Errorf("input: unknown modifier %q in key chord %q", p, s)
+		}
+		chord.Modifiers |= m
+	}
+
+	if err := chord.Key.UnmarshalText([]byte(strings.TrimSpace(parts[len(parts)-1]))); err != nil {
+		return KeyChord{}, fmt.Errorf("input: invalid key in key chord %q: %w", s, err)
+	}
+	return chord, nil
+}
+
+// MustParseKeyChord is like ParseKeyChord but panics if s is not a valid chord.
+func MustParseKeyChord(s string) KeyChord {
+	c, err := ParseKeyChord(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func parseModifier(s string) (Modifiers, bool) {
+	s = strings.ToLower(s)
+	for _, m := range modifierKeys {
+		for _, a := range m.aliases {
+			if s == a {
+				return m.modifier, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// String returns the chord in the form ParseKeyChord accepts, e.g. "Ctrl+Shift+S".
+// It can be used as the accelerator text of menu items.
+func (c KeyChord) String() string {
+	var b strings.Builder
+	for _, m := range modifierKeys {
+		if c.Modifiers&m.modifier != 0 {
+			b.WriteString(m.name)
+			b.WriteString("+")
+		}
+	}
+	name := c.Key.String()
+	name = strings.TrimPrefix(name, "Digit")
+	name = strings.TrimPrefix(name, "Arrow")
+	b.WriteString(name)
+	return b.String()
+}
+
+// ModifiersPressed returns the modifiers that are currently held.
+func ModifiersPressed() Modifiers {
+	var result Modifiers
+	for _, m := range modifierKeys {
+		if KeyPressed(m.key) {
+			result |= m.modifier
+		}
+	}
+	return result
+}
+
+// JustPressed reports whether the key of the chord has just been pressed while exactly
+// its modifiers are held.
+func (c KeyChord) JustPressed() bool {
+	return KeyJustPressed(c.Key) && ModifiersPressed() == c.Modifiers
+}
+
+// Pressed reports whether the key of the chord is held together with exactly its modifiers.
+func (c KeyChord) Pressed() bool {
+	return KeyPressed(c.Key) && ModifiersPressed() == c.Modifiers
+}
@@ -0,0 +1,64 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestParseKeyChord(t *testing.T) {
+	is := is.New(t)
+
+	c, err := ParseKeyChord("ctrl+Shift+s")
+	is.NoErr(err)
+	is.Equal(c, KeyChord{Key: ebiten.KeyS, Modifiers: ModifierControl | ModifierShift})
+	is.Equal(c.String(), "Ctrl+Shift+S")
+
+	c, err = ParseKeyChord("Cmd + 1")
+	is.NoErr(err)
+	is.Equal(c, KeyChord{Key: ebiten.KeyDigit1, Modifiers: ModifierMeta})
+	is.Equal(c.String(), "Meta+1")
+
+	c, err = ParseKeyChord("F5")
+	is.NoErr(err)
+	is.Equal(c, KeyChord{Key: ebiten.KeyF5})
+
+	_, err = ParseKeyChord("Hyper+S")
+	is.True(err != nil)
+	_, err = ParseKeyChord("Ctrl+")
+	is.True(err != nil)
+}
+
+func TestKeyChord_JustPressed(t *testing.T) {
+	is := is.New(t)
+
+	s := useFakeSource(t)
+	save := MustParseKeyChord("Ctrl+S")
+
+	s.PressKey(ebiten.KeyS)
+	update()
+	is.True(!save.JustPressed())
+	s.ReleaseKey(ebiten.KeyS)
+	update()
+
+	// Additional modifiers do not match.
+	s.PressKey(ebiten.KeyControl)
+	s.PressKey(ebiten.KeyShift)
+	s.PressKey(ebiten.KeyS)
+	Update()
+	is.True(!save.JustPressed())
+	AfterUpdate()
+	s.ReleaseKey(ebiten.KeyS)
+	s.ReleaseKey(ebiten.KeyShift)
+	update()
+
+	s.PressKey(ebiten.KeyS)
+	Update()
+	is.True(save.JustPressed())
+	is.True(save.Pressed())
+	AfterUpdate()
+	update()
+	is.True(!save.JustPressed())
+	is.True(save.Pressed())
+}
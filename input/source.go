@@ -0,0 +1,168 @@
+package input
+
+import (
+	"runtime"
+
+	internalinput "github.com/ebitenui/ebitenui/internal/input"
+	"github.com/ebitenui/ebitenui/internal/jsUtil"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Source provides the raw input state that the UI reacts to.
+//
+// The UI calls Update once at the beginning of every frame and then queries the state for that frame.
+// The default Source polls Ebitengine; other implementations can replay recorded input, receive
+// input over the network or script input in tests (see FakeSource).
+//
+// The derived state of a Source, such as "just pressed" buttons, is kept while it is set. The sources of
+// this package also keep it while another source is set, other implementations start over when they
+// are set again. Implementations must be comparable, which pointer types always are.
+type Source interface {
+	// Update advances the source to the next frame.
+	Update()
+	// CursorPosition returns the current cursor position.
+	CursorPosition() (int, int)
+	// MouseButtonPressed returns whether mouse button b is currently pressed.
+	MouseButtonPressed(b ebiten.MouseButton) bool
+	// Wheel returns the mouse wheel movement of the current frame.
+	Wheel() (float64, float64)
+	// AppendInputChars appends the characters typed during the current frame to chars.
+	AppendInputChars(chars []rune) []rune
+	// KeyPressed returns whether key k is currently pressed.
+	KeyPressed(k ebiten.Key) bool
+	// GamepadButtonPressed returns whether standard gamepad button b is currently pressed.
+	GamepadButtonPressed(b ebiten.StandardGamepadButton) bool
+	// AppendTouchIDs appends the IDs of the current touches to touches.
+	AppendTouchIDs(touches []ebiten.TouchID) []ebiten.TouchID
+	// TouchPosition returns the position of touch id.
+	TouchPosition(id ebiten.TouchID) (int, int)
+}
+
+// EbitenSource is the default Source. It polls Ebitengine for the input of the running game.
+type EbitenSource struct {
+	sourceState
+
+	touchscreenPlatform bool
+	touchIDs            []ebiten.TouchID
+	gamepadIDs          []ebiten.GamepadID
+	cursorX             int
+	cursorY             int
+}
+
+var defaultSource Source = NewEbitenSource()
+
+// currentSource is the source that was set last.
+var currentSource Source
+
+// sourceState holds the derived input state of a source of this package. It lives on the source,
+// so that it is kept while another source is set and goes away together with the source.
+type sourceState struct {
+	handler *internalinput.DefaultInternalHandler
+}
+
+func (s *sourceState) inputState() *sourceState {
+	return s
+}
+
+type statefulSource interface {
+	inputState() *sourceState
+}
+
+func init() {
+	SetSource(nil)
+}
+
+// NewEbitenSource returns a Source that polls Ebitengine.
+func NewEbitenSource() *EbitenSource {
+	return &EbitenSource{
+		// A touchscreenPlatform is defined as a device that doesn't have a mouse pointer,
+		// but has a touchscreen input instead.
+		// For native builds, there are Android and IOS; Ebitengine defines a mobile platform
+		// as these two build tags (they will always return {0,0} from ebiten.CursorPosition).
+		// Then we add web builds that are running on a mobile browser.
+		touchscreenPlatform: jsUtil.IsMobileBrowser() || runtime.GOOS == "android" || runtime.GOOS == "ios",
+	}
+}
+
+// SetSource makes src the source of the input reported by this package, until another source is set.
+// If src is nil, the default EbitenSource is used.
+//
+// The UI calls SetSource with its InputSource before it updates or draws, so this only needs to be
+// called directly when driving widgets without a UI.
+func SetSource(src Source) {
+	if src == nil {
+		src = defaultSource
+	}
+
+	if src == currentSource {
+		return
+	}
+	currentSource = src
+
+	s, ok := src.(statefulSource)
+	if !ok {
+		internalinput.InputHandler = internalinput.NewHandler(src)
+		return
+	}
+	state := s.inputState()
+	if state.handler == nil {
+		state.handler = internalinput.NewHandler(src)
+	}
+	internalinput.InputHandler = state.handler
+}
+
+func (s *EbitenSource) Update() {
+	s.gamepadIDs = ebiten.AppendGamepadIDs(s.gamepadIDs[:0])
+
+	// On touchscreen platforms, keep the position of the last touch as the cursor position,
+	// as ebiten.CursorPosition() would set it to (0, 0).
+	if s.touchscreenPlatform {
+		s.touchIDs = ebiten.AppendTouchIDs(s.touchIDs[:0])
+		if len(s.touchIDs) > 0 {
+			s.cursorX, s.cursorY = ebiten.TouchPosition(s.touchIDs[0])
+		}
+	} else {
+		s.cursorX, s.cursorY = ebiten.CursorPosition()
+	}
+}
+
+func (s *EbitenSource) CursorPosition() (int, int) {
+	return s.cursorX, s.cursorY
+}
+
+func (s *EbitenSource) MouseButtonPressed(b ebiten.MouseButton) bool {
+	if s.touchscreenPlatform {
+		return false
+	}
+	return ebiten.IsMouseButtonPressed(b)
+}
+
+func (s *EbitenSource) Wheel() (float64, float64) {
+	return ebiten.Wheel()
+}
+
+func (s *EbitenSource) AppendInputChars(chars []rune) []rune {
+	return ebiten.AppendInputChars(chars)
+}
+
+func (s *EbitenSource) KeyPressed(k ebiten.Key) bool {
+	return ebiten.IsKeyPressed(k)
+}
+
+// GamepadButtonPressed returns whether button b is pressed on any connected gamepad with a standard layout.
+func (s *EbitenSource) GamepadButtonPressed(b ebiten.StandardGamepadButton) bool {
+	for _, id := range s.gamepadIDs {
+		if ebiten.IsStandardGamepadLayoutAvailable(id) && ebiten.IsStandardGamepadButtonPressed(id, b) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *EbitenSource) AppendTouchIDs(touches []ebiten.TouchID) []ebiten.TouchID {
+	return ebiten.AppendTouchIDs(touches)
+}
+
+func (s *EbitenSource) TouchPosition(id ebiten.TouchID) (int, int) {
+	return ebiten.TouchPosition(id)
+}
@@ -0,0 +1,166 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestFakeSource_MouseButton(t *testing.T) {
+	is := is.New(t)
+
+	s := useFakeSource(t)
+	s.SetCursorPosition(10, 20)
+	s.PressMouseButton(ebiten.MouseButtonLeft)
+	update()
+
+	x, y := CursorPosition()
+	is.Equal(x, 10)
+	is.Equal(y, 20)
+	is.True(MouseButtonPressed(ebiten.MouseButtonLeft))
+	is.True(MouseButtonJustPressed(ebiten.MouseButtonLeft))
+
+	update()
+	is.True(MouseButtonPressed(ebiten.MouseButtonLeft))
+	is.True(!MouseButtonJustPressed(ebiten.MouseButtonLeft))
+
+	s.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	update()
+	is.True(!MouseButtonPressed(ebiten.MouseButtonLeft))
+	is.True(MouseButtonJustReleased(ebiten.MouseButtonLeft))
+}
+
+func TestFakeSource_Keys(t *testing.T) {
+	is := is.New(t)
+
+	s := useFakeSource(t)
+	s.PressKey(ebiten.KeyShiftLeft)
+	update()
+
+	is.True(KeyPressed(ebiten.KeyShift))
+	is.True(KeyJustPressed(ebiten.KeyShiftLeft))
+	is.True(AnyKeyPressed())
+
+	update()
+	is.True(!KeyJustPressed(ebiten.KeyShiftLeft))
+
+	s.ReleaseKey(ebiten.KeyShiftLeft)
+	update()
+	is.True(!KeyPressed(ebiten.KeyShift))
+	is.True(!AnyKeyPressed())
+}
+
+func TestFakeSource_CharsAndWheelLastOneFrame(t *testing.T) {
+	is := is.New(t)
+
+	s := useFakeSource(t)
+	s.Type("héllo")
+	s.Scroll(0, -2)
+	Update()
+
+	is.Equal(string(InputChars()), "héllo")
+	_, wy := Wheel()
+	is.Equal(wy, -2.0)
+	AfterUpdate()
+
+	update()
+	is.Equal(len(InputChars()), 0)
+	_, wy = Wheel()
+	is.Equal(wy, 0.0)
+}
+
+func TestFakeSource_Touch(t *testing.T) {
+	is := is.New(t)
+
+	s := useFakeSource(t)
+	s.SetCursorPosition(1, 1)
+	s.Touch(0, 30, 40)
+	update()
+
+	x, y := CursorPosition()
+	is.Equal(x, 30)
+	is.Equal(y, 40)
+	is.True(MouseButtonJustPressed(ebiten.MouseButtonLeft))
+
+	s.ReleaseTouch(0)
+	update()
+	is.True(MouseButtonJustReleased(ebiten.MouseButtonLeft))
+}
+
+func TestFakeSource_Enqueue(t *testing.T) {
+	is := is.New(t)
+
+	s := useFakeSource(t)
+	s.Enqueue(
+		func(s *FakeSource) { s.PressGamepadButton(ebiten.StandardGamepadButtonRightBottom) },
+		func(s *FakeSource) { s.ReleaseGamepadButton(ebiten.StandardGamepadButtonRightBottom) },
+	)
+	is.Equal(s.Pending(), 2)
+
+	update()
+	is.True(GamepadButtonPressed(ebiten.StandardGamepadButtonRightBottom))
+
+	update()
+	is.True(!GamepadButtonPressed(ebiten.StandardGamepadButtonRightBottom))
+	is.Equal(s.Pending(), 0)
+}
+
+func TestSetSource_KeepsStatePerSource(t *testing.T) {
+	is := is.New(t)
+
+	s1 := useFakeSource(t)
+	s1.PressKey(ebiten.KeyA)
+	update()
+
+	s2 := NewFakeSource()
+	SetSource(s2)
+	update()
+	is.True(!KeyPressed(ebiten.KeyA))
+
+	SetSource(s1)
+	is.True(KeyPressed(ebiten.KeyA))
+}
+
+func TestSetSource_OtherSourcesStartOver(t *testing.T) {
+	is := is.New(t)
+
+	s2 := useFakeSource(t)
+
+	fake := NewFakeSource()
+	s1 := &wrappedSource{Source: fake}
+	SetSource(s1)
+	fake.PressKey(ebiten.KeyA)
+	update()
+	is.True(KeyPressed(ebiten.KeyA))
+
+	// Setting the same source again keeps its state.
+	SetSource(s1)
+	is.True(KeyPressed(ebiten.KeyA))
+
+	// Sources not of this package don't keep their state while another source is set.
+	SetSource(s2)
+	SetSource(s1)
+	is.True(!KeyPressed(ebiten.KeyA))
+}
+
+// wrappedSource is a Source implemented outside of this package.
+type wrappedSource struct {
+	Source
+}
+
+func useFakeSource(t *testing.T) *FakeSource {
+	t.Helper()
+
+	s := NewFakeSource()
+	SetSource(s)
+	t.Cleanup(func() {
+		SetSource(nil)
+	})
+	return s
+}
+
+func update() {
+	Update()
+	AfterUpdate()
+}
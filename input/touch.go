@@ -0,0 +1,85 @@
+package input
+
+import (
+	"time"
+
+	internalinput "github.com/ebitenui/ebitenui/internal/input"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// GestureType is the kind of a touch Gesture.
+type GestureType = internalinput.GestureType
+
+const (
+	// GestureTap is a short touch that did not move. It is reported when the touch is released.
+	GestureTap = internalinput.GestureTap
+	// GestureLongPress is a touch that was held without moving for LongPressDuration.
+	GestureLongPress = internalinput.GestureLongPress
+	// GestureSwipe is a fast single-finger movement. It is reported when the touch is released,
+	// after the GesturePan that it ends. DeltaX and DeltaY are the movement from the start.
+	GestureSwipe = internalinput.GestureSwipe
+	// GesturePan is a single- or two-finger drag. DeltaX and DeltaY are the movement since the last frame.
+	// After a single-finger pan is released, it continues with GesturePhaseInertia while its velocity decays.
+	GesturePan = internalinput.GesturePan
+	// GesturePinch is a two-finger pinch. Scale is the change of the distance between the fingers
+	// since the last frame.
+	GesturePinch = internalinput.GesturePinch
+)
+
+// GesturePhase tells where in its course a Gesture is.
+type GesturePhase = internalinput.GesturePhase
+
+const (
+	GesturePhaseBegan   = internalinput.GesturePhaseBegan
+	GesturePhaseChanged = internalinput.GesturePhaseChanged
+	GesturePhaseEnded   = internalinput.GesturePhaseEnded
+	// GesturePhaseInertia is the phase of a GesturePan that keeps moving after it has been released.
+	GesturePhaseInertia = internalinput.GesturePhaseInertia
+)
+
+// Gesture is a touch gesture recognized during the current frame.
+//
+//   - StartX, StartY is where the gesture started, e.g. the first touch of a pan or the center of a pinch.
+//     Widgets receive the gestures that start within them.
+//   - X, Y is the current position, the center of the touches for two-finger gestures.
+//   - DeltaX, DeltaY is the movement, see the gesture types.
+//   - VelocityX, VelocityY is the current velocity in pixels per second.
+//   - Scale is the relative change of a pinch, 1 for other gestures.
+//   - Touches is the number of fingers.
+type Gesture = internalinput.Gesture
+
+// SetGestureThresholds changes how gestures are recognized. touchSlop is the distance in pixels a touch
+// has to move to become a pan, longPress the time a touch has to be held for a long press and
+// swipeVelocity the velocity in pixels per second a pan needs to be released with to be a swipe.
+func SetGestureThresholds(touchSlop float64, longPress time.Duration, swipeVelocity float64) {
+	internalinput.TouchSlop = touchSlop
+	internalinput.LongPressDuration = longPress
+	internalinput.SwipeVelocity = swipeVelocity
+}
+
+// AppendTouchIDs appends the IDs of the current touches to touches.
+func AppendTouchIDs(touches []ebiten.TouchID) []ebiten.TouchID {
+	return append(touches, internalinput.InputHandler.TouchIDs...)
+}
+
+// AppendJustPressedTouchIDs appends the IDs of the touches that started during the current frame to touches.
+func AppendJustPressedTouchIDs(touches []ebiten.TouchID) []ebiten.TouchID {
+	return append(touches, internalinput.InputHandler.JustPressedTouchIDs...)
+}
+
+// AppendJustReleasedTouchIDs appends the IDs of the touches that ended during the current frame to touches.
+func AppendJustReleasedTouchIDs(touches []ebiten.TouchID) []ebiten.TouchID {
+	return append(touches, internalinput.InputHandler.JustReleasedTouchIDs...)
+}
+
+// TouchPosition returns the position of touch id, or 0, 0 if there is no such touch.
+func TouchPosition(id ebiten.TouchID) (int, int) {
+	p := internalinput.InputHandler.TouchPositions[id]
+	return p.X, p.Y
+}
+
+// Gestures returns the gestures recognized during the current frame. The slice must not be kept
+// beyond the frame.
+func Gestures() []Gesture {
+	return internalinput.InputHandler.Gestures
+}
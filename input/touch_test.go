@@ -0,0 +1,157 @@
+package input
+
+import (
+	"testing"
+	"time"
+
+	internalinput "github.com/ebitenui/ebitenui/internal/input"
+	"github.com/ebitenui/ebitenui/utilities/clock"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestGestures_Tap(t *testing.T) {
+	is := is.New(t)
+
+	s, frame := useTouchSource(t)
+	s.Touch(1, 10, 10)
+	frame()
+	is.True(MouseButtonPressed(ebiten.MouseButtonLeft))
+	is.Equal(AppendJustPressedTouchIDs(nil), []ebiten.TouchID{1})
+
+	s.Touch(1, 12, 11)
+	frame()
+	is.Equal(len(Gestures()), 0)
+
+	s.ReleaseTouch(1)
+	frame()
+	is.Equal(AppendJustReleasedTouchIDs(nil), []ebiten.TouchID{1})
+	is.Equal(gestureTypes(), []GestureType{GestureTap})
+	is.True(!MouseButtonPressed(ebiten.MouseButtonLeft))
+}
+
+func TestGestures_LongPress(t *testing.T) {
+	is := is.New(t)
+
+	s, frame := useTouchSource(t)
+	s.Touch(1, 10, 10)
+	var types []GestureType
+	for i := 0; i < 40; i++ {
+		frame()
+		types = append(types, gestureTypes()...)
+	}
+	is.Equal(types, []GestureType{GestureLongPress})
+
+	// A long press is not a tap.
+	s.ReleaseTouch(1)
+	frame()
+	is.Equal(len(Gestures()), 0)
+}
+
+func TestGestures_PanSwipeAndInertia(t *testing.T) {
+	is := is.New(t)
+
+	s, frame := useTouchSource(t)
+	s.Touch(1, 10, 100)
+	frame()
+
+	var dy float64
+	for y := 80; y >= 0; y -= 20 {
+		s.Touch(1, 10, y)
+		frame()
+		g := Gestures()
+		is.Equal(len(g), 1)
+		is.Equal(g[0].Type, GesturePan)
+		is.Equal(g[0].StartY, 100)
+		dy += g[0].DeltaY
+	}
+	is.Equal(dy, -100.0)
+	is.True(internalinput.InputHandler.PointerConsumed)
+
+	s.ReleaseTouch(1)
+	frame()
+	is.Equal(gestureTypes(), []GestureType{GesturePan, GestureSwipe})
+	is.Equal(Gestures()[0].Phase, GesturePhaseEnded)
+	is.Equal(Gestures()[1].DeltaY, -100.0)
+
+	// The pan keeps moving in the same direction, slower and slower, until it stops.
+	last := -1000.0
+	frames := 0
+	for {
+		frame()
+		if len(Gestures()) == 0 {
+			break
+		}
+		g := Gestures()[0]
+		is.Equal(g.Phase, GesturePhaseInertia)
+		is.True(g.DeltaY < 0 && g.DeltaY > last)
+		last = g.DeltaY
+		frames++
+		is.True(frames < 1000)
+	}
+	is.True(frames > 10)
+}
+
+func TestGestures_Pinch(t *testing.T) {
+	is := is.New(t)
+
+	s, frame := useTouchSource(t)
+	s.Touch(1, 100, 100)
+	s.Touch(2, 120, 100)
+	frame()
+	is.Equal(gestureTypes(), []GestureType{GesturePinch, GesturePan})
+	is.Equal(Gestures()[0].Phase, GesturePhaseBegan)
+	is.Equal(Gestures()[0].X, 110)
+
+	s.Touch(1, 90, 100)
+	s.Touch(2, 130, 100)
+	frame()
+	is.Equal(gestureTypes(), []GestureType{GesturePinch})
+	is.Equal(Gestures()[0].Scale, 2.0)
+
+	// Both fingers moving the same way pan with two touches.
+	s.Touch(1, 90, 110)
+	s.Touch(2, 130, 110)
+	frame()
+	is.Equal(gestureTypes(), []GestureType{GesturePan})
+	is.Equal(Gestures()[0].Touches, 2)
+	is.Equal(Gestures()[0].DeltaY, 10.0)
+
+	s.ReleaseTouch(2)
+	frame()
+	is.Equal(gestureTypes(), []GestureType{GesturePinch, GesturePan})
+	is.Equal(Gestures()[0].Phase, GesturePhaseEnded)
+
+	// The remaining finger does not start a new gesture.
+	s.Touch(1, 50, 50)
+	frame()
+	s.ReleaseTouch(1)
+	frame()
+	is.Equal(len(Gestures()), 0)
+	is.True(!MouseButtonPressed(ebiten.MouseButtonLeft))
+}
+
+func gestureTypes() []GestureType {
+	var types []GestureType
+	for _, g := range Gestures() {
+		types = append(types, g.Type)
+	}
+	return types
+}
+
+// useTouchSource sets a FakeSource and a fake clock, and returns the source and a function that runs
+// a frame of 1/60s.
+func useTouchSource(t *testing.T) (*FakeSource, func()) {
+	t.Helper()
+
+	s := useFakeSource(t)
+	c := clock.NewFake(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	clock.Set(c)
+	t.Cleanup(func() {
+		clock.Set(nil)
+	})
+	return s, func() {
+		update()
+		c.Advance(time.Second / 60)
+	}
+}
@@ -0,0 +1,524 @@
+package ebitenui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strconv"
+	"strings"
+
+	e_image "github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/widget"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// These are the IDs of the widgets of the inspector window, to find them with UI.FindByID.
+const (
+	InspectorTreeID       = "ebitenui-inspector-tree"
+	InspectorDetailsID    = "ebitenui-inspector-details"
+	InspectorDisabledID   = "ebitenui-inspector-disabled"
+	InspectorVisibilityID = "ebitenui-inspector-visibility"
+	InspectorMinWidthID   = "ebitenui-inspector-min-width"
+	InspectorMinHeightID  = "ebitenui-inspector-min-height"
+)
+
+// inspector is the window that lists the widgets of a UI as a tree and edits the selected one.
+type inspector struct {
+	ui     *UI
+	window *widget.Window
+
+	tree       *widget.TreeView
+	details    *widget.Text
+	disabled   *widget.Checkbox
+	visibility *widget.Button
+	minWidth   *widget.TextInput
+	minHeight  *widget.TextInput
+
+	selected widget.HasWidget
+	// preferredSize is the preferred size of selected, measured when it was selected.
+	preferredSize *image.Point
+}
+
+// layoutContainer is implemented by widgets that layout their children, like Container.
+type layoutContainer interface {
+	GetLayout() widget.Layouter
+}
+
+// ShowInspector opens a window that lists the widgets of the UI as a tree. The widget selected in the tree
+// is outlined, and its Disabled state, visibility and minimum size can be edited while the UI runs.
+// The window uses theme, or the PrimaryTheme of the UI if theme is nil, so one of them must be set.
+func (u *UI) ShowInspector(theme *widget.Theme) {
+	if u.inspector == nil {
+		u.inspector = newInspector(u)
+	}
+	if u.IsWindowOpen(u.inspector.window) {
+		return
+	}
+
+	if theme == nil {
+		theme = u.PrimaryTheme
+	}
+	u.inspector.window.GetContainer().GetWidget().SetTheme(theme)
+	u.inspector.window.SetLocation(inspectorLocation(u.screenBounds))
+	u.AddWindow(u.inspector.window)
+	u.inspector.reload()
+}
+
+// HideInspector closes the window opened by ShowInspector.
+func (u *UI) HideInspector() {
+	if u.IsInspectorOpen() {
+		u.inspector.window.Close()
+	}
+}
+
+// ToggleInspector opens the window opened by ShowInspector with theme, or closes it if it is open.
+func (u *UI) ToggleInspector(theme *widget.Theme) {
+	if u.IsInspectorOpen() {
+		u.HideInspector()
+	} else {
+		u.ShowInspector(theme)
+	}
+}
+
+// IsInspectorOpen returns whether the window opened by ShowInspector is open.
+func (u *UI) IsInspectorOpen() bool {
+	return u.inspector != nil && u.IsWindowOpen(u.inspector.window)
+}
+
+func newInspector(u *UI) *inspector {
+	i := &inspector{ui: u}
+
+	titleBar := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(e_image.NewNineSliceColor(color.NRGBA{0x30, 0x30, 0x3a, 0xff})),
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout(widget.AnchorLayoutOpts.Padding(widget.NewInsetsSimple(4)))),
+	)
+	titleBar.AddChild(widget.NewText(
+		widget.TextOpts.TextLabel("Inspector"),
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+			VerticalPosition: widget.AnchorLayoutPositionCenter,
+		})),
+	))
+	titleBar.AddChild(widget.NewButton(
+		widget.ButtonOpts.TextLabel("Close"),
+		widget.ButtonOpts.TextPadding(&widget.Insets{Left: 8, Right: 8}),
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+			HorizontalPosition: widget.AnchorLayoutPositionEnd,
+			StretchVertical:    true,
+		})),
+		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
+			u.HideInspector()
+		}),
+	))
+
+	contents := widget.NewContainer(
+		widget.ContainerOpts.BackgroundImage(e_image.NewNineSliceColor(color.NRGBA{0x20, 0x20, 0x28, 0xf0})),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.NewInsetsSimple(8)),
+			widget.RowLayoutOpts.Spacing(6),
+		)),
+	)
+
+	i.tree = widget.NewTreeView(
+		widget.TreeViewOpts.WidgetOpts(
+			widget.WidgetOpts.ID(InspectorTreeID),
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{Stretch: true}),
+			widget.WidgetOpts.MinSize(0, 220),
+		),
+		widget.TreeViewOpts.ChildrenFunc(func(node any) []any {
+			children := widget.Children(node.(widget.HasWidget))
+			result := make([]any, len(children))
+			for idx, c := range children {
+				result[idx] = c
+			}
+			return result
+		}),
+		widget.TreeViewOpts.HasChildrenFunc(func(node any) bool {
+			return len(widget.Children(node.(widget.HasWidget))) > 0
+		}),
+		widget.TreeViewOpts.LabelFunc(func(node any) string {
+			return widgetName(node.(widget.HasWidget))
+		}),
+		widget.TreeViewOpts.NodeSelectedHandler(func(args *widget.TreeViewNodeSelectedEventArgs) {
+			i.selected, _ = args.Node.(widget.HasWidget)
+			i.updateEditors()
+		}),
+	)
+	contents.AddChild(i.tree)
+
+	contents.AddChild(widget.NewButton(
+		widget.ButtonOpts.TextLabel("Refresh"),
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{Stretch: true})),
+		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
+			i.reload()
+		}),
+	))
+
+	i.details = widget.NewText(
+		widget.TextOpts.WidgetOpts(
+			widget.WidgetOpts.ID(InspectorDetailsID),
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{Stretch: true}),
+		),
+	)
+	contents.AddChild(i.details)
+
+	i.disabled = widget.NewCheckbox(
+		widget.CheckboxOpts.TextLabel("Disabled"),
+		widget.CheckboxOpts.WidgetOpts(widget.WidgetOpts.ID(InspectorDisabledID)),
+		widget.CheckboxOpts.StateChangedHandler(func(args *widget.CheckboxChangedEventArgs) {
+			if i.selected != nil {
+				i.selected.GetWidget().Disabled = args.State == widget.WidgetChecked
+			}
+		}),
+	)
+	contents.AddChild(i.disabled)
+
+	i.visibility = widget.NewButton(
+		widget.ButtonOpts.TextLabel(visibilityName(widget.Visibility_Show)),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.ID(InspectorVisibilityID),
+			widget.WidgetOpts.LayoutData(widget.RowLayoutData{Stretch: true}),
+		),
+		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
+			if i.selected != nil {
+				w := i.selected.GetWidget()
+				w.SetVisibility((w.GetVisibility() + 1) % (widget.Visibility_Hide + 1))
+				i.updateEditors()
+			}
+		}),
+	)
+	contents.AddChild(i.visibility)
+
+	minSize := widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewRowLayout(widget.RowLayoutOpts.Spacing(6))),
+	)
+	minSize.AddChild(widget.NewText(
+		widget.TextOpts.TextLabel("Min size"),
+		widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
+			Position: widget.RowLayoutPositionCenter,
+		})),
+	))
+	i.minWidth = i.newSizeInput(InspectorMinWidthID)
+	minSize.AddChild(i.minWidth)
+	i.minHeight = i.newSizeInput(InspectorMinHeightID)
+	minSize.AddChild(i.minHeight)
+	contents.AddChild(minSize)
+
+	i.window = widget.NewWindow(
+		widget.WindowOpts.Contents(contents),
+		widget.WindowOpts.TitleBar(titleBar, 30),
+		widget.WindowOpts.Draggable(),
+		widget.WindowOpts.Resizeable(),
+		widget.WindowOpts.MinSize(260, 300),
+		widget.WindowOpts.CloseMode(widget.NONE),
+	)
+
+	i.updateEditors()
+	return i
+}
+
+// newSizeInput returns a text input that sets the minimum size of the selected widget when submitted.
+func (i *inspector) newSizeInput(id string) *widget.TextInput {
+	return widget.NewTextInput(
+		widget.TextInputOpts.WidgetOpts(
+			widget.WidgetOpts.ID(id),
+			widget.WidgetOpts.MinSize(60, 0),
+		),
+		widget.TextInputOpts.Validation(func(newInputText string) (bool, *string) {
+			_, err := strconv.Atoi(newInputText)
+			return newInputText == "" || err == nil, nil
+		}),
+		widget.TextInputOpts.AllowDuplicateSubmit(true),
+		widget.TextInputOpts.SubmitHandler(func(_ *widget.TextInputChangedEventArgs) {
+			i.setMinSize()
+		}),
+	)
+}
+
+// reload lists the current widgets of the UI in the tree.
+func (i *inspector) reload() {
+	roots := []any{}
+	if i.ui.Container != nil {
+		roots = append(roots, i.ui.Container)
+	}
+	for _, w := range i.ui.windows {
+		if w != i.window && !w.Ephemeral {
+			roots = append(roots, w.GetContainer())
+		}
+	}
+	i.tree.SetRoots(roots...)
+	if i.selected != nil {
+		i.tree.ExpandTo(i.selected)
+	}
+	i.updateEditors()
+}
+
+// updateEditors shows the properties of the selected widget.
+func (i *inspector) updateEditors() {
+	editable := i.selected != nil
+	for _, w := range []widget.HasWidget{i.disabled, i.visibility, i.minWidth, i.minHeight} {
+		w.GetWidget().Disabled = !editable
+	}
+	i.preferredSize = nil
+	if !editable {
+		i.details.Label = "Select a widget."
+		return
+	}
+
+	// Measuring may initialize the widget, so it is only done here rather than on every update.
+	i.preferredSize = measureWidget(i.selected)
+
+	w := i.selected.GetWidget()
+	i.details.Label = describeWidget(i.selected, i.preferredSize)
+	state := widget.WidgetUnchecked
+	if w.Disabled {
+		state = widget.WidgetChecked
+	}
+	i.disabled.SetState(state)
+	i.visibility.SetText(visibilityName(w.GetVisibility()))
+	i.minWidth.SetText(strconv.Itoa(w.MinWidth))
+	i.minHeight.SetText(strconv.Itoa(w.MinHeight))
+}
+
+// setMinSize sets the minimum size of the selected widget to the values entered.
+func (i *inspector) setMinSize() {
+	if i.selected == nil {
+		return
+	}
+	w := i.selected.GetWidget()
+	width, err := strconv.Atoi(i.minWidth.GetText())
+	if err != nil {
+		width = w.MinWidth
+	}
+	height, err := strconv.Atoi(i.minHeight.GetText())
+	if err != nil {
+		height = w.MinHeight
+	}
+	w.SetMinSize(max(width, 0), max(height, 0))
+	i.updateEditors()
+}
+
+// update keeps the details of the selected widget current, as its location changes while the UI runs.
+func (i *inspector) update() {
+	if i.selected != nil {
+		i.details.Label = describeWidget(i.selected, i.preferredSize)
+	}
+}
+
+// contains returns whether p is inside the inspector window.
+func (i *inspector) contains(p image.Point) bool {
+	return p.In(i.window.GetContainer().GetWidget().Rect)
+}
+
+// inspectorLocation returns where the inspector window opens on a screen with the given bounds.
+func inspectorLocation(screen image.Rectangle) image.Rectangle {
+	const width, height, margin = 320, 560, 10
+	if screen.Dx() < width+2*margin {
+		return image.Rect(margin, margin, margin+width, margin+height)
+	}
+	return image.Rect(screen.Max.X-width-margin, screen.Min.Y+margin, screen.Max.X-margin, screen.Min.Y+margin+min(height, screen.Dy()-2*margin))
+}
+
+// drawDebugOverlay outlines all widgets of the UI in the color of the layout that locates them, shades the
+// padding of layouts and the insets of AnchorLayoutData, and describes the widget below the cursor.
+func (u *UI) drawDebugOverlay(screen *ebiten.Image) {
+	x, y := input.CursorPosition()
+	cursor := image.Pt(x, y)
+	var hovered widget.HasWidget
+
+	var draw func(w widget.HasWidget, parentLayout widget.Layouter, parentRect image.Rectangle)
+	draw = func(w widget.HasWidget, parentLayout widget.Layouter, parentRect image.Rectangle) {
+		wd := w.GetWidget()
+		if wd.GetVisibility() != widget.Visibility_Show {
+			return
+		}
+
+		c := layoutColor(parentLayout)
+		if ld, ok := wd.LayoutData.(widget.AnchorLayoutData); ok && ld.Padding != nil {
+			fillBand(screen, parentRect, ld.Padding.Apply(parentRect), withAlpha(c, 0x20))
+		}
+
+		rect := wd.Rect
+		var layout widget.Layouter
+		if l, ok := w.(layoutContainer); ok {
+			layout = l.GetLayout()
+			if p, ok := layout.(widget.PaddedLayouter); ok && p.GetPadding() != nil {
+				rect = p.GetPadding().Apply(wd.Rect)
+				fillBand(screen, wd.Rect, rect, withAlpha(layoutColor(layout), 0x40))
+			}
+		}
+		strokeRect(screen, wd.Rect, 1, c)
+
+		if cursor.In(wd.Rect) {
+			hovered = w
+		}
+		for _, child := range widget.Children(w) {
+			draw(child, layout, rect)
+		}
+	}
+
+	if u.Container != nil {
+		draw(u.Container, nil, screen.Bounds())
+	}
+	for _, w := range u.windows {
+		if u.inspector == nil || w != u.inspector.window {
+			draw(w.GetContainer(), nil, screen.Bounds())
+		}
+	}
+
+	if u.IsInspectorOpen() && u.inspector.contains(cursor) {
+		return
+	}
+	if hovered == nil {
+		u.hovered, u.hoveredSize = nil, nil
+		return
+	}
+	if hovered != u.hovered {
+		// Measuring may initialize the widget, so it is only done when another widget is hovered.
+		u.hovered = hovered
+		u.hoveredSize = measureWidget(hovered)
+	}
+	strokeRect(screen, hovered.GetWidget().Rect, 2, color.White)
+	drawWidgetInfo(screen, describeWidget(hovered, u.hoveredSize), cursor)
+}
+
+// drawInspectorSelection outlines the widget selected in the inspector.
+func (u *UI) drawInspectorSelection(screen *ebiten.Image) {
+	if u.IsInspectorOpen() && u.inspector.selected != nil {
+		strokeRect(screen, u.inspector.selected.GetWidget().Rect, 3, color.NRGBA{0xff, 0x00, 0xff, 0xff})
+	}
+}
+
+// drawWidgetInfo draws info next to the cursor, keeping it on the screen.
+func drawWidgetInfo(screen *ebiten.Image, info string, cursor image.Point) {
+	// The size of the characters of the debug font.
+	const charWidth, lineHeight, margin = 6, 16, 4
+
+	lines := strings.Split(info, "\n")
+	width := 0
+	for _, l := range lines {
+		width = max(width, len(l))
+	}
+	size := image.Pt(width*charWidth+2*margin, len(lines)*lineHeight+2*margin)
+
+	bounds := screen.Bounds()
+	p := cursor.Add(image.Pt(16, 16))
+	if p.X+size.X > bounds.Max.X {
+		p.X = max(bounds.Max.X-size.X, bounds.Min.X)
+	}
+	if p.Y+size.Y > bounds.Max.Y {
+		p.Y = max(cursor.Y-size.Y-4, bounds.Min.Y)
+	}
+
+	vector.DrawFilledRect(screen, float32(p.X), float32(p.Y), float32(size.X), float32(size.Y), color.NRGBA{0x00, 0x00, 0x00, 0xd0}, false)
+	ebitenutil.DebugPrintAt(screen, info, p.X+margin, p.Y+margin)
+}
+
+// describeWidget returns the type, location, sizes and data of w, one per line. It only reads the state
+// of w, as it is called while the UI runs, so the preferred size is passed in if it has been measured.
+func describeWidget(w widget.HasWidget, preferredSize *image.Point) string {
+	wd := w.GetWidget()
+
+	var b strings.Builder
+	b.WriteString(widgetName(w))
+	fmt.Fprintf(&b, "\nRect: %v %dx%d", wd.Rect, wd.Rect.Dx(), wd.Rect.Dy())
+	if preferredSize != nil {
+		fmt.Fprintf(&b, "\nPreferred size: %dx%d", preferredSize.X, preferredSize.Y)
+	}
+	fmt.Fprintf(&b, "\nMin size: %dx%d", wd.MinWidth, wd.MinHeight)
+	if l, ok := w.(layoutContainer); ok && l.GetLayout() != nil {
+		fmt.Fprintf(&b, "\nLayout: %T", l.GetLayout())
+	}
+	if wd.LayoutData != nil {
+		fmt.Fprintf(&b, "\nLayout data: %T%+v", wd.LayoutData, wd.LayoutData)
+	}
+	if wd.CustomData != nil {
+		fmt.Fprintf(&b, "\nCustom data: %v", wd.CustomData)
+	}
+	return b.String()
+}
+
+// measureWidget returns the preferred size of w, or nil if it has none.
+func measureWidget(w widget.HasWidget) *image.Point {
+	p, ok := w.(widget.PreferredSizer)
+	if !ok {
+		return nil
+	}
+	width, height := p.PreferredSize()
+	return &image.Point{width, height}
+}
+
+// widgetName returns the type of w without its package, followed by its ID if it has one.
+func widgetName(w widget.HasWidget) string {
+	name := fmt.Sprintf("%T", w)
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	if id := w.GetWidget().ID; id != "" {
+		name += " #" + id
+	}
+	return name
+}
+
+// visibilityName returns the label of the visibility button for v.
+func visibilityName(v widget.Visibility) string {
+	switch v {
+	case widget.Visibility_Hide_Blocking:
+		return "Visibility: Hide (blocking)"
+	case widget.Visibility_Hide:
+		return "Visibility: Hide"
+	case widget.Visibility_Show:
+		// Do nothing
+	}
+	return "Visibility: Show"
+}
+
+// layoutColor returns the color of the outlines of widgets located by l.
+func layoutColor(l widget.Layouter) color.NRGBA {
+	switch l.(type) {
+	case *widget.RowLayout:
+		return color.NRGBA{0x4c, 0xaf, 0x50, 0xff}
+	case *widget.GridLayout:
+		return color.NRGBA{0x21, 0x96, 0xf3, 0xff}
+	case *widget.AnchorLayout:
+		return color.NRGBA{0xff, 0x98, 0x00, 0xff}
+	case *widget.StackedLayout:
+		return color.NRGBA{0x9c, 0x27, 0xb0, 0xff}
+	case *widget.FlowLayout:
+		return color.NRGBA{0x00, 0xbc, 0xd4, 0xff}
+	case *widget.FlexLayout:
+		return color.NRGBA{0xff, 0xeb, 0x3b, 0xff}
+	}
+	return color.NRGBA{0x9e, 0x9e, 0x9e, 0xff}
+}
+
+func withAlpha(c color.NRGBA, a uint8) color.NRGBA {
+	c.A = a
+	return c
+}
+
+func strokeRect(screen *ebiten.Image, r image.Rectangle, width float32, c color.Color) {
+	vector.StrokeRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), width, c, false)
+}
+
+// fillBand fills the part of outer that is not inside inner.
+func fillBand(screen *ebiten.Image, outer image.Rectangle, inner image.Rectangle, c color.Color) {
+	inner = inner.Intersect(outer)
+	if inner.Empty() {
+		return
+	}
+	for _, r := range []image.Rectangle{
+		image.Rect(outer.Min.X, outer.Min.Y, outer.Max.X, inner.Min.Y),
+		image.Rect(outer.Min.X, inner.Max.Y, outer.Max.X, outer.Max.Y),
+		image.Rect(outer.Min.X, inner.Min.Y, inner.Min.X, inner.Max.Y),
+		image.Rect(inner.Max.X, inner.Min.Y, outer.Max.X, inner.Max.Y),
+	} {
+		if !r.Empty() {
+			vector.DrawFilledRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), c, false)
+		}
+	}
+}
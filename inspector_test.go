@@ -0,0 +1,54 @@
+package ebitenui_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/themes"
+	"github.com/ebitenui/ebitenui/uitest"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestUI_Inspector(t *testing.T) {
+	is := is.New(t)
+
+	ui, button, _ := newShortcutUI()
+	ui.SetDebugMode(true)
+	h := uitest.New(t, ui, 800, 600)
+
+	ui.ToggleInspector(themes.GetBasicDarkTheme())
+	h.Frame()
+	is.True(ui.IsInspectorOpen())
+
+	tree, ok := ui.FindByID(ebitenui.InspectorTreeID).(*widget.TreeView)
+	is.True(ok)
+	tree.SetSelectedNode(button)
+	h.Frame()
+
+	details, ok := ui.FindByID(ebitenui.InspectorDetailsID).(*widget.Text)
+	is.True(ok)
+	is.True(strings.HasPrefix(details.Label, "Button\nRect: (0,0)-(100,50) 100x50"))
+	is.True(strings.Contains(details.Label, "\nPreferred size: "))
+
+	h.Click(ui.FindByID(ebitenui.InspectorDisabledID))
+	is.True(button.GetWidget().Disabled)
+
+	h.Click(ui.FindByID(ebitenui.InspectorVisibilityID))
+	is.Equal(button.GetWidget().GetVisibility(), widget.Visibility_Hide_Blocking)
+
+	minWidth, ok := ui.FindByID(ebitenui.InspectorMinWidthID).(*widget.TextInput)
+	is.True(ok)
+	is.Equal(minWidth.GetText(), "100")
+	h.Click(minWidth)
+	minWidth.SetText("150")
+	h.PressKey(ebiten.KeyEnter)
+	is.Equal(button.GetWidget().MinWidth, 150)
+	is.Equal(button.GetWidget().MinHeight, 30)
+
+	ui.ToggleInspector(themes.GetBasicDarkTheme())
+	h.Frame()
+	is.True(!ui.IsInspectorOpen())
+}
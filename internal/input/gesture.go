@@ -0,0 +1,243 @@
+package input
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type GestureType int
+
+const (
+	GestureTap GestureType = iota
+	GestureLongPress
+	GestureSwipe
+	GesturePan
+	GesturePinch
+)
+
+type GesturePhase int
+
+const (
+	GesturePhaseBegan GesturePhase = iota
+	GesturePhaseChanged
+	GesturePhaseEnded
+	GesturePhaseInertia
+)
+
+// Gesture is a gesture recognized from the touches of a frame. See the public input package
+// for the documentation of the fields.
+type Gesture struct {
+	Type      GestureType
+	Phase     GesturePhase
+	StartX    int
+	StartY    int
+	X         int
+	Y         int
+	DeltaX    float64
+	DeltaY    float64
+	VelocityX float64
+	VelocityY float64
+	Scale     float64
+	Touches   int
+}
+
+// Gesture recognition thresholds, in pixels and pixels per second.
+var (
+	TouchSlop          = 8.0
+	LongPressDuration  = 500 * time.Millisecond
+	SwipeVelocity      = 600.0
+	InertiaMinVelocity = 30.0
+	// InertiaDecay is the fraction of the velocity that is left after one second of inertia.
+	InertiaDecay = 0.02
+)
+
+type gestureMode int
+
+const (
+	gestureModeNone gestureMode = iota
+	gestureModePending
+	gestureModePan
+	gestureModePinch
+	// gestureModeDone ignores the touches until all of them are released.
+	gestureModeDone
+)
+
+type gestureRecognizer struct {
+	mode      gestureMode
+	touches   int
+	start     image.Point
+	startTime time.Time
+	last      image.Point
+	lastTime  time.Time
+	vx        float64
+	vy        float64
+	distance  float64
+	inertia   bool
+
+	positions []image.Point
+	gestures  []Gesture
+}
+
+// update recognizes the gestures of the touches ids at the time now.
+func (r *gestureRecognizer) update(source Source, ids []ebiten.TouchID, now time.Time) {
+	r.gestures = r.gestures[:0]
+
+	r.positions = r.positions[:0]
+	for _, id := range ids {
+		x, y := source.TouchPosition(id)
+		r.positions = append(r.positions, image.Pt(x, y))
+	}
+	n := len(r.positions)
+	c := centroid(r.positions)
+
+	dt := 0.0
+	if !r.lastTime.IsZero() {
+		dt = now.Sub(r.lastTime).Seconds()
+	}
+	r.lastTime = now
+
+	if r.inertia {
+		r.updateInertia(n, dt)
+	}
+
+	if n != r.touches {
+		r.touchesChanged(n, c, now)
+	} else if n > 0 {
+		r.touchesMoved(c, dt, now)
+	}
+
+	r.touches = n
+	r.last = c
+}
+
+func (r *gestureRecognizer) updateInertia(touches int, dt float64) {
+	if touches > 0 || dt <= 0 {
+		r.inertia = touches == 0
+		return
+	}
+
+	decay := math.Pow(InertiaDecay, dt)
+	r.vx *= decay
+	r.vy *= decay
+	if math.Hypot(r.vx, r.vy) < InertiaMinVelocity {
+		r.inertia = false
+		return
+	}
+	r.emit(GesturePan, GesturePhaseInertia, r.vx*dt, r.vy*dt, 1)
+}
+
+func (r *gestureRecognizer) touchesChanged(n int, c image.Point, now time.Time) {
+	switch r.mode {
+	case gestureModePending:
+		if n == 0 && now.Sub(r.startTime) < LongPressDuration {
+			r.emit(GestureTap, GesturePhaseEnded, 0, 0, 1)
+		}
+	case gestureModePan:
+		r.emit(GesturePan, GesturePhaseEnded, 0, 0, 1)
+		if n == 0 {
+			if math.Hypot(r.vx, r.vy) >= SwipeVelocity {
+				r.emit(GestureSwipe, GesturePhaseEnded, float64(r.last.X-r.start.X), float64(r.last.Y-r.start.Y), 1)
+			}
+			r.inertia = math.Hypot(r.vx, r.vy) >= InertiaMinVelocity
+		}
+	case gestureModePinch:
+		r.emit(GesturePinch, GesturePhaseEnded, 0, 0, 2)
+		r.emit(GesturePan, GesturePhaseEnded, 0, 0, 2)
+	}
+
+	switch {
+	case n == 0:
+		r.mode = gestureModeNone
+	case r.touches == 0 && n == 1:
+		r.mode = gestureModePending
+		r.start = c
+		r.startTime = now
+		r.vx, r.vy = 0, 0
+		r.inertia = false
+	case n == 2 && r.mode != gestureModeDone:
+		r.mode = gestureModePinch
+		r.start = c
+		r.distance = distance(r.positions[0], r.positions[1])
+		r.vx, r.vy = 0, 0
+		r.last = c
+		r.emit(GesturePinch, GesturePhaseBegan, 0, 0, 2)
+		r.emit(GesturePan, GesturePhaseBegan, 0, 0, 2)
+	default:
+		r.mode = gestureModeDone
+	}
+}
+
+func (r *gestureRecognizer) touchesMoved(c image.Point, dt float64, now time.Time) {
+	dx, dy := float64(c.X-r.last.X), float64(c.Y-r.last.Y)
+	if dt > 0 {
+		// Smooth the velocity, so that a single uneven frame does not decide the speed of a fling.
+		r.vx = r.vx*0.3 + dx/dt*0.7
+		r.vy = r.vy*0.3 + dy/dt*0.7
+	}
+
+	switch r.mode {
+	case gestureModePending:
+		if distance(c, r.start) > TouchSlop {
+			r.mode = gestureModePan
+			r.emit(GesturePan, GesturePhaseBegan, float64(c.X-r.start.X), float64(c.Y-r.start.Y), 1)
+		} else if now.Sub(r.startTime) >= LongPressDuration {
+			r.mode = gestureModeDone
+			r.emit(GestureLongPress, GesturePhaseBegan, 0, 0, 1)
+		}
+	case gestureModePan:
+		if dx != 0 || dy != 0 {
+			r.emit(GesturePan, GesturePhaseChanged, dx, dy, 1)
+		}
+	case gestureModePinch:
+		if dx != 0 || dy != 0 {
+			r.emit(GesturePan, GesturePhaseChanged, dx, dy, 2)
+		}
+		d := distance(r.positions[0], r.positions[1])
+		if r.distance > 0 && d != r.distance {
+			g := r.emit(GesturePinch, GesturePhaseChanged, 0, 0, 2)
+			g.Scale = d / r.distance
+		}
+		r.distance = d
+	}
+}
+
+// emit adds a gesture at the current position and returns it for further changes.
+func (r *gestureRecognizer) emit(t GestureType, phase GesturePhase, dx float64, dy float64, touches int) *Gesture {
+	p := r.last
+	if len(r.positions) > 0 {
+		p = centroid(r.positions)
+	}
+	r.gestures = append(r.gestures, Gesture{
+		Type:      t,
+		Phase:     phase,
+		StartX:    r.start.X,
+		StartY:    r.start.Y,
+		X:         p.X,
+		Y:         p.Y,
+		DeltaX:    dx,
+		DeltaY:    dy,
+		VelocityX: r.vx,
+		VelocityY: r.vy,
+		Scale:     1,
+		Touches:   touches,
+	})
+	return &r.gestures[len(r.gestures)-1]
+}
+
+func centroid(points []image.Point) image.Point {
+	if len(points) == 0 {
+		return image.Point{}
+	}
+	var c image.Point
+	for _, p := range points {
+		c = c.Add(p)
+	}
+	return c.Div(len(points))
+}
+
+func distance(a image.Point, b image.Point) float64 {
+	return math.Hypot(float64(a.X-b.X), float64(a.Y-b.Y))
+}
@@ -2,12 +2,26 @@ package input
 
 import (
 	"image"
-	"runtime"
+	"slices"
 
-	"github.com/ebitenui/ebitenui/internal/jsUtil"
+	"github.com/ebitenui/ebitenui/utilities/clock"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// Source has the same methods as the public input.Source, which cannot be referenced
+// from here without an import cycle.
+type Source interface {
+	Update()
+	CursorPosition() (int, int)
+	MouseButtonPressed(b ebiten.MouseButton) bool
+	Wheel() (float64, float64)
+	AppendInputChars(chars []rune) []rune
+	KeyPressed(k ebiten.Key) bool
+	GamepadButtonPressed(b ebiten.StandardGamepadButton) bool
+	AppendTouchIDs(touches []ebiten.TouchID) []ebiten.TouchID
+	TouchPosition(id ebiten.TouchID) (int, int)
+}
+
 type DefaultInternalHandler struct {
 	LeftMouseButtonPressed   bool
 	MiddleMouseButtonPressed bool
@@ -29,77 +43,96 @@ type DefaultInternalHandler struct {
 	LastMiddleMouseButtonPressed bool
 	LastRightMouseButtonPressed  bool
 
-	InputChars    []rune
-	KeyPressed    map[ebiten.Key]bool
-	AnyKeyPressed bool
-	isTouched     bool
-	cursorImages  map[string]*ebiten.Image
-	cursorOffset  map[string]image.Point
+	// GamepadButtonPressed holds the state of the standard gamepad buttons.
+	GamepadButtonPressed map[ebiten.StandardGamepadButton]bool
+
+	InputChars     []rune
+	KeyPressed     map[ebiten.Key]bool
+	KeyJustPressed map[ebiten.Key]bool
+	AnyKeyPressed  bool
+	isTouched      bool
+
+	// TouchIDs are the current touches, TouchPositions their positions.
+	TouchIDs             []ebiten.TouchID
+	TouchPositions       map[ebiten.TouchID]image.Point
+	JustPressedTouchIDs  []ebiten.TouchID
+	JustReleasedTouchIDs []ebiten.TouchID
+	// Gestures are the gestures recognized in the current frame.
+	Gestures []Gesture
+	// PointerConsumed is set once the touch that acts as the left mouse button has become
+	// a pan or a pinch, or the left mouse button has started a drag, so that releasing it is not a click.
+	PointerConsumed bool
 
-	touchscreenPlatform bool
-	touchIDs            []ebiten.TouchID
+	source       Source
+	primaryTouch ebiten.TouchID
+	lastTouchIDs []ebiten.TouchID
+	gestures     gestureRecognizer
 }
 
 var InternalUIHovered = false
 
-var InputHandler *DefaultInternalHandler = &DefaultInternalHandler{
-	// A touchscreenPlatform is defined as a device that doesn't have a mouse pointer,
-	// but has a touchscreen input instead.
-	// For native builds, there are Android and IOS; Ebitengine defines a mobile platform
-	// as these two build tags (they will always return {0,0} from ebiten.CursorPosition).
-	// Then we add web builds that are running on a mobile browser.
-	//
-	// TODO: maybe move this platform-detection code to somewhere else?
-	// There should be a context-like object that would infer the preferred platform
-	// input options.
-	touchscreenPlatform: jsUtil.IsMobileBrowser() || runtime.GOOS == "android" || runtime.GOOS == "ios",
-
-	KeyPressed:   make(map[ebiten.Key]bool),
-	cursorImages: make(map[string]*ebiten.Image),
-	cursorOffset: make(map[string]image.Point),
+// Cursor images are shared by all handlers so that they survive switching input sources.
+var cursorImages = make(map[string]*ebiten.Image)
+var cursorOffset = make(map[string]image.Point)
+
+// InputHandler is the handler of the input source currently in use.
+var InputHandler *DefaultInternalHandler = NewHandler(nil)
+
+// NewHandler returns a handler that reads its input from source. A handler without a source
+// never reports any input.
+func NewHandler(source Source) *DefaultInternalHandler {
+	return &DefaultInternalHandler{
+		KeyPressed:           make(map[ebiten.Key]bool),
+		KeyJustPressed:       make(map[ebiten.Key]bool),
+		GamepadButtonPressed: make(map[ebiten.StandardGamepadButton]bool),
+		TouchPositions:       make(map[ebiten.TouchID]image.Point),
+		source:               source,
+	}
 }
 
 // Update updates the input system. This is called by the UI.
 func (handler *DefaultInternalHandler) Update() {
-	handler.touchIDs = ebiten.AppendTouchIDs(handler.touchIDs[:0])
-	if len(handler.touchIDs) > 0 {
-		handler.isTouched = true
+	if handler.source == nil {
+		return
 	}
+	handler.source.Update()
+
+	handler.updateTouches()
 	if handler.isTouched {
-		if len(handler.touchIDs) > 0 {
+		// The first touch acts as the left mouse button until it is released, even if other touches
+		// are added or released in the meantime.
+		if p, ok := handler.TouchPositions[handler.primaryTouch]; ok {
 			handler.LeftMouseButtonPressed = true
-			handler.CursorX, handler.CursorY = ebiten.TouchPosition(handler.touchIDs[0])
+			handler.CursorX, handler.CursorY = p.X, p.Y
 		} else {
 			handler.LeftMouseButtonPressed = false
 			handler.isTouched = false
 		}
-	} else if !handler.touchscreenPlatform {
-		// Only execute this branch on non-mobile platforms.
-		// This is a workaround to keep the touch position intact,
-		// as ebiten.CursorPosition() would set it to (0, 0).
-		//
-		// TODO: maybe get rid of this special condition when fireEvents are
-		// moved to the Update() tree.
-		// See issue #100.
-		handler.LeftMouseButtonPressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
-		handler.MiddleMouseButtonPressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonMiddle)
-		handler.RightMouseButtonPressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
-		handler.CursorX, handler.CursorY = ebiten.CursorPosition()
+	} else {
+		handler.LeftMouseButtonPressed = handler.source.MouseButtonPressed(ebiten.MouseButtonLeft)
+		handler.MiddleMouseButtonPressed = handler.source.MouseButtonPressed(ebiten.MouseButtonMiddle)
+		handler.RightMouseButtonPressed = handler.source.MouseButtonPressed(ebiten.MouseButtonRight)
+		handler.CursorX, handler.CursorY = handler.source.CursorPosition()
 	}
 
-	wx, wy := ebiten.Wheel()
+	wx, wy := handler.source.Wheel()
 	handler.WheelX += wx
 	handler.WheelY += wy
 
-	handler.InputChars = ebiten.AppendInputChars(handler.InputChars)
+	handler.InputChars = handler.source.AppendInputChars(handler.InputChars)
 	handler.AnyKeyPressed = false
 	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
-		p := ebiten.IsKeyPressed(k)
+		p := handler.source.KeyPressed(k)
+		handler.KeyJustPressed[k] = p && !handler.KeyPressed[k]
 		handler.KeyPressed[k] = p
 		if p {
 			handler.AnyKeyPressed = true
 		}
 	}
+
+	for b := ebiten.StandardGamepadButton(0); b <= ebiten.StandardGamepadButtonMax; b++ {
+		handler.GamepadButtonPressed[b] = handler.source.GamepadButtonPressed(b)
+	}
 	handler.LeftMouseButtonJustPressed = handler.LeftMouseButtonPressed && handler.LeftMouseButtonPressed != handler.LastLeftMouseButtonPressed
 	handler.MiddleMouseButtonJustPressed = handler.MiddleMouseButtonPressed && handler.MiddleMouseButtonPressed != handler.LastMiddleMouseButtonPressed
 	handler.RightMouseButtonJustPressed = handler.RightMouseButtonPressed && handler.RightMouseButtonPressed != handler.LastRightMouseButtonPressed
@@ -113,6 +146,47 @@ func (handler *DefaultInternalHandler) Update() {
 	handler.LastRightMouseButtonPressed = handler.RightMouseButtonPressed
 }
 
+// updateTouches tracks the touches of the source and recognizes gestures from them.
+func (handler *DefaultInternalHandler) updateTouches() {
+	if !handler.isTouched && !handler.LastLeftMouseButtonPressed {
+		// The release of the primary touch or the left mouse button has been handled in the previous frame.
+		handler.PointerConsumed = false
+	}
+	handler.lastTouchIDs = append(handler.lastTouchIDs[:0], handler.TouchIDs...)
+	handler.TouchIDs = handler.source.AppendTouchIDs(handler.TouchIDs[:0])
+	clear(handler.TouchPositions)
+	for _, id := range handler.TouchIDs {
+		x, y := handler.source.TouchPosition(id)
+		handler.TouchPositions[id] = image.Pt(x, y)
+	}
+
+	handler.JustPressedTouchIDs = handler.JustPressedTouchIDs[:0]
+	for _, id := range handler.TouchIDs {
+		if !slices.Contains(handler.lastTouchIDs, id) {
+			handler.JustPressedTouchIDs = append(handler.JustPressedTouchIDs, id)
+		}
+	}
+	handler.JustReleasedTouchIDs = handler.JustReleasedTouchIDs[:0]
+	for _, id := range handler.lastTouchIDs {
+		if _, ok := handler.TouchPositions[id]; !ok {
+			handler.JustReleasedTouchIDs = append(handler.JustReleasedTouchIDs, id)
+		}
+	}
+
+	if !handler.isTouched && len(handler.TouchIDs) > 0 {
+		handler.isTouched = true
+		handler.primaryTouch = handler.TouchIDs[0]
+	}
+
+	handler.gestures.update(handler.source, handler.TouchIDs, clock.Now())
+	handler.Gestures = handler.gestures.gestures
+	for _, g := range handler.Gestures {
+		if g.Phase == GesturePhaseBegan && (g.Type == GesturePan || g.Type == GesturePinch) {
+			handler.PointerConsumed = true
+		}
+	}
+}
+
 func (handler *DefaultInternalHandler) AfterUpdate() {
 	handler.InputChars = handler.InputChars[:0]
 	handler.WheelX, handler.WheelY = 0, 0
@@ -170,13 +244,13 @@ func (handler *DefaultInternalHandler) CursorPosition() (int, int) {
 }
 
 func (handler *DefaultInternalHandler) GetCursorImage(name string) *ebiten.Image {
-	return handler.cursorImages[name]
+	return cursorImages[name]
 }
 
 func (handler *DefaultInternalHandler) GetCursorOffset(name string) image.Point {
-	return handler.cursorOffset[name]
+	return cursorOffset[name]
 }
 func (handler *DefaultInternalHandler) SetCursorImage(name string, cursorImage *ebiten.Image, offset image.Point) {
-	handler.cursorImages[name] = cursorImage
-	handler.cursorOffset[name] = offset
+	cursorImages[name] = cursorImage
+	cursorOffset[name] = offset
 }
@@ -4,6 +4,7 @@
 package jsUtil
 
 import (
+	"errors"
 	"regexp"
 	"strconv"
 	"syscall/js"
@@ -121,3 +122,44 @@ func handleSelection(this js.Value, args []js.Value) any {
 	}
 	return nil
 }
+
+// ReadClipboard returns the text on the browser clipboard. It blocks until the
+// browser resolves the request.
+func ReadClipboard() (string, error) {
+	clipboard := js.Global().Get("navigator").Get("clipboard")
+	if !clipboard.Truthy() {
+		return "", errors.New("clipboard is not available")
+	}
+
+	result := make(chan js.Value, 1)
+	failed := make(chan js.Value, 1)
+	onResult := js.FuncOf(func(this js.Value, args []js.Value) any {
+		result <- args[0]
+		return nil
+	})
+	defer onResult.Release()
+	onError := js.FuncOf(func(this js.Value, args []js.Value) any {
+		failed <- args[0]
+		return nil
+	})
+	defer onError.Release()
+
+	clipboard.Call("readText").Call("then", onResult, onError)
+
+	select {
+	case r := <-result:
+		return r.String(), nil
+	case err := <-failed:
+		return "", errors.New(err.Call("toString").String())
+	}
+}
+
+// WriteClipboard puts text on the browser clipboard.
+func WriteClipboard(text string) error {
+	clipboard := js.Global().Get("navigator").Get("clipboard")
+	if !clipboard.Truthy() {
+		return errors.New("clipboard is not available")
+	}
+	clipboard.Call("writeText", text)
+	return nil
+}
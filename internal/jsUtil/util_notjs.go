@@ -3,7 +3,11 @@
 
 package jsUtil
 
-import "github.com/ebitenui/ebitenui/utilities/mobile"
+import (
+	"errors"
+
+	"github.com/ebitenui/ebitenui/utilities/mobile"
+)
 
 func IsMobileBrowser() bool {
 	return false
@@ -19,3 +23,11 @@ func SetCursorPosition(cursorPos int, cursorPos2 int) {
 func GetCursorPosition() int {
 	return 0
 }
+
+func ReadClipboard() (string, error) {
+	return "", errors.New("clipboard is only available in js builds")
+}
+
+func WriteClipboard(text string) error {
+	return errors.New("clipboard is only available in js builds")
+}
@@ -0,0 +1,625 @@
+// Package loader builds widget trees from JSON or YAML documents, so that screens can be
+// changed without recompiling.
+//
+// A document describes a single root container. Every node has a "type" and optionally an "id",
+// under which the created widget is returned:
+//
+//	{
+//	  "type": "container",
+//	  "background": "panel",
+//	  "layout": {"type": "row", "direction": "vertical", "spacing": 10, "padding": {"top": 20}},
+//	  "children": [
+//	    {"type": "label", "text": "Name", "face": "main", "color": "#ffffff"},
+//	    {"type": "textInput", "id": "name", "placeholder": "Enter your name", "layoutData": {"stretch": true}},
+//	    {"type": "button", "id": "ok", "text": "OK", "image": "button", "onClick": "submit"}
+//	  ]
+//	}
+//
+// Images, faces, colors and handlers are referenced by name and resolved from a Registry.
+// Colors may also be given as "#rrggbb" or "#rrggbbaa". Properties that are left out fall back
+// to the theme of the UI, like they do when the widgets are created in code.
+//
+// The supported node types are container, button, label, text, textInput and list; the supported
+// layout types are row, grid, anchor and stacked. The "layoutData" of a node is interpreted
+// according to the layout of its parent container.
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Widgets maps the ids of a document to the widgets created for them.
+type Widgets map[string]widget.PreferredSizeLocateableWidget
+
+// Node is a widget in a document.
+type Node struct {
+	Type       string          `json:"type" yaml:"type"`
+	ID         string          `json:"id,omitempty" yaml:"id,omitempty"`
+	MinWidth   int             `json:"minWidth,omitempty" yaml:"minWidth,omitempty"`
+	MinHeight  int             `json:"minHeight,omitempty" yaml:"minHeight,omitempty"`
+	Disabled   bool            `json:"disabled,omitempty" yaml:"disabled,omitempty"`
+	LayoutData *LayoutDataNode `json:"layoutData,omitempty" yaml:"layoutData,omitempty"`
+
+	// Containers
+	Background string      `json:"background,omitempty" yaml:"background,omitempty"`
+	Layout     *LayoutNode `json:"layout,omitempty" yaml:"layout,omitempty"`
+	Children   []*Node     `json:"children,omitempty" yaml:"children,omitempty"`
+
+	// Buttons, labels, texts and text inputs
+	Text    string         `json:"text,omitempty" yaml:"text,omitempty"`
+	Face    string         `json:"face,omitempty" yaml:"face,omitempty"`
+	Color   string         `json:"color,omitempty" yaml:"color,omitempty"`
+	Image   string         `json:"image,omitempty" yaml:"image,omitempty"`
+	Padding *widget.Insets `json:"padding,omitempty" yaml:"padding,omitempty"`
+	OnClick string         `json:"onClick,omitempty" yaml:"onClick,omitempty"`
+
+	// Text inputs
+	Placeholder string `json:"placeholder,omitempty" yaml:"placeholder,omitempty"`
+	Secure      bool   `json:"secure,omitempty" yaml:"secure,omitempty"`
+	OnChange    string `json:"onChange,omitempty" yaml:"onChange,omitempty"`
+	OnSubmit    string `json:"onSubmit,omitempty" yaml:"onSubmit,omitempty"`
+
+	// Lists
+	Entries              []string `json:"entries,omitempty" yaml:"entries,omitempty"`
+	ScrollContainerImage string   `json:"scrollContainerImage,omitempty" yaml:"scrollContainerImage,omitempty"`
+	Slider               string   `json:"slider,omitempty" yaml:"slider,omitempty"`
+	OnSelect             string   `json:"onSelect,omitempty" yaml:"onSelect,omitempty"`
+}
+
+// LayoutNode is the layout of a container.
+type LayoutNode struct {
+	// Type is one of row, grid, anchor or stacked.
+	Type      string         `json:"type" yaml:"type"`
+	Padding   *widget.Insets `json:"padding,omitempty" yaml:"padding,omitempty"`
+	Direction string         `json:"direction,omitempty" yaml:"direction,omitempty"`
+	Spacing   int            `json:"spacing,omitempty" yaml:"spacing,omitempty"`
+
+	// Grid layouts
+	Columns       int    `json:"columns,omitempty" yaml:"columns,omitempty"`
+	ColumnSpacing *int   `json:"columnSpacing,omitempty" yaml:"columnSpacing,omitempty"`
+	RowSpacing    *int   `json:"rowSpacing,omitempty" yaml:"rowSpacing,omitempty"`
+	ColumnStretch []bool `json:"columnStretch,omitempty" yaml:"columnStretch,omitempty"`
+	RowStretch    []bool `json:"rowStretch,omitempty" yaml:"rowStretch,omitempty"`
+}
+
+// LayoutDataNode places a widget in the layout of its parent. Which fields apply depends on that layout.
+type LayoutDataNode struct {
+	// Row layouts
+	Position string `json:"position,omitempty" yaml:"position,omitempty"`
+	Stretch  bool   `json:"stretch,omitempty" yaml:"stretch,omitempty"`
+
+	// Row and grid layouts
+	MaxWidth  int `json:"maxWidth,omitempty" yaml:"maxWidth,omitempty"`
+	MaxHeight int `json:"maxHeight,omitempty" yaml:"maxHeight,omitempty"`
+
+	// Grid and anchor layouts
+	HorizontalPosition string `json:"horizontalPosition,omitempty" yaml:"horizontalPosition,omitempty"`
+	VerticalPosition   string `json:"verticalPosition,omitempty" yaml:"verticalPosition,omitempty"`
+
+	// Anchor layouts
+	StretchHorizontal bool `json:"stretchHorizontal,omitempty" yaml:"stretchHorizontal,omitempty"`
+	StretchVertical   bool `json:"stretchVertical,omitempty" yaml:"stretchVertical,omitempty"`
+}
+
+// LoadJSON builds the widget tree described by a JSON document.
+func LoadJSON(data []byte, registry *Registry) (*widget.Container, Widgets, error) {
+	var root Node
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&root); err != nil {
+		return nil, nil, fmt.Errorf("loader: %w", err)
+	}
+	return Build(&root, registry)
+}
+
+// LoadYAML builds the widget tree described by a YAML document.
+func LoadYAML(data []byte, registry *Registry) (*widget.Container, Widgets, error) {
+	var root Node
+	d := yaml.NewDecoder(bytes.NewReader(data))
+	d.KnownFields(true)
+	if err := d.Decode(&root); err != nil {
+		return nil, nil, fmt.Errorf("loader: %w", err)
+	}
+	return Build(&root, registry)
+}
+
+// LoadFile builds the widget tree described by a JSON or YAML file, depending on its extension.
+func LoadFile(path string, registry *Registry) (*widget.Container, Widgets, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loader: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return LoadJSON(data, registry)
+	case ".yaml", ".yml":
+		return LoadYAML(data, registry)
+	default:
+		return nil, nil, fmt.Errorf("loader: unsupported file extension %q", filepath.Ext(path))
+	}
+}
+
+// Build builds the widget tree described by root, which has to be a container.
+func Build(root *Node, registry *Registry) (*widget.Container, Widgets, error) {
+	if root.Type != "container" {
+		return nil, nil, fmt.Errorf("loader: root: the root has to be a container, not %q", root.Type)
+	}
+
+	b := builder{registry: registry, widgets: Widgets{}}
+	w, err := b.build(root, "root", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loader: %w", err)
+	}
+	return w.(*widget.Container), b.widgets, nil
+}
+
+type builder struct {
+	registry *Registry
+	widgets  Widgets
+}
+
+func (b *builder) build(n *Node, path string, parentLayout *LayoutNode) (widget.PreferredSizeLocateableWidget, error) {
+	if n.ID != "" {
+		path = fmt.Sprintf("%s (%s %q)", path, n.Type, n.ID)
+	}
+
+	widgetOpts, err := b.widgetOpts(n, parentLayout)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var w widget.PreferredSizeLocateableWidget
+	switch n.Type {
+	case "container":
+		w, err = b.container(n, path, widgetOpts)
+	case "button":
+		w, err = b.button(n, widgetOpts)
+	case "label":
+		w, err = b.label(n, widgetOpts)
+	case "text":
+		w, err = b.text(n, widgetOpts)
+	case "textInput":
+		w, err = b.textInput(n, widgetOpts)
+	case "list":
+		w, err = b.list(n, widgetOpts)
+	default:
+		err = fmt.Errorf("unknown widget type %q", n.Type)
+	}
+	if err != nil {
+		// Errors of children already carry their own path.
+		var childErr *childError
+		if errors.As(err, &childErr) {
+			return nil, childErr.err
+		}
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	w.GetWidget().Disabled = n.Disabled
+	w.GetWidget().ID = n.ID
+
+	if n.ID != "" {
+		if _, ok := b.widgets[n.ID]; ok {
+			return nil, fmt.Errorf("%s: duplicate id %q", path, n.ID)
+		}
+		b.widgets[n.ID] = w
+	}
+	return w, nil
+}
+
+// childError wraps an error that occurred while building a child node.
+type childError struct {
+	err error
+}
+
+func (e *childError) Error() string {
+	return e.err.Error()
+}
+
+func (b *builder) widgetOpts(n *Node, parentLayout *LayoutNode) ([]widget.WidgetOpt, error) {
+	opts := []widget.WidgetOpt{
+		widget.WidgetOpts.MinSize(n.MinWidth, n.MinHeight),
+	}
+
+	if n.LayoutData != nil {
+		if parentLayout == nil {
+			return nil, errors.New("layoutData requires a parent container with a layout")
+		}
+		ld, err := layoutData(n.LayoutData, parentLayout.Type)
+		if err != nil {
+			return nil, err
+		}
+		if ld != nil {
+			opts = append(opts, widget.WidgetOpts.LayoutData(ld))
+		}
+	}
+	return opts, nil
+}
+
+func (b *builder) container(n *Node, path string, widgetOpts []widget.WidgetOpt) (widget.PreferredSizeLocateableWidget, error) {
+	opts := []widget.ContainerOpt{widget.ContainerOpts.WidgetOpts(widgetOpts...)}
+
+	if n.Background != "" {
+		i, err := lookup[*image.NineSlice](b.registry, kindNineSlice, n.Background)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ContainerOpts.BackgroundImage(i))
+	}
+
+	if n.Layout != nil {
+		l, err := layout(n.Layout)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ContainerOpts.Layout(l))
+	}
+
+	c := widget.NewContainer(opts...)
+	for i, child := range n.Children {
+		w, err := b.build(child, fmt.Sprintf("%s.children[%d]", path, i), n.Layout)
+		if err != nil {
+			return nil, &childError{err}
+		}
+		c.AddChild(w)
+	}
+	return c, nil
+}
+
+func (b *builder) button(n *Node, widgetOpts []widget.WidgetOpt) (widget.PreferredSizeLocateableWidget, error) {
+	opts := []widget.ButtonOpt{
+		widget.ButtonOpts.WidgetOpts(widgetOpts...),
+		widget.ButtonOpts.TextLabel(n.Text),
+	}
+
+	if n.Image != "" {
+		i, err := lookup[*widget.ButtonImage](b.registry, kindButtonImage, n.Image)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ButtonOpts.Image(i))
+	}
+	if n.Face != "" {
+		f, err := lookup[*text.Face](b.registry, kindFace, n.Face)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ButtonOpts.TextFace(f))
+	}
+	if n.Color != "" {
+		c, err := lookup[*widget.ButtonTextColor](b.registry, kindButtonTextColor, n.Color)
+		if err != nil {
+			plain, plainErr := lookupColor(b.registry, n.Color)
+			if plainErr != nil {
+				return nil, err
+			}
+			c = &widget.ButtonTextColor{Idle: plain, Disabled: plain, Hover: plain, Pressed: plain}
+		}
+		opts = append(opts, widget.ButtonOpts.TextColor(c))
+	}
+	if n.Padding != nil {
+		opts = append(opts, widget.ButtonOpts.TextPadding(n.Padding))
+	}
+	if n.OnClick != "" {
+		f, err := lookup[widget.ButtonClickedHandlerFunc](b.registry, kindClickedHandler, n.OnClick)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ButtonOpts.ClickedHandler(f))
+	}
+
+	return widget.NewButton(opts...), nil
+}
+
+func (b *builder) label(n *Node, widgetOpts []widget.WidgetOpt) (widget.PreferredSizeLocateableWidget, error) {
+	opts := []widget.LabelOpt{
+		widget.LabelOpts.TextOpts(widget.TextOpts.WidgetOpts(widgetOpts...)),
+		widget.LabelOpts.LabelText(n.Text),
+	}
+
+	if n.Face != "" {
+		f, err := lookup[*text.Face](b.registry, kindFace, n.Face)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.LabelOpts.LabelFace(f))
+	}
+	if n.Color != "" {
+		c, err := lookup[*widget.LabelColor](b.registry, kindLabelColor, n.Color)
+		if err != nil {
+			plain, plainErr := lookupColor(b.registry, n.Color)
+			if plainErr != nil {
+				return nil, err
+			}
+			c = &widget.LabelColor{Idle: plain, Disabled: plain}
+		}
+		opts = append(opts, widget.LabelOpts.LabelColor(c))
+	}
+	if n.Padding != nil {
+		opts = append(opts, widget.LabelOpts.TextOpts(widget.TextOpts.Padding(n.Padding)))
+	}
+
+	return widget.NewLabel(opts...), nil
+}
+
+func (b *builder) text(n *Node, widgetOpts []widget.WidgetOpt) (widget.PreferredSizeLocateableWidget, error) {
+	opts := []widget.TextOpt{
+		widget.TextOpts.WidgetOpts(widgetOpts...),
+		widget.TextOpts.TextLabel(n.Text),
+	}
+
+	if n.Face != "" {
+		f, err := lookup[*text.Face](b.registry, kindFace, n.Face)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.TextOpts.TextFace(f))
+	}
+	if n.Color != "" {
+		c, err := lookupColor(b.registry, n.Color)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.TextOpts.TextColor(c))
+	}
+	if n.Padding != nil {
+		opts = append(opts, widget.TextOpts.Padding(n.Padding))
+	}
+
+	return widget.NewText(opts...), nil
+}
+
+func (b *builder) textInput(n *Node, widgetOpts []widget.WidgetOpt) (widget.PreferredSizeLocateableWidget, error) {
+	opts := []widget.TextInputOpt{
+		widget.TextInputOpts.WidgetOpts(widgetOpts...),
+		widget.TextInputOpts.Placeholder(n.Placeholder),
+		widget.TextInputOpts.Secure(n.Secure),
+	}
+
+	if n.Image != "" {
+		i, err := lookup[*widget.TextInputImage](b.registry, kindTextInputImage, n.Image)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.TextInputOpts.Image(i))
+	}
+	if n.Face != "" {
+		f, err := lookup[*text.Face](b.registry, kindFace, n.Face)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.TextInputOpts.Face(f))
+	}
+	if n.Color != "" {
+		c, err := lookup[*widget.TextInputColor](b.registry, kindTextInputColor, n.Color)
+		if err != nil {
+			plain, plainErr := lookupColor(b.registry, n.Color)
+			if plainErr != nil {
+				return nil, err
+			}
+			c = &widget.TextInputColor{Idle: plain, Disabled: plain, Caret: plain, DisabledCaret: plain}
+		}
+		opts = append(opts, widget.TextInputOpts.Color(c))
+	}
+	if n.Padding != nil {
+		opts = append(opts, widget.TextInputOpts.Padding(n.Padding))
+	}
+	if n.OnChange != "" {
+		f, err := lookup[widget.TextInputChangedHandlerFunc](b.registry, kindTextInputHandler, n.OnChange)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.TextInputOpts.ChangedHandler(f))
+	}
+	if n.OnSubmit != "" {
+		f, err := lookup[widget.TextInputChangedHandlerFunc](b.registry, kindTextInputHandler, n.OnSubmit)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.TextInputOpts.SubmitHandler(f))
+	}
+
+	t := widget.NewTextInput(opts...)
+	t.SetText(n.Text)
+	return t, nil
+}
+
+func (b *builder) list(n *Node, widgetOpts []widget.WidgetOpt) (widget.PreferredSizeLocateableWidget, error) {
+	entries := make([]any, len(n.Entries))
+	for i, e := range n.Entries {
+		entries[i] = e
+	}
+
+	opts := []widget.ListOpt{
+		widget.ListOpts.ContainerOpts(widget.ContainerOpts.WidgetOpts(widgetOpts...)),
+		widget.ListOpts.Entries(entries),
+		widget.ListOpts.EntryLabelFunc(func(e any) string {
+			return e.(string)
+		}),
+	}
+
+	if n.Face != "" {
+		f, err := lookup[*text.Face](b.registry, kindFace, n.Face)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ListOpts.EntryFontFace(f))
+	}
+	if n.Color != "" {
+		c, err := lookup[*widget.ListEntryColor](b.registry, kindListEntryColor, n.Color)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ListOpts.EntryColor(c))
+	}
+	if n.Padding != nil {
+		opts = append(opts, widget.ListOpts.EntryTextPadding(n.Padding))
+	}
+	if n.ScrollContainerImage != "" {
+		i, err := lookup[*widget.ScrollContainerImage](b.registry, kindScrollContainerImage, n.ScrollContainerImage)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ListOpts.ScrollContainerImage(i))
+	}
+	if n.Slider != "" {
+		p, err := lookup[*widget.SliderParams](b.registry, kindSliderParams, n.Slider)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ListOpts.SliderParams(p))
+	}
+	if n.OnSelect != "" {
+		f, err := lookup[widget.ListEntrySelectedHandlerFunc](b.registry, kindEntrySelectedHandler, n.OnSelect)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ListOpts.EntrySelectedHandler(f))
+	}
+
+	return widget.NewList(opts...), nil
+}
+
+func layout(n *LayoutNode) (widget.Layouter, error) {
+	switch n.Type {
+	case "row":
+		d, err := direction(n.Direction)
+		if err != nil {
+			return nil, err
+		}
+		opts := []widget.RowLayoutOpt{
+			widget.RowLayoutOpts.Direction(d),
+			widget.RowLayoutOpts.Spacing(n.Spacing),
+		}
+		if n.Padding != nil {
+			opts = append(opts, widget.RowLayoutOpts.Padding(n.Padding))
+		}
+		return widget.NewRowLayout(opts...), nil
+
+	case "grid":
+		columnSpacing, rowSpacing := n.Spacing, n.Spacing
+		if n.ColumnSpacing != nil {
+			columnSpacing = *n.ColumnSpacing
+		}
+		if n.RowSpacing != nil {
+			rowSpacing = *n.RowSpacing
+		}
+		columns := n.Columns
+		if columns <= 0 {
+			columns = 1
+		}
+		opts := []widget.GridLayoutOpt{
+			widget.GridLayoutOpts.Columns(columns),
+			widget.GridLayoutOpts.Spacing(columnSpacing, rowSpacing),
+			widget.GridLayoutOpts.Stretch(n.ColumnStretch, n.RowStretch),
+		}
+		if n.Padding != nil {
+			opts = append(opts, widget.GridLayoutOpts.Padding(n.Padding))
+		}
+		return widget.NewGridLayout(opts...), nil
+
+	case "anchor":
+		var opts []widget.AnchorLayoutOpt
+		if n.Padding != nil {
+			opts = append(opts, widget.AnchorLayoutOpts.Padding(n.Padding))
+		}
+		return widget.NewAnchorLayout(opts...), nil
+
+	case "stacked":
+		var opts []widget.StackedLayoutOpt
+		if n.Padding != nil {
+			opts = append(opts, widget.StackedLayoutOpts.Padding(n.Padding))
+		}
+		return widget.NewStackedLayout(opts...), nil
+
+	default:
+		return nil, fmt.Errorf("unknown layout type %q", n.Type)
+	}
+}
+
+func layoutData(n *LayoutDataNode, layoutType string) (any, error) {
+	switch layoutType {
+	case "row":
+		p, err := position(n.Position)
+		if err != nil {
+			return nil, err
+		}
+		return widget.RowLayoutData{
+			Position:  widget.RowLayoutPosition(p),
+			Stretch:   n.Stretch,
+			MaxWidth:  n.MaxWidth,
+			MaxHeight: n.MaxHeight,
+		}, nil
+
+	case "grid":
+		h, err := position(n.HorizontalPosition)
+		if err != nil {
+			return nil, err
+		}
+		v, err := position(n.VerticalPosition)
+		if err != nil {
+			return nil, err
+		}
+		return widget.GridLayoutData{
+			MaxWidth:           n.MaxWidth,
+			MaxHeight:          n.MaxHeight,
+			HorizontalPosition: widget.GridLayoutPosition(h),
+			VerticalPosition:   widget.GridLayoutPosition(v),
+		}, nil
+
+	case "anchor":
+		h, err := position(n.HorizontalPosition)
+		if err != nil {
+			return nil, err
+		}
+		v, err := position(n.VerticalPosition)
+		if err != nil {
+			return nil, err
+		}
+		return widget.AnchorLayoutData{
+			HorizontalPosition: widget.AnchorLayoutPosition(h),
+			VerticalPosition:   widget.AnchorLayoutPosition(v),
+			StretchHorizontal:  n.StretchHorizontal,
+			StretchVertical:    n.StretchVertical,
+		}, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// position returns the index of a start, center or end position, which is the same for all layouts.
+func position(s string) (int, error) {
+	switch s {
+	case "", "start":
+		return 0, nil
+	case "center":
+		return 1, nil
+	case "end":
+		return 2, nil
+	default:
+		return 0, fmt.Errorf("unknown position %q", s)
+	}
+}
+
+func direction(s string) (widget.Direction, error) {
+	switch s {
+	case "", "horizontal":
+		return widget.DirectionHorizontal, nil
+	case "vertical":
+		return widget.DirectionVertical, nil
+	default:
+		return 0, fmt.Errorf("unknown direction %q", s)
+	}
+}
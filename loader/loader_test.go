@@ -0,0 +1,170 @@
+package loader
+
+import (
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/matryer/is"
+	"golang.org/x/image/font/basicfont"
+)
+
+const testJSON = `{
+  "type": "container",
+  "background": "panel",
+  "layout": {"type": "row", "direction": "vertical", "spacing": 10, "padding": {"top": 20}},
+  "children": [
+    {"type": "label", "id": "title", "text": "Name", "face": "main", "color": "#ff0000"},
+    {"type": "textInput", "id": "name", "text": "Bob", "face": "main", "color": "white", "layoutData": {"stretch": true}},
+    {
+      "type": "container",
+      "layout": {"type": "grid", "columns": 2, "spacing": 5},
+      "children": [
+        {"type": "button", "id": "ok", "text": "OK", "face": "main", "image": "button", "onClick": "submit",
+          "layoutData": {"horizontalPosition": "center"}},
+        {"type": "button", "id": "cancel", "text": "Cancel", "face": "main", "image": "button", "disabled": true}
+      ]
+    }
+  ]
+}`
+
+const testYAML = `
+type: container
+layout:
+  type: anchor
+children:
+  - type: text
+    id: greeting
+    text: Hello
+    face: main
+    color: "#00ff0080"
+    layoutData:
+      horizontalPosition: center
+      verticalPosition: end
+`
+
+func TestLoadJSON(t *testing.T) {
+	is := is.New(t)
+
+	clicked := false
+	r := newTestRegistry()
+	r.RegisterClickedHandler("submit", func(_ *widget.ButtonClickedEventArgs) {
+		clicked = true
+	})
+
+	root, widgets, err := LoadJSON([]byte(testJSON), r)
+	is.NoErr(err)
+	is.Equal(len(root.Children()), 3)
+	is.Equal(len(widgets), 4)
+
+	title := widgets["title"].(*widget.Label)
+	is.Equal(title.Label, "Name")
+
+	name := widgets["name"].(*widget.TextInput)
+	is.Equal(name.GetText(), "Bob")
+	is.Equal(name.GetWidget().LayoutData, widget.RowLayoutData{Stretch: true})
+
+	ok := widgets["ok"].(*widget.Button)
+	is.Equal(ok.GetWidget().LayoutData, widget.GridLayoutData{HorizontalPosition: widget.GridLayoutPositionCenter})
+	ok.Click()
+	event.ExecuteDeferred()
+	is.True(clicked)
+
+	is.True(widgets["cancel"].GetWidget().Disabled)
+	is.Equal(widget.FindByID(root, "cancel"), widgets["cancel"])
+}
+
+func TestLoadYAML(t *testing.T) {
+	is := is.New(t)
+
+	root, widgets, err := LoadYAML([]byte(testYAML), newTestRegistry())
+	is.NoErr(err)
+	is.Equal(len(root.Children()), 1)
+
+	greeting := widgets["greeting"].(*widget.Text)
+	is.Equal(greeting.Label, "Hello")
+	is.Equal(greeting.GetWidget().LayoutData, widget.AnchorLayoutData{
+		HorizontalPosition: widget.AnchorLayoutPositionCenter,
+		VerticalPosition:   widget.AnchorLayoutPositionEnd,
+	})
+}
+
+func TestLoadFile(t *testing.T) {
+	is := is.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "screen.yml")
+	is.NoErr(os.WriteFile(path, []byte(testYAML), 0o600))
+
+	_, widgets, err := LoadFile(path, newTestRegistry())
+	is.NoErr(err)
+	is.True(widgets["greeting"] != nil)
+
+	_, _, err = LoadFile(filepath.Join(dir, "screen.txt"), newTestRegistry())
+	is.True(err != nil)
+}
+
+func TestLoadJSON_Errors(t *testing.T) {
+	tests := map[string]struct {
+		doc string
+		err string
+	}{
+		"root not a container": {
+			doc: `{"type": "button"}`,
+			err: `loader: root: the root has to be a container, not "button"`,
+		},
+		"unknown widget type": {
+			doc: `{"type": "container", "children": [{"type": "spinner"}]}`,
+			err: `loader: root.children[0]: unknown widget type "spinner"`,
+		},
+		"unknown resource": {
+			doc: `{"type": "container", "children": [{"type": "label"}, {"type": "button", "id": "ok", "face": "other"}]}`,
+			err: `loader: root.children[1] (button "ok"): unknown face "other"`,
+		},
+		"nested": {
+			doc: `{"type": "container", "children": [{"type": "container", "children": [{"type": "text", "color": "#12"}]}]}`,
+			err: `loader: root.children[0].children[0]: invalid color "#12": wrong length`,
+		},
+		"duplicate id": {
+			doc: `{"type": "container", "children": [{"type": "label", "id": "a"}, {"type": "label", "id": "a"}]}`,
+			err: `loader: root.children[1] (label "a"): duplicate id "a"`,
+		},
+		"unknown position": {
+			doc: `{"type": "container", "layout": {"type": "row"}, "children": [{"type": "label", "layoutData": {"position": "middle"}}]}`,
+			err: `loader: root.children[0]: unknown position "middle"`,
+		},
+		"unknown field": {
+			doc: `{"type": "container", "colour": "red"}`,
+			err: `loader: json: unknown field "colour"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+
+			_, _, err := LoadJSON([]byte(test.doc), newTestRegistry())
+			is.True(err != nil)
+			is.Equal(err.Error(), test.err)
+		})
+	}
+}
+
+func newTestRegistry() *Registry {
+	var face text.Face = text.NewGoXFace(basicfont.Face7x13)
+
+	r := NewRegistry()
+	r.RegisterFace("main", &face)
+	r.RegisterColor("white", color.White)
+	r.RegisterNineSlice("panel", image.NewNineSliceColor(color.Black))
+	r.RegisterButtonImage("button", &widget.ButtonImage{
+		Idle:    image.NewNineSliceColor(color.White),
+		Pressed: image.NewNineSliceColor(color.White),
+	})
+	return r
+}
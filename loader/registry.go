@@ -0,0 +1,146 @@
+package loader
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// Registry holds the named resources and handlers a document refers to.
+// The same name may be used for resources of different kinds.
+type Registry struct {
+	resources map[resourceKey]any
+}
+
+type resourceKey struct {
+	kind string
+	name string
+}
+
+const (
+	kindFace                 = "face"
+	kindColor                = "color"
+	kindNineSlice            = "nine slice"
+	kindButtonImage          = "button image"
+	kindButtonTextColor      = "button text color"
+	kindLabelColor           = "label color"
+	kindTextInputImage       = "text input image"
+	kindTextInputColor       = "text input color"
+	kindListEntryColor       = "list entry color"
+	kindScrollContainerImage = "scroll container image"
+	kindSliderParams         = "slider params"
+	kindClickedHandler       = "clicked handler"
+	kindTextInputHandler     = "text input handler"
+	kindEntrySelectedHandler = "entry selected handler"
+)
+
+func NewRegistry() *Registry {
+	return &Registry{
+		resources: make(map[resourceKey]any),
+	}
+}
+
+func (r *Registry) RegisterFace(name string, face *text.Face) {
+	r.register(kindFace, name, face)
+}
+
+// RegisterColor registers a plain color. Documents can also specify colors as "#rrggbb" or "#rrggbbaa".
+// Where a widget needs a set of colors, such as a LabelColor, a plain color is used for all of its states.
+func (r *Registry) RegisterColor(name string, c color.Color) {
+	r.register(kindColor, name, c)
+}
+
+// RegisterNineSlice registers an image used for container backgrounds.
+func (r *Registry) RegisterNineSlice(name string, i *image.NineSlice) {
+	r.register(kindNineSlice, name, i)
+}
+
+func (r *Registry) RegisterButtonImage(name string, i *widget.ButtonImage) {
+	r.register(kindButtonImage, name, i)
+}
+
+func (r *Registry) RegisterButtonTextColor(name string, c *widget.ButtonTextColor) {
+	r.register(kindButtonTextColor, name, c)
+}
+
+func (r *Registry) RegisterLabelColor(name string, c *widget.LabelColor) {
+	r.register(kindLabelColor, name, c)
+}
+
+func (r *Registry) RegisterTextInputImage(name string, i *widget.TextInputImage) {
+	r.register(kindTextInputImage, name, i)
+}
+
+func (r *Registry) RegisterTextInputColor(name string, c *widget.TextInputColor) {
+	r.register(kindTextInputColor, name, c)
+}
+
+func (r *Registry) RegisterListEntryColor(name string, c *widget.ListEntryColor) {
+	r.register(kindListEntryColor, name, c)
+}
+
+func (r *Registry) RegisterScrollContainerImage(name string, i *widget.ScrollContainerImage) {
+	r.register(kindScrollContainerImage, name, i)
+}
+
+func (r *Registry) RegisterSliderParams(name string, p *widget.SliderParams) {
+	r.register(kindSliderParams, name, p)
+}
+
+// RegisterClickedHandler registers a handler for the "onClick" property of buttons.
+func (r *Registry) RegisterClickedHandler(name string, f widget.ButtonClickedHandlerFunc) {
+	r.register(kindClickedHandler, name, f)
+}
+
+// RegisterTextInputHandler registers a handler for the "onChange" and "onSubmit" properties of text inputs.
+func (r *Registry) RegisterTextInputHandler(name string, f widget.TextInputChangedHandlerFunc) {
+	r.register(kindTextInputHandler, name, f)
+}
+
+// RegisterEntrySelectedHandler registers a handler for the "onSelect" property of lists.
+func (r *Registry) RegisterEntrySelectedHandler(name string, f widget.ListEntrySelectedHandlerFunc) {
+	r.register(kindEntrySelectedHandler, name, f)
+}
+
+func (r *Registry) register(kind string, name string, resource any) {
+	r.resources[resourceKey{kind, name}] = resource
+}
+
+func lookup[T any](r *Registry, kind string, name string) (T, error) {
+	var zero T
+	if r != nil {
+		if v, ok := r.resources[resourceKey{kind, name}]; ok {
+			return v.(T), nil
+		}
+	}
+	return zero, fmt.Errorf("unknown %s %q", kind, name)
+}
+
+// lookupColor resolves a hex color or the name of a registered color.
+func lookupColor(r *Registry, name string) (color.Color, error) {
+	if len(name) > 0 && name[0] == '#' {
+		return parseHexColor(name)
+	}
+	return lookup[color.Color](r, kindColor, name)
+}
+
+func parseHexColor(s string) (color.Color, error) {
+	var c color.NRGBA
+	var err error
+	switch len(s) {
+	case 7:
+		c.A = 0xff
+		_, err = fmt.Sscanf(s, "#%02x%02x%02x", &c.R, &c.G, &c.B)
+	case 9:
+		_, err = fmt.Sscanf(s, "#%02x%02x%02x%02x", &c.R, &c.G, &c.B, &c.A)
+	default:
+		err = fmt.Errorf("wrong length")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %q: %w", s, err)
+	}
+	return c, nil
+}
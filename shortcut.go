@@ -0,0 +1,162 @@
+package ebitenui
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/widget"
+)
+
+// ErrShortcutConflict is returned by UI.AddShortcut if the chord is already bound in the same scope.
+var ErrShortcutConflict = errors.New("shortcut conflict")
+
+// ShortcutEventArgs are passed to the handler of a shortcut when its chord is pressed.
+type ShortcutEventArgs struct {
+	Chord input.KeyChord
+	// Focused is the widget that had focus when the chord was pressed, or nil.
+	Focused widget.Focuser
+}
+
+// ShortcutHandlerFunc is called when the chord of a shortcut is pressed.
+type ShortcutHandlerFunc func(args *ShortcutEventArgs)
+
+// RemoveShortcutFunc removes a shortcut from the UI it was added to.
+type RemoveShortcutFunc func()
+
+type shortcut struct {
+	chord               input.KeyChord
+	handler             ShortcutHandlerFunc
+	window              *widget.Window
+	widget              *widget.Widget
+	suppressWhileTyping bool
+}
+
+type ShortcutOpt func(s *shortcut)
+
+type ShortcutOptions struct {
+}
+
+var ShortcutOpts ShortcutOptions
+
+// Window scopes the shortcut to w. It only fires while w is the topmost open window.
+func (o ShortcutOptions) Window(w *widget.Window) ShortcutOpt {
+	return func(s *shortcut) {
+		s.window = w
+	}
+}
+
+// Widget scopes the shortcut to w. It only fires while w or one of its descendants has focus.
+func (o ShortcutOptions) Widget(w widget.HasWidget) ShortcutOpt {
+	return func(s *shortcut) {
+		s.widget = w.GetWidget()
+	}
+}
+
+// SuppressWhileTyping keeps the shortcut from firing while a widget that receives text has focus,
+// for example a TextInput. See widget.TextReceiver.
+func (o ShortcutOptions) SuppressWhileTyping() ShortcutOpt {
+	return func(s *shortcut) {
+		s.suppressWhileTyping = true
+	}
+}
+
+// AddShortcut binds chord to handler. Shortcuts are global unless they are scoped to a window or a widget.
+// If the same chord is bound in several active scopes, only the most specific one fires: widget scopes
+// closest to the focused widget first, then the topmost window, then the global scope. Global shortcuts
+// do not fire while a modal window is open.
+//
+// It returns an error wrapping ErrShortcutConflict if chord is already bound in the same scope,
+// otherwise a function to remove the shortcut.
+func (u *UI) AddShortcut(chord input.KeyChord, handler ShortcutHandlerFunc, opts ...ShortcutOpt) (RemoveShortcutFunc, error) {
+	s := &shortcut{
+		chord:   chord,
+		handler: handler,
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	if s.window != nil && s.widget != nil {
+		return nil, errors.New("shortcut cannot be scoped to both a window and a widget")
+	}
+	for _, o := range u.shortcuts {
+		if o.chord == s.chord && o.window == s.window && o.widget == s.widget {
+			return nil, fmt.Errorf("%w: %s is already bound in this scope", ErrShortcutConflict, chord)
+		}
+	}
+
+	u.shortcuts = append(u.shortcuts, s)
+	return func() {
+		for i, o := range u.shortcuts {
+			if o == s {
+				u.shortcuts = append(u.shortcuts[:i], u.shortcuts[i+1:]...)
+				return
+			}
+		}
+	}, nil
+}
+
+func (u *UI) handleShortcuts() {
+	if len(u.shortcuts) == 0 {
+		return
+	}
+
+	typing := false
+	if r, ok := u.focusedWidget.(widget.TextReceiver); ok {
+		typing = r.ReceivesText()
+	}
+	pressed := make([]*shortcut, 0, 1)
+	for _, s := range u.shortcuts {
+		if (!typing || !s.suppressWhileTyping) && s.chord.JustPressed() {
+			pressed = append(pressed, s)
+		}
+	}
+	if len(pressed) == 0 {
+		return
+	}
+
+	if s := u.activeShortcut(pressed); s != nil {
+		s.handler(&ShortcutEventArgs{
+			Chord:   s.chord,
+			Focused: u.focusedWidget,
+		})
+	}
+}
+
+// activeShortcut returns the most specific shortcut of candidates whose scope is active.
+func (u *UI) activeShortcut(candidates []*shortcut) *shortcut {
+	if u.focusedWidget != nil {
+		for w := u.focusedWidget.GetWidget(); w != nil; w = w.Parent() {
+			for _, s := range candidates {
+				if s.widget == w {
+					return s
+				}
+			}
+		}
+	}
+
+	for i := len(u.windows) - 1; i >= 0; i-- {
+		if u.windows[i].Ephemeral {
+			continue
+		}
+		for _, s := range candidates {
+			if s.window == u.windows[i] {
+				return s
+			}
+		}
+		break
+	}
+
+	for _, w := range u.windows {
+		if w.Modal {
+			return nil
+		}
+	}
+	for _, s := range candidates {
+		if s.window == nil && s.widget == nil {
+			return s
+		}
+	}
+	return nil
+}
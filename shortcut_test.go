@@ -0,0 +1,131 @@
+package ebitenui_test
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/ebitenui/ebitenui"
+	e_image "github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/uitest"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/matryer/is"
+	"golang.org/x/image/font/basicfont"
+)
+
+func TestUI_AddShortcut(t *testing.T) {
+	is := is.New(t)
+
+	ui, _, _ := newShortcutUI()
+	save := input.MustParseKeyChord("Ctrl+S")
+
+	var fired []input.KeyChord
+	remove, err := ui.AddShortcut(save, func(args *ebitenui.ShortcutEventArgs) {
+		fired = append(fired, args.Chord)
+	})
+	is.NoErr(err)
+
+	_, err = ui.AddShortcut(save, func(_ *ebitenui.ShortcutEventArgs) {})
+	is.True(errors.Is(err, ebitenui.ErrShortcutConflict))
+
+	h := uitest.New(t, ui, 200, 100)
+	h.PressKey(ebiten.KeyS)
+	is.Equal(len(fired), 0)
+	h.PressKey(ebiten.KeyS, ebiten.KeyControl)
+	is.Equal(fired, []input.KeyChord{save})
+
+	remove()
+	h.PressKey(ebiten.KeyS, ebiten.KeyControl)
+	is.Equal(len(fired), 1)
+}
+
+func TestUI_AddShortcut_Scopes(t *testing.T) {
+	is := is.New(t)
+
+	ui, button, _ := newShortcutUI()
+	chord := input.MustParseKeyChord("Alt+1")
+
+	var fired []string
+	add := func(name string, opts ...ebitenui.ShortcutOpt) {
+		_, err := ui.AddShortcut(chord, func(_ *ebitenui.ShortcutEventArgs) {
+			fired = append(fired, name)
+		}, opts...)
+		is.NoErr(err)
+	}
+	window := widget.NewWindow(widget.WindowOpts.Contents(widget.NewContainer()))
+	add("global")
+	add("window", ebitenui.ShortcutOpts.Window(window))
+	add("root", ebitenui.ShortcutOpts.Widget(ui.Container))
+	add("button", ebitenui.ShortcutOpts.Widget(button))
+
+	h := uitest.New(t, ui, 200, 100)
+	h.PressKey(ebiten.Key1, ebiten.KeyAlt)
+	is.Equal(fired, []string{"global"})
+
+	ui.SetFocusedWidget(button)
+	h.PressKey(ebiten.Key1, ebiten.KeyAlt)
+	is.Equal(fired[1], "button")
+
+	ui.ClearFocus()
+	ui.AddWindow(window)
+	h.PressKey(ebiten.Key1, ebiten.KeyAlt)
+	is.Equal(fired[2], "window")
+}
+
+func TestUI_AddShortcut_SuppressWhileTyping(t *testing.T) {
+	is := is.New(t)
+
+	ui, _, textInput := newShortcutUI()
+
+	var fired []string
+	_, err := ui.AddShortcut(input.MustParseKeyChord("Delete"), func(_ *ebitenui.ShortcutEventArgs) {
+		fired = append(fired, "delete")
+	}, ebitenui.ShortcutOpts.SuppressWhileTyping())
+	is.NoErr(err)
+	_, err = ui.AddShortcut(input.MustParseKeyChord("Ctrl+S"), func(_ *ebitenui.ShortcutEventArgs) {
+		fired = append(fired, "save")
+	})
+	is.NoErr(err)
+
+	h := uitest.New(t, ui, 200, 100)
+	h.Click(textInput)
+	h.PressKey(ebiten.KeyDelete)
+	h.PressKey(ebiten.KeyS, ebiten.KeyControl)
+	is.Equal(fired, []string{"save"})
+
+	ui.ClearFocus()
+	h.PressKey(ebiten.KeyDelete)
+	is.Equal(fired, []string{"save", "delete"})
+}
+
+func newShortcutUI() (*ebitenui.UI, *widget.Button, *widget.TextInput) {
+	root := widget.NewContainer(widget.ContainerOpts.Layout(widget.NewRowLayout(
+		widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+	)))
+
+	button := widget.NewButton(
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:    e_image.NewNineSliceColor(color.White),
+			Pressed: e_image.NewNineSliceColor(color.White),
+		}),
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.MinSize(100, 30)),
+	)
+	root.AddChild(button)
+
+	var face text.Face = text.NewGoXFace(basicfont.Face7x13)
+	textInput := widget.NewTextInput(
+		widget.TextInputOpts.Face(&face),
+		widget.TextInputOpts.Color(&widget.TextInputColor{
+			Idle:     color.White,
+			Disabled: color.White,
+			Caret:    color.White,
+		}),
+		widget.TextInputOpts.WidgetOpts(widget.WidgetOpts.MinSize(100, 30)),
+	)
+	root.AddChild(textInput)
+
+	return &ebitenui.UI{Container: root}, button, textInput
+}
@@ -3,9 +3,14 @@ package themes
 import (
 	"bytes"
 	"fmt"
+	"image/color"
 	"log"
 
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 	"golang.org/x/image/font/gofont/goregular"
 )
 
@@ -21,3 +26,34 @@ func loadFont(size float64) (text.Face, error) {
 		Size:   size,
 	}, nil
 }
+
+// getMenuIcons draws the check mark and the submenu arrow of menu items in c, and in disabled for disabled items.
+func getMenuIcons(c color.Color, disabled color.Color) (*widget.GraphicImage, *widget.GraphicImage) {
+	drawCheck := func(c color.Color) *ebiten.Image {
+		i := ebiten.NewImage(12, 12)
+		var path vector.Path
+		path.MoveTo(11, 2)
+		path.LineTo(5, 10)
+		path.LineTo(1, 6)
+		vertices, indices := path.AppendVerticesAndIndicesForStroke(nil, nil, &vector.StrokeOptions{
+			Width:    2,
+			LineJoin: vector.LineJoinBevel,
+		})
+		i.DrawTriangles(vertices, indices, image.NewImageColor(c), &ebiten.DrawTrianglesOptions{AntiAlias: true})
+		return i
+	}
+	drawArrow := func(c color.Color) *ebiten.Image {
+		i := ebiten.NewImage(8, 12)
+		var path vector.Path
+		path.MoveTo(1, 1)
+		path.LineTo(7, 6)
+		path.LineTo(1, 11)
+		path.Close()
+		vertices, indices := path.AppendVerticesAndIndicesForFilling(nil, nil)
+		i.DrawTriangles(vertices, indices, image.NewImageColor(c), &ebiten.DrawTrianglesOptions{AntiAlias: true})
+		return i
+	}
+
+	return &widget.GraphicImage{Idle: drawCheck(c), Disabled: drawCheck(disabled)},
+		&widget.GraphicImage{Idle: drawArrow(c), Disabled: drawArrow(disabled)}
+}
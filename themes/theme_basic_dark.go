@@ -60,6 +60,8 @@ func GetBasicDarkTheme() *widget.Theme {
 	// load button text font
 	face, _ := loadFont(20)
 
+	menuCheckIcon, menuSubmenuIcon := getMenuIcons(color.White, color.NRGBA{127, 122, 126, 255})
+
 	return &widget.Theme{
 		DefaultFace:      &face,
 		DefaultTextColor: color.White,
@@ -132,6 +134,7 @@ func GetBasicDarkTheme() *widget.Theme {
 				Caret:         color.White,
 				Disabled:      color.NRGBA{127, 122, 126, 255},
 				DisabledCaret: color.NRGBA{127, 122, 126, 255},
+				Highlight:     color.NRGBA{6, 67, 161, 100},
 			},
 			Padding: widget.NewInsetsSimple(5),
 		},
@@ -159,6 +162,35 @@ func GetBasicDarkTheme() *widget.Theme {
 				},
 			},
 		},
+		TextEditorTheme: &widget.TextEditorParams{
+			Face: &face,
+			Color: &widget.TextInputColor{
+				Idle:          color.White,
+				Caret:         color.White,
+				Disabled:      color.NRGBA{127, 122, 126, 255},
+				DisabledCaret: color.NRGBA{127, 122, 126, 255},
+				Highlight:     color.NRGBA{6, 67, 161, 100},
+			},
+			ControlWidgetSpacing:   constantutil.ConstantToPointer(2),
+			TextPadding:            widget.NewInsetsSimple(2),
+			ScrollContainerPadding: widget.NewInsetsSimple(4),
+			ScrollContainerImage: &widget.ScrollContainerImage{
+				Idle:     image.NewBorderedNineSliceColor(color.NRGBA{77, 77, 77, 255}, color.NRGBA{177, 177, 177, 255}, 1),
+				Disabled: image.NewBorderedNineSliceColor(color.NRGBA{47, 47, 47, 255}, color.NRGBA{177, 177, 177, 255}, 1),
+				Mask:     image.NewBorderedNineSliceColor(color.NRGBA{77, 77, 77, 255}, color.NRGBA{177, 177, 177, 255}, 1),
+			},
+			Slider: &widget.SliderParams{
+				TrackImage: &widget.SliderTrackImage{
+					Idle:     image.NewBorderedNineSliceColor(color.NRGBA{77, 77, 77, 255}, color.NRGBA{177, 177, 177, 255}, 1),
+					Disabled: image.NewBorderedNineSliceColor(color.NRGBA{47, 47, 47, 255}, color.NRGBA{177, 177, 177, 255}, 1),
+				},
+				HandleImage: &widget.ButtonImage{
+					Idle:    image.NewBorderedNineSliceColor(color.NRGBA{77, 77, 77, 255}, color.NRGBA{51, 51, 51, 255}, 2),
+					Hover:   image.NewBorderedNineSliceColor(color.NRGBA{99, 99, 99, 255}, color.NRGBA{77, 77, 77, 255}, 2),
+					Pressed: image.NewBorderedNineSliceColor(color.NRGBA{99, 99, 99, 255}, color.NRGBA{77, 77, 77, 255}, 2),
+				},
+			},
+		},
 		ProgressBarTheme: &widget.ProgressBarParams{
 			TrackPadding: widget.NewInsetsSimple(2),
 			TrackImage: &widget.ProgressBarImage{
@@ -224,6 +256,60 @@ func GetBasicDarkTheme() *widget.Theme {
 				},
 			},
 		},
+		TableTheme: &widget.TableParams{
+			HeaderFace: &face,
+			HeaderTextColor: &widget.ButtonTextColor{
+				Idle:    color.White,
+				Hover:   color.White,
+				Pressed: color.White,
+			},
+			HeaderImage: &widget.ButtonImage{
+				Idle:    image.NewBorderedNineSliceColor(color.NRGBA{51, 51, 51, 255}, color.NRGBA{81, 81, 81, 255}, 1),
+				Hover:   image.NewBorderedNineSliceColor(color.NRGBA{77, 77, 77, 255}, color.NRGBA{51, 51, 51, 255}, 1),
+				Pressed: image.NewBorderedNineSliceColor(color.NRGBA{119, 119, 119, 255}, color.NRGBA{77, 77, 77, 255}, 1),
+			},
+			HeaderPadding: widget.NewInsetsSimple(5),
+			CellPadding:   &widget.Insets{Left: 5, Right: 5},
+		},
+		TreeViewTheme: &widget.TreeViewParams{
+			Indent: constantutil.ConstantToPointer(16),
+		},
+		MenuTheme: &widget.MenuParams{
+			BackgroundImage: image.NewBorderedNineSliceColor(color.NRGBA{51, 51, 51, 255}, color.NRGBA{81, 81, 81, 255}, 1),
+			Padding:         widget.NewInsetsSimple(4),
+			MinWidth:        constantutil.ConstantToPointer(150),
+			ItemFace:        &face,
+			ItemImage: &widget.ButtonImage{
+				Idle:     image.NewNineSliceColor(color.Transparent),
+				Hover:    image.NewNineSliceColor(color.NRGBA{77, 77, 77, 255}),
+				Pressed:  image.NewNineSliceColor(color.NRGBA{119, 119, 119, 255}),
+				Disabled: image.NewNineSliceColor(color.Transparent),
+			},
+			ItemTextColor: &widget.ButtonTextColor{
+				Idle:     color.White,
+				Hover:    color.White,
+				Disabled: color.NRGBA{127, 122, 126, 255},
+			},
+			ItemPadding:      &widget.Insets{Left: 8, Right: 8, Top: 4, Bottom: 4},
+			SeparatorImage:   image.NewNineSliceColor(color.NRGBA{81, 81, 81, 255}),
+			SeparatorPadding: &widget.Insets{Left: 4, Right: 4, Top: 3, Bottom: 3},
+			CheckIcon:        menuCheckIcon,
+			SubmenuIcon:      menuSubmenuIcon,
+		},
+		MenuBarTheme: &widget.MenuBarParams{
+			BackgroundImage: image.NewNineSliceColor(color.NRGBA{40, 40, 40, 255}),
+			ButtonFace:      &face,
+			ButtonImage: &widget.ButtonImage{
+				Idle:    image.NewNineSliceColor(color.Transparent),
+				Hover:   image.NewNineSliceColor(color.NRGBA{77, 77, 77, 255}),
+				Pressed: image.NewNineSliceColor(color.NRGBA{119, 119, 119, 255}),
+			},
+			ButtonTextColor: &widget.ButtonTextColor{
+				Idle:     color.White,
+				Disabled: color.NRGBA{127, 122, 126, 255},
+			},
+			ButtonPadding: &widget.Insets{Left: 10, Right: 10, Top: 4, Bottom: 4},
+		},
 		ListComboButtonTheme: &widget.ListComboButtonParams{
 			List: &widget.ListParams{
 				EntryFace:                   &face,
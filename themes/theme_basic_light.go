@@ -73,6 +73,8 @@ func GetBasicLightTheme() *widget.Theme {
 	// load button text font
 	face, _ := loadFont(20)
 
+	menuCheckIcon, menuSubmenuIcon := getMenuIcons(color.Black, color.NRGBA{177, 172, 176, 255})
+
 	return &widget.Theme{
 		DefaultFace:      &face,
 		DefaultTextColor: color.Black,
@@ -145,6 +147,7 @@ func GetBasicLightTheme() *widget.Theme {
 				Caret:         color.Black,
 				Disabled:      color.NRGBA{122, 122, 122, 255},
 				DisabledCaret: color.NRGBA{122, 122, 122, 255},
+				Highlight:     color.NRGBA{153, 201, 255, 160},
 			},
 			Padding: widget.NewInsetsSimple(5),
 		},
@@ -172,6 +175,35 @@ func GetBasicLightTheme() *widget.Theme {
 				},
 			},
 		},
+		TextEditorTheme: &widget.TextEditorParams{
+			Face: &face,
+			Color: &widget.TextInputColor{
+				Idle:          color.Black,
+				Caret:         color.Black,
+				Disabled:      color.NRGBA{122, 122, 122, 255},
+				DisabledCaret: color.NRGBA{122, 122, 122, 255},
+				Highlight:     color.NRGBA{153, 201, 255, 160},
+			},
+			ControlWidgetSpacing:   constantutil.ConstantToPointer(2),
+			TextPadding:            widget.NewInsetsSimple(2),
+			ScrollContainerPadding: widget.NewInsetsSimple(4),
+			ScrollContainerImage: &widget.ScrollContainerImage{
+				Idle:     image.NewBorderedNineSliceColor(color.White, color.NRGBA{177, 177, 177, 255}, 1),
+				Disabled: image.NewBorderedNineSliceColor(color.NRGBA{223, 220, 220, 255}, color.NRGBA{177, 177, 177, 255}, 1),
+				Mask:     image.NewBorderedNineSliceColor(color.White, color.NRGBA{177, 177, 177, 255}, 1),
+			},
+			Slider: &widget.SliderParams{
+				TrackImage: &widget.SliderTrackImage{
+					Idle:     image.NewBorderedNineSliceColor(color.NRGBA{233, 231, 231, 255}, color.NRGBA{223, 220, 220, 255}, 2),
+					Disabled: image.NewBorderedNineSliceColor(color.NRGBA{223, 220, 220, 255}, color.NRGBA{177, 177, 177, 255}, 1),
+				},
+				HandleImage: &widget.ButtonImage{
+					Idle:    image.NewBorderedNineSliceColor(color.White, color.NRGBA{177, 177, 177, 255}, 1),
+					Hover:   image.NewBorderedNineSliceColor(color.NRGBA{235, 235, 235, 255}, color.NRGBA{177, 177, 177, 255}, 2),
+					Pressed: image.NewBorderedNineSliceColor(color.NRGBA{210, 210, 210, 255}, color.NRGBA{177, 177, 177, 255}, 2),
+				},
+			},
+		},
 		ProgressBarTheme: &widget.ProgressBarParams{
 			TrackPadding: widget.NewInsetsSimple(2),
 			TrackImage: &widget.ProgressBarImage{
@@ -235,6 +267,60 @@ func GetBasicLightTheme() *widget.Theme {
 				},
 			},
 		},
+		TableTheme: &widget.TableParams{
+			HeaderFace: &face,
+			HeaderTextColor: &widget.ButtonTextColor{
+				Idle:    color.Black,
+				Hover:   color.Black,
+				Pressed: color.Black,
+			},
+			HeaderImage: &widget.ButtonImage{
+				Idle:    image.NewBorderedNineSliceColor(color.NRGBA{233, 231, 231, 255}, color.NRGBA{223, 220, 220, 255}, 1),
+				Hover:   image.NewBorderedNineSliceColor(color.NRGBA{223, 220, 220, 255}, color.NRGBA{197, 192, 196, 255}, 1),
+				Pressed: image.NewBorderedNineSliceColor(color.NRGBA{197, 192, 196, 255}, color.NRGBA{177, 172, 176, 255}, 1),
+			},
+			HeaderPadding: widget.NewInsetsSimple(5),
+			CellPadding:   &widget.Insets{Left: 5, Right: 5},
+		},
+		TreeViewTheme: &widget.TreeViewParams{
+			Indent: constantutil.ConstantToPointer(16),
+		},
+		MenuTheme: &widget.MenuParams{
+			BackgroundImage: image.NewBorderedNineSliceColor(color.NRGBA{243, 241, 241, 255}, color.NRGBA{197, 192, 196, 255}, 1),
+			Padding:         widget.NewInsetsSimple(4),
+			MinWidth:        constantutil.ConstantToPointer(150),
+			ItemFace:        &face,
+			ItemImage: &widget.ButtonImage{
+				Idle:     image.NewNineSliceColor(color.Transparent),
+				Hover:    image.NewNineSliceColor(color.NRGBA{223, 220, 220, 255}),
+				Pressed:  image.NewNineSliceColor(color.NRGBA{197, 192, 196, 255}),
+				Disabled: image.NewNineSliceColor(color.Transparent),
+			},
+			ItemTextColor: &widget.ButtonTextColor{
+				Idle:     color.Black,
+				Hover:    color.Black,
+				Disabled: color.NRGBA{177, 172, 176, 255},
+			},
+			ItemPadding:      &widget.Insets{Left: 8, Right: 8, Top: 4, Bottom: 4},
+			SeparatorImage:   image.NewNineSliceColor(color.NRGBA{197, 192, 196, 255}),
+			SeparatorPadding: &widget.Insets{Left: 4, Right: 4, Top: 3, Bottom: 3},
+			CheckIcon:        menuCheckIcon,
+			SubmenuIcon:      menuSubmenuIcon,
+		},
+		MenuBarTheme: &widget.MenuBarParams{
+			BackgroundImage: image.NewNineSliceColor(color.NRGBA{233, 231, 231, 255}),
+			ButtonFace:      &face,
+			ButtonImage: &widget.ButtonImage{
+				Idle:    image.NewNineSliceColor(color.Transparent),
+				Hover:   image.NewNineSliceColor(color.NRGBA{223, 220, 220, 255}),
+				Pressed: image.NewNineSliceColor(color.NRGBA{197, 192, 196, 255}),
+			},
+			ButtonTextColor: &widget.ButtonTextColor{
+				Idle:     color.Black,
+				Disabled: color.NRGBA{177, 172, 176, 255},
+			},
+			ButtonPadding: &widget.Insets{Left: 10, Right: 10, Top: 4, Bottom: 4},
+		},
 		ListComboButtonTheme: &widget.ListComboButtonParams{
 			MaxContentHeight: constantutil.ConstantToPointer(200),
 			List: &widget.ListParams{
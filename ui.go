@@ -4,6 +4,7 @@ import (
 	"image"
 	"sort"
 
+	"github.com/ebitenui/ebitenui/animation"
 	"github.com/ebitenui/ebitenui/event"
 	"github.com/ebitenui/ebitenui/input"
 	"github.com/ebitenui/ebitenui/utilities/sliceutil"
@@ -14,15 +15,35 @@ import (
 
 // UI encapsulates a complete user interface that can be rendered onto the screen.
 // There should only be exactly one UI per application.
+//
+// Each UI reads its input from its own InputSource, but the input layer stack, the animations of
+// animation.DefaultPlayer and the queue of deferred events are package globals shared by all UIs.
+// Several UIs can therefore only be used one after another, such as in tests, not side by side.
 type UI struct {
 	// Container is the root container of the UI hierarchy.
 	Container widget.Containerer
+
+	// InputSource provides the input this UI reacts to, for example recorded or scripted input.
+	// If nil, input is read from Ebitengine. See UI for the state that is shared regardless of it.
+	InputSource input.Source
+
 	// If true the default tab/shift-tab to focus will be disabled
 	DisableDefaultFocus bool
 
 	// If true the default relayering of Windows will be disabled
 	DisableWindowRelayering bool
 
+	// If true the arrow keys and the gamepad D-pad move focus to the nearest focusable widget
+	// in that direction, the gamepad's A button activates the focused widget and its B button
+	// cancels (closes an open list or the topmost window that closes on click).
+	// Arrow keys are left to the focused widget if it uses them itself, e.g. a TextInput.
+	EnableSpatialNavigation bool
+
+	// Keymap are the keys and gamepad buttons the widgets and the UI react to, for example to activate
+	// the focused widget or to move focus. If nil, widget.DefaultKeymap is used.
+	// Widgets can override it with widget.WidgetOpts.Keymap.
+	Keymap *widget.Keymap
+
 	// This exposes a Render call before the Container is drawn,
 	// but after the Windows with DrawLayer < 0 are drawn.
 	PreRenderHook widget.RenderFunc
@@ -35,25 +56,39 @@ type UI struct {
 	PrimaryTheme  *widget.Theme
 	previousTheme *widget.Theme
 
+	previousKeymap *widget.Keymap
+
 	focusedWidget      widget.Focuser
 	focusedWindow      *widget.Window
 	focusedWindowIndex int
 	inputLayerers      []input.Layerer
 	windows            []*widget.Window
+	shortcuts          []*shortcut
 
 	previousContainer          widget.Containerer
 	previousRemoveHandlerFuncs []event.RemoveHandlerFunc
 	tabWasPressed              bool
+	directionWasPressed        bool
+	activateWasPressed         bool
+	cancelWasPressed           bool
 	updObj                     *widget.UpdateObject
 
-	debugMode bool
+	debugMode    bool
+	inspector    *inspector
+	screenBounds image.Rectangle
+	// hovered is the widget the debug overlay describes, measured at hoveredSize.
+	hovered     widget.HasWidget
+	hoveredSize *image.Point
 }
 
 // Update updates u. This method should be called in the Ebiten Update function.
 func (u *UI) Update() {
+	input.SetSource(u.InputSource)
 	input.Update()
 	defer input.AfterUpdate()
 
+	animation.Update()
+
 	if u.previousContainer == nil || u.previousContainer != u.Container {
 		for _, removeHandler := range u.previousRemoveHandlerFuncs {
 			removeHandler()
@@ -63,13 +98,18 @@ func (u *UI) Update() {
 			u.Container.GetWidget().FocusEvent.AddHandler(u.handleFocusEvent),
 			u.Container.GetWidget().ToolTipEvent.AddHandler(u.handleToolTipEvent),
 			u.Container.GetWidget().DragAndDropEvent.AddHandler(u.handleDragAndDropEvent),
+			u.Container.GetWidget().PopupEvent.AddHandler(u.handlePopupEvent),
 		}
 		u.previousContainer = u.Container
+		// Hand the Keymap down to the new container.
+		u.previousKeymap = nil
 		// Close all Ephemeral Windows (tooltip/dnd/etc).
 		u.closeEphemeralWindows(0)
 	}
 	u.setTheme()
+	u.setKeymap()
 	u.handleFocusChangeRequest()
+	u.handleShortcuts()
 
 	// If widget is not visible or disabled, change focus to next widget.
 	if u.focusedWidget != nil && (u.focusedWidget.GetWidget().Disabled || !u.focusedWidget.GetWidget().IsVisible()) {
@@ -121,6 +161,10 @@ func (u *UI) Update() {
 		u.windows = sliceutil.ShiftEnd(u.windows, u.focusedWindowIndex)
 	}
 
+	if u.IsInspectorOpen() {
+		u.inspector.update()
+	}
+
 	event.ExecuteDeferred()
 }
 
@@ -138,15 +182,22 @@ func (u *UI) resetUpdateObject() {
 // Draw renders u onto screen. This function should be called in the Ebiten Draw function.
 func (u *UI) Draw(screen *ebiten.Image) {
 	u.setTheme()
+	input.SetSource(u.InputSource)
 	input.Draw(screen)
 	defer input.AfterDraw(screen)
 	x, y := screen.Bounds().Dx(), screen.Bounds().Dy()
 	rect := image.Rect(0, 0, x, y)
+	u.screenBounds = rect
 	u.setupInputLayers()
 	u.Container.SetLocation(rect)
 	u.render(screen)
 	// Render elements that pop up (like combobox) on top of everything else
 	widget.RenderDeferred(screen)
+
+	if u.debugMode {
+		u.drawDebugOverlay(screen)
+	}
+	u.drawInspectorSelection(screen)
 }
 
 func (u *UI) setupInputLayers() {
@@ -207,6 +258,31 @@ func (u *UI) setTheme() {
 		}
 	}
 }
+
+// setKeymap hands the Keymap down to the root container and the open windows when it changes.
+func (u *UI) setKeymap() {
+	if u.Keymap == u.previousKeymap {
+		return
+	}
+	if c := u.Container.GetWidget(); c.GetKeymap() == u.previousKeymap {
+		c.SetKeymap(u.Keymap)
+	}
+	for _, w := range u.windows {
+		if c := w.GetContainer().GetWidget(); c.GetKeymap() == u.previousKeymap {
+			c.SetKeymap(u.Keymap)
+		}
+	}
+	u.previousKeymap = u.Keymap
+}
+
+// keymap returns the key bindings of the focused widget, or those of the root container if nothing is focused.
+func (u *UI) keymap() *widget.Keymap {
+	if u.focusedWidget != nil {
+		return u.focusedWidget.GetWidget().Keymap()
+	}
+	return u.Container.GetWidget().Keymap()
+}
+
 func (u *UI) handleContextMenu(args interface{}) {
 	if a, ok := args.(*widget.WidgetContextMenuEventArgs); ok {
 		x, y := a.Widget.ContextMenu.PreferredSize()
@@ -263,6 +339,19 @@ func (u *UI) handleDragAndDropEvent(args interface{}) {
 	}
 }
 
+// handlePopupEvent shows or hides popup windows such as menus. They are ephemeral, so they close
+// like tooltips when other windows open or close.
+func (u *UI) handlePopupEvent(args interface{}) {
+	if a, ok := args.(*widget.WidgetPopupEventArgs); ok {
+		if a.Show {
+			a.Window.Ephemeral = true
+			u.addWindow(a.Window)
+		} else {
+			u.removeWindow(a.Window)
+		}
+	}
+}
+
 func (u *UI) getDropTargets() []widget.HasWidget {
 	dropTargets := u.Container.GetDropTargets()
 	// Loop through the windows array in reverse. If we find a modal window, only loop through its droppable widgets
@@ -279,10 +368,12 @@ func (u *UI) getDropTargets() []widget.HasWidget {
 
 func (u *UI) handleFocusChangeRequest() {
 	if !u.DisableDefaultFocus {
-		if input.KeyPressed(ebiten.KeyTab) {
+		keymap := u.keymap()
+		// Previous is checked first, as Shift+Tab also matches the unmodified Tab of Next.
+		if previous := keymap.KeyPressed(widget.KeyActionPrevious); previous || keymap.KeyPressed(widget.KeyActionNext) {
 			if !u.tabWasPressed {
 				u.tabWasPressed = true
-				if input.KeyPressed(ebiten.KeyShift) {
+				if previous {
 					u.ChangeFocus(widget.FOCUS_PREVIOUS)
 				} else {
 					u.ChangeFocus(widget.FOCUS_NEXT)
@@ -292,6 +383,82 @@ func (u *UI) handleFocusChangeRequest() {
 			u.tabWasPressed = false
 		}
 	}
+	if u.EnableSpatialNavigation {
+		u.handleSpatialNavigation()
+	}
+}
+
+func (u *UI) handleSpatialNavigation() {
+	keymap := u.keymap()
+	if direction, keyboard, ok := spatialNavigationDirection(keymap); ok {
+		if !u.directionWasPressed {
+			u.directionWasPressed = true
+			handled := false
+			if h, ok := u.focusedWidget.(widget.DirectionalKeyHandler); ok && keyboard {
+				handled = h.HandlesDirection(direction)
+			}
+			if !handled {
+				u.ChangeFocus(direction)
+			}
+		}
+	} else {
+		u.directionWasPressed = false
+	}
+
+	activate := keymap.GamepadButtonPressed(widget.KeyActionActivate)
+	if activate && !u.activateWasPressed {
+		if a, ok := u.focusedWidget.(widget.Activator); ok {
+			a.Activate()
+		}
+	}
+	u.activateWasPressed = activate
+
+	cancel := keymap.GamepadButtonPressed(widget.KeyActionCancel)
+	if cancel && !u.cancelWasPressed {
+		u.cancel()
+	}
+	u.cancelWasPressed = cancel
+}
+
+// spatialNavigationDirection returns the direction requested by the gamepad buttons or keys
+// bound to the navigation actions of keymap, and whether it came from the keyboard.
+func spatialNavigationDirection(keymap *widget.Keymap) (widget.FocusDirection, bool, bool) {
+	switch {
+	case keymap.GamepadButtonPressed(widget.KeyActionUp):
+		return widget.FOCUS_NORTH, false, true
+	case keymap.GamepadButtonPressed(widget.KeyActionDown):
+		return widget.FOCUS_SOUTH, false, true
+	case keymap.GamepadButtonPressed(widget.KeyActionLeft):
+		return widget.FOCUS_WEST, false, true
+	case keymap.GamepadButtonPressed(widget.KeyActionRight):
+		return widget.FOCUS_EAST, false, true
+	case keymap.KeyPressed(widget.KeyActionUp):
+		return widget.FOCUS_NORTH, true, true
+	case keymap.KeyPressed(widget.KeyActionDown):
+		return widget.FOCUS_SOUTH, true, true
+	case keymap.KeyPressed(widget.KeyActionLeft):
+		return widget.FOCUS_WEST, true, true
+	case keymap.KeyPressed(widget.KeyActionRight):
+		return widget.FOCUS_EAST, true, true
+	}
+	return widget.FOCUS_NEXT, false, false
+}
+
+// cancel lets the focused widget dismiss what it opened, or otherwise closes the topmost window
+// that would close on a click.
+func (u *UI) cancel() {
+	if c, ok := u.focusedWidget.(widget.Canceler); ok && c.Cancel() {
+		return
+	}
+	for i := len(u.windows) - 1; i >= 0; i-- {
+		if u.windows[i].Ephemeral {
+			continue
+		}
+		if u.windows[i].GetCloseMode() != widget.NONE {
+			u.windows[i].Close()
+		}
+		return
+	}
 }
 
 func (u *UI) ChangeFocus(direction widget.FocusDirection) {
@@ -353,7 +520,12 @@ func (u *UI) ChangeFocus(direction widget.FocusDirection) {
 		}
 	} else {
 		if u.focusedWidget != nil {
-			if next := u.focusedWidget.GetFocus(direction); next != nil {
+			// Links set with AddFocus take precedence over the geometrically nearest widget.
+			next := u.focusedWidget.GetFocus(direction)
+			if next == nil {
+				next = widget.NearestFocuser(u.focusedWidget.GetWidget().Rect, focusableWidgets, direction)
+			}
+			if next != nil {
 				if !next.GetWidget().Disabled && next.GetWidget().IsVisible() {
 					u.focusedWidget.Focus(false)
 					next.Focus(true)
@@ -380,6 +552,7 @@ func (u *UI) AddWindowQuietly(w *widget.Window, closeEphemeralWindows bool) widg
 		w.GetContainer().GetWidget().FocusEvent.AddHandler(u.handleFocusEvent)
 		w.GetContainer().GetWidget().ToolTipEvent.AddHandler(u.handleToolTipEvent)
 		w.GetContainer().GetWidget().DragAndDropEvent.AddHandler(u.handleDragAndDropEvent)
+		w.GetContainer().GetWidget().PopupEvent.AddHandler(u.handlePopupEvent)
 
 		if w.Modal && u.focusedWidget != nil {
 			u.focusedWidget.Focus(false)
@@ -402,6 +575,9 @@ func (u *UI) addWindow(w *widget.Window) bool {
 	if w.GetContainer().GetWidget().GetTheme() == nil {
 		w.GetContainer().GetWidget().SetTheme(u.PrimaryTheme)
 	}
+	if w.GetContainer().GetWidget().GetKeymap() == nil {
+		w.GetContainer().GetWidget().SetKeymap(u.Keymap)
+	}
 	w.GetContainer().Validate()
 
 	closeFunc := func() {
@@ -430,11 +606,17 @@ func (u *UI) removeWindow(w *widget.Window) {
 	}
 }
 
-// Used to close tooltips/dnd etc
+// Used to close tooltips/dnd etc. Only popup windows, such as menus, are told that they were
+// closed, so that they can update their state.
 func (u *UI) closeEphemeralWindows(windowIdx int) {
 	for i := len(u.windows) - 1; i >= windowIdx; i-- {
-		if u.windows[i].Ephemeral {
+		if w := u.windows[i]; w.Ephemeral {
 			u.windows = append(u.windows[:i], u.windows[i+1:]...)
+			if w.IsPopup() {
+				w.ClosedEvent.Fire(&widget.WindowClosedEventArgs{
+					Window: w,
+				})
+			}
 		}
 	}
 }
@@ -492,8 +674,9 @@ func (u *UI) SetFocusedWidget(focused widget.Focuser) {
 	}
 }
 
-// SetDebugMode enables or disables DebugMode which shows the
-// margins of the Widgets when hovering the cursor on them
+// SetDebugMode enables or disables DebugMode, which outlines all widgets in the color of the layout
+// that locates them, shades the padding of layouts, and describes the widget below the cursor.
+// See also ShowInspector.
 func (u *UI) SetDebugMode(dm bool) {
 	u.debugMode = dm
 }
@@ -502,3 +685,42 @@ func (u *UI) SetDebugMode(dm bool) {
 func (u *UI) GetDebugMode() bool {
 	return u.debugMode
 }
+
+// Walk calls f for the root container, the open windows and all widgets below them.
+// See widget.Walk for the widgets that are visited. Returning false from f stops the walk.
+func (u *UI) Walk(f widget.WalkFunc) bool {
+	if u.Container != nil && !widget.Walk(u.Container, f) {
+		return false
+	}
+	for _, w := range u.windows {
+		if !w.Walk(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// FindByID returns the first widget in the UI, including open windows, whose ID is id, or nil if there is none.
+func (u *UI) FindByID(id string) widget.HasWidget {
+	var result widget.HasWidget
+	u.Walk(func(w widget.HasWidget) bool {
+		if w.GetWidget().ID == id {
+			result = w
+			return false
+		}
+		return true
+	})
+	return result
+}
+
+// FindAll returns all widgets in the UI, including open windows, for which pred returns true.
+func (u *UI) FindAll(pred func(w widget.HasWidget) bool) []widget.HasWidget {
+	var result []widget.HasWidget
+	u.Walk(func(w widget.HasWidget) bool {
+		if pred(w) {
+			result = append(result, w)
+		}
+		return true
+	})
+	return result
+}
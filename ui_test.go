@@ -0,0 +1,58 @@
+package ebitenui_test
+
+import (
+	"testing"
+
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/uitest"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestUI_Keymap(t *testing.T) {
+	is := is.New(t)
+
+	ui, button, textInput := newShortcutUI()
+	keymap := widget.DefaultKeymap()
+	keymap.Bind(widget.KeyActionNext, widget.KeyBinding{Keys: []input.KeyChord{{Key: ebiten.KeyPageDown}}})
+	ui.Keymap = keymap
+
+	h := uitest.New(t, ui, 200, 100)
+	h.PressKey(ebiten.KeyTab)
+	is.True(!button.IsFocused())
+
+	h.PressKey(ebiten.KeyPageDown)
+	is.True(button.IsFocused())
+	h.PressKey(ebiten.KeyPageDown)
+	is.True(textInput.IsFocused())
+
+	// Windows added later use the keymap of the UI as well.
+	window := widget.NewWindow(widget.WindowOpts.Contents(widget.NewContainer()))
+	ui.AddWindow(window)
+	is.Equal(window.GetContainer().GetWidget().Keymap(), keymap)
+}
+
+func TestUI_CloseEphemeralWindows(t *testing.T) {
+	is := is.New(t)
+
+	ui, _, _ := newShortcutUI()
+	h := uitest.New(t, ui, 200, 100)
+
+	closed := false
+	toolTip := widget.NewWindow(
+		widget.WindowOpts.Contents(widget.NewContainer()),
+		widget.WindowOpts.ClosedHandler(func(_ *widget.WindowClosedEventArgs) {
+			closed = true
+		}),
+	)
+	toolTip.Ephemeral = true
+	ui.AddWindowQuietly(toolTip, false)
+	is.True(ui.IsWindowOpen(toolTip))
+
+	// Opening another window closes ephemeral windows, but only popup windows are told so.
+	ui.AddWindow(widget.NewWindow(widget.WindowOpts.Contents(widget.NewContainer())))
+	h.Frame()
+	is.True(!ui.IsWindowOpen(toolTip))
+	is.True(!closed)
+}
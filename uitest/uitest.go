@@ -0,0 +1,192 @@
+// Package uitest runs a UI headlessly for tests.
+//
+// A Harness drives a UI with an input.FakeSource and a fake clock, and draws every frame onto an
+// offscreen image, so tests can click, type and wait deterministically without a window:
+//
+//	h := uitest.New(t, &ebitenui.UI{Container: root}, 640, 480)
+//	h.Click(okButton)
+//	h.Type("hello")
+//	h.AdvanceTime(time.Second)
+package uitest
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/ebitenui/ebitenui"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/clock"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// FrameDuration is how far the clock moves for every frame, matching Ebitengine's default TPS.
+const FrameDuration = time.Second / 60
+
+// DragSteps is the number of frames Drag takes to move the cursor from start to end.
+const DragSteps = 5
+
+// Harness runs a UI without a window.
+type Harness struct {
+	// UI is the UI under test. Its InputSource is set to Input.
+	UI *ebitenui.UI
+	// Input provides the input of the UI. It can be used directly for input the helpers do not cover.
+	Input *input.FakeSource
+	// Clock is the clock used by all widgets while the harness is alive.
+	Clock *clock.Fake
+	// Screen is the offscreen image the UI is drawn onto.
+	Screen *ebiten.Image
+}
+
+// New returns a Harness for ui with a screen of the given size and runs a first frame,
+// so that the widgets are laid out. The global clock is restored when the test ends.
+func New(t testing.TB, ui *ebitenui.UI, width int, height int) *Harness {
+	t.Helper()
+
+	h := &Harness{
+		UI:     ui,
+		Input:  input.NewFakeSource(),
+		Clock:  clock.NewFake(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Screen: ebiten.NewImage(width, height),
+	}
+	ui.InputSource = h.Input
+
+	clock.Set(h.Clock)
+	t.Cleanup(func() {
+		clock.Set(nil)
+		input.SetSource(nil)
+	})
+
+	h.Frame()
+	return h
+}
+
+// Frame runs one Update and Draw of the UI and advances the clock by FrameDuration.
+func (h *Harness) Frame() {
+	// There is no window whose cursor could be managed.
+	cursorManagement := input.CursorManagementEnabled
+	input.CursorManagementEnabled = false
+	defer func() {
+		input.CursorManagementEnabled = cursorManagement
+	}()
+
+	h.UI.Update()
+	h.Screen.Clear()
+	h.UI.Draw(h.Screen)
+	h.Clock.Advance(FrameDuration)
+}
+
+// Frames runs n frames.
+func (h *Harness) Frames(n int) {
+	for i := 0; i < n; i++ {
+		h.Frame()
+	}
+}
+
+// AdvanceTime moves the clock forward by d, calling the timers that become due, and runs a frame
+// so that widgets can react to them.
+func (h *Harness) AdvanceTime(d time.Duration) {
+	h.Clock.Advance(d)
+	h.Frame()
+}
+
+// Center returns the center of w's Rect.
+func Center(w widget.HasWidget) image.Point {
+	r := w.GetWidget().Rect
+	return image.Pt((r.Min.X+r.Max.X)/2, (r.Min.Y+r.Max.Y)/2)
+}
+
+// Hover moves the cursor to the center of w and runs a frame.
+func (h *Harness) Hover(w widget.HasWidget) {
+	h.HoverAt(Center(w))
+}
+
+// HoverAt moves the cursor to p and runs a frame.
+func (h *Harness) HoverAt(p image.Point) {
+	h.Input.SetCursorPosition(p.X, p.Y)
+	h.Frame()
+}
+
+// Click clicks the left mouse button at the center of w.
+func (h *Harness) Click(w widget.HasWidget) {
+	h.ClickAt(Center(w))
+}
+
+// ClickAt moves the cursor to p, then presses and releases the left mouse button, one frame each.
+func (h *Harness) ClickAt(p image.Point) {
+	h.ClickButtonAt(p, ebiten.MouseButtonLeft)
+}
+
+// ClickButtonAt moves the cursor to p, then presses and releases mouse button b, one frame each.
+func (h *Harness) ClickButtonAt(p image.Point, b ebiten.MouseButton) {
+	h.HoverAt(p)
+	h.Input.PressMouseButton(b)
+	h.Frame()
+	h.Input.ReleaseMouseButton(b)
+	h.Frame()
+}
+
+// Drag presses the left mouse button at from, moves the cursor to to in DragSteps frames
+// and releases the button there.
+func (h *Harness) Drag(from image.Point, to image.Point) {
+	h.HoverAt(from)
+	h.Input.PressMouseButton(ebiten.MouseButtonLeft)
+	h.Frame()
+	for i := 1; i <= DragSteps; i++ {
+		h.HoverAt(from.Add(to.Sub(from).Mul(i).Div(DragSteps)))
+	}
+	h.Input.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	h.Frame()
+}
+
+// Tap touches the center of w for one frame and releases the touch in the next one.
+func (h *Harness) Tap(w widget.HasWidget) {
+	p := Center(w)
+	h.Input.Touch(0, p.X, p.Y)
+	h.Frame()
+	h.Input.ReleaseTouch(0)
+	h.Frame()
+}
+
+// TouchDrag touches from, moves the touch to to in DragSteps frames and releases it there.
+// A fast drag is recognized as a swipe and keeps scrolling after the release, see Frames.
+func (h *Harness) TouchDrag(from image.Point, to image.Point) {
+	h.Input.Touch(0, from.X, from.Y)
+	h.Frame()
+	for i := 1; i <= DragSteps; i++ {
+		p := from.Add(to.Sub(from).Mul(i).Div(DragSteps))
+		h.Input.Touch(0, p.X, p.Y)
+		h.Frame()
+	}
+	h.Input.ReleaseTouch(0)
+	h.Frame()
+}
+
+// Type sends text as typed characters in a single frame.
+func (h *Harness) Type(text string) {
+	h.Input.Type(text)
+	h.Frame()
+}
+
+// PressKey holds the modifiers and presses key k for one frame, then releases all of them in the next frame.
+func (h *Harness) PressKey(k ebiten.Key, modifiers ...ebiten.Key) {
+	for _, m := range modifiers {
+		h.Input.PressKey(m)
+	}
+	h.Input.PressKey(k)
+	h.Frame()
+	h.Input.ReleaseKey(k)
+	for _, m := range modifiers {
+		h.Input.ReleaseKey(m)
+	}
+	h.Frame()
+}
+
+// PressGamepadButton presses and releases standard gamepad button b, one frame each.
+func (h *Harness) PressGamepadButton(b ebiten.StandardGamepadButton) {
+	h.Input.PressGamepadButton(b)
+	h.Frame()
+	h.Input.ReleaseGamepadButton(b)
+	h.Frame()
+}
@@ -0,0 +1,159 @@
+package uitest
+
+import (
+	"image"
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/ebitenui/ebitenui"
+	e_image "github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/matryer/is"
+	"golang.org/x/image/font/basicfont"
+)
+
+func TestHarness_Click(t *testing.T) {
+	is := is.New(t)
+
+	clicks := 0
+	button := newButton(widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
+		clicks++
+	}))
+	h := New(t, newUI(button), 200, 100)
+
+	h.Click(button)
+	is.Equal(clicks, 1)
+
+	h.ClickAt(image.Pt(199, 99)) // outside of the button
+	is.Equal(clicks, 1)
+}
+
+func TestHarness_TypeAndPressKey(t *testing.T) {
+	is := is.New(t)
+
+	textInput := newTextInput()
+	h := New(t, newUI(textInput), 200, 100)
+
+	h.Click(textInput)
+	is.True(textInput.IsFocused())
+
+	h.Type("hello")
+	is.Equal(textInput.GetText(), "hello")
+
+	h.PressKey(ebiten.KeyBackspace)
+	is.Equal(textInput.GetText(), "hell")
+
+	h.PressKey(ebiten.KeyZ, ebiten.KeyControl)
+	is.Equal(textInput.GetText(), "hello")
+}
+
+func TestHarness_AdvanceTime(t *testing.T) {
+	is := is.New(t)
+
+	textInput := newTextInput(
+		widget.TextInputOpts.RepeatInterval(50 * time.Millisecond),
+	)
+	h := New(t, newUI(textInput), 200, 100)
+	h.Click(textInput)
+	h.Type("hello")
+
+	h.Input.PressKey(ebiten.KeyBackspace)
+	h.Frame()
+	is.Equal(textInput.GetText(), "hell")
+
+	// Holding the key repeats it once the repeat delay has passed.
+	h.AdvanceTime(300 * time.Millisecond)
+	h.AdvanceTime(50 * time.Millisecond)
+	h.AdvanceTime(50 * time.Millisecond)
+	h.Input.ReleaseKey(ebiten.KeyBackspace)
+	h.Frame()
+
+	is.Equal(textInput.GetText(), "h")
+}
+
+func TestHarness_Drag(t *testing.T) {
+	is := is.New(t)
+
+	var positions []int
+	slider := widget.NewSlider(
+		widget.SliderOpts.MinMax(0, 100),
+		widget.SliderOpts.Images(&widget.SliderTrackImage{}, &widget.ButtonImage{
+			Idle:    e_image.NewNineSliceColor(color.White),
+			Pressed: e_image.NewNineSliceColor(color.White),
+		}),
+		widget.SliderOpts.WidgetOpts(widget.WidgetOpts.MinSize(200, 20)),
+		widget.SliderOpts.ChangedHandler(func(args *widget.SliderChangedEventArgs) {
+			positions = append(positions, args.Current)
+		}),
+	)
+	h := New(t, newUI(slider), 200, 100)
+
+	r := slider.GetWidget().Rect
+	h.Drag(image.Pt(r.Min.X+1, r.Min.Y+10), image.Pt(r.Max.X, r.Min.Y+10))
+
+	is.True(len(positions) > 0)
+	is.Equal(slider.Current, 100)
+}
+
+func TestHarness_TapAndTouchDrag(t *testing.T) {
+	is := is.New(t)
+
+	taps, clicks, pans := 0, 0, 0
+	button := newButton(
+		widget.ButtonOpts.ClickedHandler(func(_ *widget.ButtonClickedEventArgs) {
+			clicks++
+		}),
+		widget.ButtonOpts.WidgetOpts(
+			widget.WidgetOpts.TapHandler(func(_ *widget.WidgetTapEventArgs) {
+				taps++
+			}),
+			widget.WidgetOpts.PanHandler(func(_ *widget.WidgetPanEventArgs) {
+				pans++
+			}),
+		),
+	)
+	h := New(t, newUI(button), 200, 100)
+
+	h.Tap(button)
+	is.Equal(taps, 1)
+	is.Equal(clicks, 1)
+
+	h.TouchDrag(image.Pt(10, 10), image.Pt(60, 10))
+	is.Equal(taps, 1)
+	is.Equal(clicks, 1)
+	is.True(pans > 0)
+}
+
+func newUI(w widget.PreferredSizeLocateableWidget) *ebitenui.UI {
+	root := widget.NewContainer(widget.ContainerOpts.Layout(widget.NewRowLayout(
+		widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+	)))
+	root.AddChild(w)
+	return &ebitenui.UI{Container: root}
+}
+
+func newButton(opts ...widget.ButtonOpt) *widget.Button {
+	return widget.NewButton(append(opts,
+		widget.ButtonOpts.Image(&widget.ButtonImage{
+			Idle:    e_image.NewNineSliceColor(color.White),
+			Pressed: e_image.NewNineSliceColor(color.White),
+		}),
+		widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.MinSize(100, 30)),
+	)...)
+}
+
+func newTextInput(opts ...widget.TextInputOpt) *widget.TextInput {
+	var face text.Face = text.NewGoXFace(basicfont.Face7x13)
+	return widget.NewTextInput(append(opts,
+		widget.TextInputOpts.Face(&face),
+		widget.TextInputOpts.Color(&widget.TextInputColor{
+			Idle:     color.White,
+			Disabled: color.White,
+			Caret:    color.White,
+		}),
+		widget.TextInputOpts.WidgetOpts(widget.WidgetOpts.MinSize(100, 30)),
+	)...)
+}
@@ -0,0 +1,148 @@
+// Package clock provides the time to widgets that blink, repeat or delay something, so that
+// tests and replays can control it instead of waiting for the wall clock.
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock tells the current time and runs functions after a delay.
+type Clock interface {
+	Now() time.Time
+	// AfterFunc calls f in its own goroutine or, for a fake clock, synchronously once d has elapsed.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a function scheduled with AfterFunc.
+type Timer interface {
+	// Stop prevents the function from being called. It returns false if it has already been called
+	// or the timer was already stopped.
+	Stop() bool
+}
+
+type systemClock struct{}
+
+var (
+	lock    sync.RWMutex
+	current Clock = systemClock{}
+)
+
+// Set makes c the clock used by all widgets. If c is nil, the system clock is used.
+func Set(c Clock) {
+	if c == nil {
+		c = systemClock{}
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	current = c
+}
+
+// Get returns the clock used by all widgets.
+func Get() Clock {
+	lock.RLock()
+	defer lock.RUnlock()
+	return current
+}
+
+// Now returns the current time of the clock in use.
+func Now() time.Time {
+	return Get().Now()
+}
+
+// Since returns the time elapsed since t on the clock in use.
+func Since(t time.Time) time.Duration {
+	return Now().Sub(t)
+}
+
+// AfterFunc calls f once d has elapsed on the clock in use.
+func AfterFunc(d time.Duration, f func()) Timer {
+	return Get().AfterFunc(d, f)
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// Fake is a Clock that only moves when it is advanced. Functions scheduled with AfterFunc
+// are called synchronously by Advance, in the order they are due.
+type Fake struct {
+	lock   sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+	seq    int
+}
+
+type fakeTimer struct {
+	clock *Fake
+	at    time.Time
+	seq   int
+	f     func()
+}
+
+// NewFake returns a Fake clock set to start.
+func NewFake(start time.Time) *Fake {
+	return &Fake{now: start}
+}
+
+func (c *Fake) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+func (c *Fake) AfterFunc(d time.Duration, f func()) Timer {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.seq++
+	t := &fakeTimer{clock: c, at: c.now.Add(d), seq: c.seq, f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by d, calling the functions that become due on the way.
+// Functions scheduled by those functions are called as well if they are due before the new time.
+func (c *Fake) Advance(d time.Duration) {
+	c.lock.Lock()
+	end := c.now.Add(d)
+	c.lock.Unlock()
+
+	for {
+		c.lock.Lock()
+		sort.Slice(c.timers, func(i, j int) bool {
+			if c.timers[i].at.Equal(c.timers[j].at) {
+				return c.timers[i].seq < c.timers[j].seq
+			}
+			return c.timers[i].at.Before(c.timers[j].at)
+		})
+		if len(c.timers) == 0 || c.timers[0].at.After(end) {
+			c.now = end
+			c.lock.Unlock()
+			return
+		}
+		t := c.timers[0]
+		c.timers = c.timers[1:]
+		c.now = t.at
+		c.lock.Unlock()
+
+		t.f()
+	}
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i := range c.timers {
+		if c.timers[i] == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
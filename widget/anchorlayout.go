@@ -1,6 +1,12 @@
 package widget
 
-import "image"
+import (
+	"errors"
+	"image"
+	"log"
+	"math"
+	"slices"
+)
 
 // AnchorLayout layouts widgets anchored to either a corner or edge of a rectangle,
 // optionally stretching it in one or both directions.
@@ -9,8 +15,13 @@ import "image"
 // The widgets in the container will be drawn in the order they were added to the container.
 //
 // Widget.LayoutData of widgets being layouted by AnchorLayout need to be of type AnchorLayoutData.
+//
+// A widget may also be anchored to a sibling widget instead of the rectangle, see AnchorLayoutData.Sibling.
+// If widgets are anchored to each other in a cycle, all of them are anchored to the rectangle instead,
+// Layout logs ErrAnchorLayoutCycle and Err returns it until the next Layout.
 type AnchorLayout struct {
 	padding *Insets
+	err     error
 }
 
 // AnchorLayoutOpt is a function that configures a.
@@ -38,8 +49,41 @@ type AnchorLayoutData struct {
 
 	// Sets the padding for the child.
 	Padding *Insets
+
+	// WidthPercent specifies the width as a percentage of the width of the rectangle, from 0 to 100. If set,
+	// it overrides StretchHorizontal.
+	WidthPercent float64
+
+	// HeightPercent specifies the height as a percentage of the height of the rectangle, from 0 to 100. If set,
+	// it overrides StretchVertical.
+	HeightPercent float64
+
+	// AspectRatio specifies the ratio of width to height to keep. If only one of width and height is
+	// stretched or set by percentage, the other one follows it; if both are, the widget is shrunk to fit.
+	AspectRatio float64
+
+	// OffsetX and OffsetY specify how far to move the widget from its anchored position, in pixels.
+	OffsetX, OffsetY int
+
+	// OffsetXPercent and OffsetYPercent specify how far to move the widget from its anchored position,
+	// as a percentage of the size of the rectangle. They add to OffsetX and OffsetY.
+	OffsetXPercent, OffsetYPercent float64
+
+	// Sibling specifies another widget in the same container to anchor the widget to, instead of the rectangle.
+	// The widget is placed next to Sibling on SiblingSide, and aligned along that side according to
+	// HorizontalPosition or VerticalPosition. Stretching and percentages remain relative to the rectangle.
+	Sibling HasWidget
+
+	// SiblingSide specifies on which side of Sibling to place the widget.
+	SiblingSide AnchorLayoutSide
+
+	// SiblingGap specifies the space between the widget and Sibling.
+	SiblingGap int
 }
 
+// AnchorLayoutSide is the type used to specify on which side of a sibling a widget is placed.
+type AnchorLayoutSide int
+
 const (
 	// AnchorLayoutPositionStart is the anchoring position for "left" (in the horizontal direction) or "top" (in the vertical direction.)
 	AnchorLayoutPositionStart = AnchorLayoutPosition(iota)
@@ -51,6 +95,29 @@ const (
 	AnchorLayoutPositionEnd
 )
 
+const (
+	// AnchorLayoutSideLeft places a widget to the left of its sibling.
+	AnchorLayoutSideLeft = AnchorLayoutSide(iota)
+
+	// AnchorLayoutSideRight places a widget to the right of its sibling.
+	AnchorLayoutSideRight
+
+	// AnchorLayoutSideAbove places a widget above its sibling.
+	AnchorLayoutSideAbove
+
+	// AnchorLayoutSideBelow places a widget below its sibling.
+	AnchorLayoutSideBelow
+)
+
+var (
+	// ErrAnchorLayoutCycle is reported by AnchorLayout.Err when widgets are anchored to each other in a cycle.
+	ErrAnchorLayoutCycle = errors.New("anchor layout: widgets are anchored to each other in a cycle")
+
+	// ErrAnchorLayoutSibling is reported by AnchorLayout.Err when a widget is anchored to a widget that is not
+	// in the same container.
+	ErrAnchorLayoutSibling = errors.New("anchor layout: widget is anchored to a widget that is not a sibling")
+)
+
 // AnchorLayoutOpts contains functions that configure an AnchorLayout.
 var AnchorLayoutOpts AnchorLayoutOptions
 
@@ -76,79 +143,354 @@ func (o AnchorLayoutOptions) Padding(i *Insets) AnchorLayoutOpt {
 	}
 }
 
+// GetPadding implements PaddedLayouter.
+func (a *AnchorLayout) GetPadding() *Insets {
+	return a.padding
+}
+
 // PreferredSize implements Layouter.
 func (a *AnchorLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int, int) {
+	return a.PreferredSizeFor(widgets, 0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedLayouter. The preferred size fits every widget anchored to the
+// rectangle at its preferred size, together with the widgets anchored to it through siblings, as far as they
+// can be fitted by growing the rectangle.
+func (a *AnchorLayout) PreferredSizeFor(widgets []PreferredSizeLocateableWidget, width int, height int) (int, int) {
 	px, py := a.padding.Dx(), a.padding.Dy()
 
 	if len(widgets) == 0 {
 		return px, py
 	}
 
-	w, h := widgets[0].PreferredSize()
+	maxWidth, maxHeight := a.padding.constrainedSize(width, height)
+	siblings, _ := siblingIndexes(widgets)
+
+	w, h := 0, 0
+	for idx, widget := range widgets {
+		if siblings[idx] >= 0 {
+			continue
+		}
+		ww, wh := a.requiredSize(widget, maxWidth, maxHeight)
+		w, h = max(w, ww), max(h, wh)
+	}
+
+	// Widgets anchored to siblings extend the widget at the start of their chain, which is anchored to the
+	// rectangle, so the rectangle must grow on the side that widget is anchored to.
+	rects := a.locate(widgets, siblings, image.Rect(0, 0, w+px, h+py))
+	chains := map[int]image.Rectangle{}
+	for idx := range widgets {
+		root := idx
+		for siblings[root] >= 0 {
+			root = siblings[root]
+		}
+		if root != idx {
+			chains[root] = chains[root].Union(rects[idx])
+		}
+	}
+
+	for root, chain := range chains {
+		r := rects[root]
+		ald, _ := widgets[root].GetWidget().LayoutData.(AnchorLayoutData)
+		ww, wh := a.requiredSize(widgets[root], maxWidth, maxHeight)
+		ww += chainExtent(ald.HorizontalPosition, r.Min.X-chain.Min.X, chain.Max.X-r.Max.X)
+		wh += chainExtent(ald.VerticalPosition, r.Min.Y-chain.Min.Y, chain.Max.Y-r.Max.Y)
+		w, h = max(w, ww), max(h, wh)
+	}
+
 	return w + px, h + py
 }
 
+// requiredSize returns the size of the rectangle that widget needs to get its preferred size, taking its
+// padding and percentage sizes into account.
+func (a *AnchorLayout) requiredSize(widget PreferredSizeLocateableWidget, maxWidth int, maxHeight int) (int, int) {
+	ald, _ := widget.GetWidget().LayoutData.(AnchorLayoutData)
+	padding := Insets{}
+	if ald.Padding != nil {
+		padding = *ald.Padding
+	}
+
+	maxWidth, maxHeight = padding.constrainedSize(maxWidth, maxHeight)
+	if ald.WidthPercent > 0 {
+		maxWidth = percentOf(maxWidth, ald.WidthPercent)
+	}
+	if ald.HeightPercent > 0 {
+		maxHeight = percentOf(maxHeight, ald.HeightPercent)
+	}
+
+	ww, wh := PreferredSizeFor(widget, maxWidth, maxHeight)
+	if ald.WidthPercent > 0 {
+		ww = int(math.Ceil(float64(ww) * 100 / ald.WidthPercent))
+	}
+	if ald.HeightPercent > 0 {
+		wh = int(math.Ceil(float64(wh) * 100 / ald.HeightPercent))
+	}
+
+	return ww + padding.Dx(), wh + padding.Dy()
+}
+
+// chainExtent returns how much a widget anchored at pos must grow so that the widgets anchored to it, which
+// extend before and after it, fit. Widgets before a widget anchored at the start never fit, neither do widgets
+// after a widget anchored at the end.
+func chainExtent(pos AnchorLayoutPosition, before int, after int) int {
+	before, after = max(before, 0), max(after, 0)
+	switch pos {
+	case AnchorLayoutPositionCenter:
+		return 2 * max(before, after)
+	case AnchorLayoutPositionEnd:
+		return before
+	case AnchorLayoutPositionStart:
+		// Do nothing
+	}
+	return after
+}
+
+// Err returns the error found during the last Layout, if any. Widgets that could not be anchored to their
+// sibling have been anchored to the rectangle instead. Layout also logs each error when it first finds it,
+// as layouts run while rendering.
+func (a *AnchorLayout) Err() error {
+	return a.err
+}
+
 // Layout implements Layouter.
 func (a *AnchorLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle) {
 	if len(widgets) == 0 {
+		a.setErr(nil)
 		return
 	}
-	for idx := range widgets {
-		widget := widgets[idx]
+
+	siblings, err := siblingIndexes(widgets)
+	a.setErr(err)
+
+	rects := a.locate(widgets, siblings, rect)
+	for idx, widget := range widgets {
 		if widget.GetWidget().GetVisibility() == Visibility_Hide {
 			continue
 		}
+		widget.SetLocation(rects[idx])
+	}
+}
+
+// setErr remembers err as the error of the current Layout, logging it unless the previous Layout found it too.
+func (a *AnchorLayout) setErr(err error) {
+	if err != nil && err != a.err {
+		log.Printf("ebitenui: %v", err)
+	}
+	a.err = err
+}
+
+// siblingIndexes returns the index of the sibling each widget is anchored to, or -1, together with the first
+// error found. Widgets anchored to a widget that is not a sibling, or anchored in a cycle, get -1.
+func siblingIndexes(widgets []PreferredSizeLocateableWidget) ([]int, error) {
+	indexes := make(map[*Widget]int, len(widgets))
+	for idx, w := range widgets {
+		indexes[w.GetWidget()] = idx
+	}
 
-		ww, wh := widget.PreferredSize()
-		wrect := a.padding.Apply(rect)
-		wx := 0
-		wy := 0
+	var err error
+	siblings := make([]int, len(widgets))
+	for idx, w := range widgets {
+		siblings[idx] = -1
+		ald, ok := w.GetWidget().LayoutData.(AnchorLayoutData)
+		if !ok || ald.Sibling == nil {
+			continue
+		}
+		if sidx, ok := indexes[ald.Sibling.GetWidget()]; ok && sidx != idx {
+			siblings[idx] = sidx
+		} else if err == nil {
+			err = ErrAnchorLayoutSibling
+		}
+	}
+
+	if cerr := breakCycles(siblings); err == nil {
+		err = cerr
+	}
+	return siblings, err
+}
 
-		if ald, ok := widget.GetWidget().LayoutData.(AnchorLayoutData); ok {
-			if ald.Padding != nil {
-				wrect = ald.Padding.Apply(wrect)
+// locate returns the locations of widgets within rect, locating the sibling each widget is anchored to first.
+func (a *AnchorLayout) locate(widgets []PreferredSizeLocateableWidget, siblings []int, rect image.Rectangle) []image.Rectangle {
+	located := make([]bool, len(widgets))
+	rects := make([]image.Rectangle, len(widgets))
+
+	var locate func(idx int) image.Rectangle
+	locate = func(idx int) image.Rectangle {
+		if located[idx] {
+			return rects[idx]
+		}
+
+		var sibling *image.Rectangle
+		if sidx := siblings[idx]; sidx >= 0 {
+			r := locate(sidx)
+			sibling = &r
+		}
+
+		rects[idx] = a.widgetRect(widgets[idx], rect, sibling)
+		located[idx] = true
+		return rects[idx]
+	}
+
+	for idx := range widgets {
+		locate(idx)
+	}
+	return rects
+}
+
+// breakCycles removes the siblings of all widgets that are anchored to each other in a cycle, so that they
+// are anchored to the rectangle instead. It returns ErrAnchorLayoutCycle if there was a cycle.
+func breakCycles(siblings []int) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make([]int, len(siblings))
+
+	var err error
+	for start := range siblings {
+		// Follow the siblings until reaching a widget that is done, or one on the current path.
+		var path []int
+		idx := start
+		for idx >= 0 && states[idx] == unvisited {
+			states[idx] = visiting
+			path = append(path, idx)
+			idx = siblings[idx]
+		}
+
+		if idx >= 0 && states[idx] == visiting {
+			// The widgets on the path from idx on are anchored to each other in a cycle.
+			err = ErrAnchorLayoutCycle
+			for _, cidx := range path[slices.Index(path, idx):] {
+				siblings[cidx] = -1
 			}
-			wx, wy, ww, wh = a.applyLayoutData(ald, wx, wy, ww, wh, wrect)
 		}
 
-		r := image.Rect(0, 0, ww, wh)
-		r = r.Add(image.Point{wx, wy})
-		r = r.Add(wrect.Min)
+		for _, pidx := range path {
+			states[pidx] = visited
+		}
+	}
+	return err
+}
+
+// widgetRect returns the location of widget within rect, next to sibling if it is not nil.
+func (a *AnchorLayout) widgetRect(widget PreferredSizeLocateableWidget, rect image.Rectangle, sibling *image.Rectangle) image.Rectangle {
+	wrect := a.padding.Apply(rect)
+
+	ald, ok := widget.GetWidget().LayoutData.(AnchorLayoutData)
+	if ok && ald.Padding != nil {
+		wrect = ald.Padding.Apply(wrect)
+	}
+
+	maxWidth, maxHeight := wrect.Dx(), wrect.Dy()
+	if ald.WidthPercent > 0 {
+		maxWidth = percentOf(maxWidth, ald.WidthPercent)
+	}
+	if ald.HeightPercent > 0 {
+		maxHeight = percentOf(maxHeight, ald.HeightPercent)
+	}
 
-		widget.SetLocation(r)
+	wx, wy := 0, 0
+	ww, wh := PreferredSizeFor(widget, maxWidth, maxHeight)
+	if ok {
+		if sibling != nil {
+			s := sibling.Sub(wrect.Min)
+			sibling = &s
+		}
+		wx, wy, ww, wh = a.applyLayoutData(ald, ww, wh, wrect, sibling)
 	}
+
+	r := image.Rect(0, 0, ww, wh)
+	r = r.Add(image.Point{wx, wy})
+	r = r.Add(wrect.Min)
+	return r
 }
 
-func (a *AnchorLayout) applyLayoutData(ld AnchorLayoutData, wx int, wy int, ww int, wh int, rect image.Rectangle) (int, int, int, int) {
+func (a *AnchorLayout) applyLayoutData(ld AnchorLayoutData, ww int, wh int, rect image.Rectangle, sibling *image.Rectangle) (int, int, int, int) {
+	ww, wh = a.applySize(ld, ww, wh, rect)
 
-	if ld.StretchHorizontal {
-		ww = rect.Dx()
+	var wx, wy int
+	if sibling != nil {
+		wx, wy = a.applySibling(ld, ww, wh, *sibling)
+	} else {
+		wx = anchorPosition(ld.HorizontalPosition, 0, rect.Dx(), ww)
+		wy = anchorPosition(ld.VerticalPosition, 0, rect.Dy(), wh)
 	}
 
-	if ld.StretchVertical {
+	wx += ld.OffsetX + percentOf(rect.Dx(), ld.OffsetXPercent)
+	wy += ld.OffsetY + percentOf(rect.Dy(), ld.OffsetYPercent)
+
+	return wx, wy, ww, wh
+}
+
+// applySize returns the size of a widget of preferred size ww and wh, stretched, sized by percentage and kept
+// at the aspect ratio according to ld.
+func (a *AnchorLayout) applySize(ld AnchorLayoutData, ww int, wh int, rect image.Rectangle) (int, int) {
+	fixedWidth, fixedHeight := true, true
+	switch {
+	case ld.WidthPercent > 0:
+		ww = percentOf(rect.Dx(), ld.WidthPercent)
+	case ld.StretchHorizontal:
+		ww = rect.Dx()
+	default:
+		fixedWidth = false
+	}
+	switch {
+	case ld.HeightPercent > 0:
+		wh = percentOf(rect.Dy(), ld.HeightPercent)
+	case ld.StretchVertical:
 		wh = rect.Dy()
+	default:
+		fixedHeight = false
 	}
 
-	hPos := ld.HorizontalPosition
-	vPos := ld.VerticalPosition
+	if ld.AspectRatio <= 0 {
+		return ww, wh
+	}
 
-	switch hPos {
-	case AnchorLayoutPositionCenter:
-		wx = (rect.Dx() - ww) / 2
-	case AnchorLayoutPositionEnd:
-		wx = rect.Dx() - ww
-	case AnchorLayoutPositionStart:
+	byWidth := int(math.Round(float64(ww) / ld.AspectRatio))
+	byHeight := int(math.Round(float64(wh) * ld.AspectRatio))
+	switch {
+	case fixedHeight && !fixedWidth:
+		ww = byHeight
+	case fixedWidth && fixedHeight && byWidth > wh:
+		ww = byHeight
+	default:
+		wh = byWidth
+	}
+
+	return ww, wh
+}
+
+// applySibling returns the position of a widget of size ww and wh placed next to sibling according to ld.
+func (a *AnchorLayout) applySibling(ld AnchorLayoutData, ww int, wh int, sibling image.Rectangle) (int, int) {
+	switch ld.SiblingSide {
+	case AnchorLayoutSideRight:
+		return sibling.Max.X + ld.SiblingGap, anchorPosition(ld.VerticalPosition, sibling.Min.Y, sibling.Dy(), wh)
+	case AnchorLayoutSideAbove:
+		return anchorPosition(ld.HorizontalPosition, sibling.Min.X, sibling.Dx(), ww), sibling.Min.Y - ld.SiblingGap - wh
+	case AnchorLayoutSideBelow:
+		return anchorPosition(ld.HorizontalPosition, sibling.Min.X, sibling.Dx(), ww), sibling.Max.Y + ld.SiblingGap
+	case AnchorLayoutSideLeft:
 		// Do nothing
 	}
+	return sibling.Min.X - ld.SiblingGap - ww, anchorPosition(ld.VerticalPosition, sibling.Min.Y, sibling.Dy(), wh)
+}
 
-	switch vPos {
+// anchorPosition returns where a widget of the given size starts when anchored at pos within the space
+// starting at start.
+func anchorPosition(pos AnchorLayoutPosition, start int, space int, size int) int {
+	switch pos {
 	case AnchorLayoutPositionCenter:
-		wy = (rect.Dy() - wh) / 2
+		return start + (space-size)/2
 	case AnchorLayoutPositionEnd:
-		wy = rect.Dy() - wh
+		return start + space - size
 	case AnchorLayoutPositionStart:
 		// Do nothing
 	}
+	return start
+}
 
-	return wx, wy, ww, wh
+// percentOf returns percent percent of size.
+func percentOf(size int, percent float64) int {
+	return int(math.Round(float64(size) * percent / 100))
 }
@@ -1,8 +1,13 @@
 package widget
 
 import (
+	"bytes"
+	"errors"
 	"image"
+	"log"
+	"os"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/matryer/is"
@@ -28,6 +33,21 @@ func TestAnchorLayout_PreferredSize(t *testing.T) {
 	is.Equal(h, wi.preferredHeight+padding.Dy())
 }
 
+func TestAnchorLayout_PreferredSizeFor(t *testing.T) {
+	is := is.New(t)
+
+	l := NewAnchorLayout(AnchorLayoutOpts.Padding(NewInsetsSimple(10)))
+
+	widgets := []PreferredSizeLocateableWidget{newWrappingWidget(100, 10, nil)}
+
+	w, h := l.PreferredSizeFor(widgets, 70, 0)
+	is.Equal(w, 70)
+	is.Equal(h, 40)
+
+	l.Layout(widgets, image.Rect(0, 0, 70, 100))
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(10, 10, 60, 30))
+}
+
 func TestAnchorLayout_Layout(t *testing.T) {
 	ww, wh := 25, 35
 	wrect := image.Rect(0, 0, ww, wh)
@@ -103,6 +123,134 @@ func TestAnchorLayout_Layout(t *testing.T) {
 	}
 }
 
+func TestAnchorLayout_Percent(t *testing.T) {
+	is := is.New(t)
+
+	l := NewAnchorLayout()
+
+	w := newSimpleWidget(10, 10, AnchorLayoutData{
+		HorizontalPosition: AnchorLayoutPositionEnd,
+		WidthPercent:       30,
+		HeightPercent:      50,
+		OffsetXPercent:     -10,
+		OffsetY:            5,
+	})
+	l.Layout([]PreferredSizeLocateableWidget{w}, image.Rect(0, 0, 200, 100))
+
+	is.Equal(w.GetWidget().Rect, image.Rect(120, 5, 180, 55))
+}
+
+func TestAnchorLayout_AspectRatio(t *testing.T) {
+	tests := []struct {
+		ld       AnchorLayoutData
+		expected image.Rectangle
+	}{
+		{AnchorLayoutData{StretchHorizontal: true, AspectRatio: 4}, image.Rect(0, 0, 200, 50)},
+		{AnchorLayoutData{HeightPercent: 50, AspectRatio: 2}, image.Rect(0, 0, 100, 50)},
+		{AnchorLayoutData{StretchHorizontal: true, StretchVertical: true, AspectRatio: 4}, image.Rect(0, 0, 200, 50)},
+		{AnchorLayoutData{StretchHorizontal: true, StretchVertical: true, AspectRatio: 1}, image.Rect(0, 0, 100, 100)},
+	}
+
+	for i, test := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			is := is.New(t)
+
+			l := NewAnchorLayout()
+
+			w := newSimpleWidget(10, 10, test.ld)
+			l.Layout([]PreferredSizeLocateableWidget{w}, image.Rect(0, 0, 200, 100))
+
+			is.Equal(w.GetWidget().Rect, test.expected)
+		})
+	}
+}
+
+func TestAnchorLayout_Sibling(t *testing.T) {
+	is := is.New(t)
+
+	l := NewAnchorLayout()
+
+	center := newSimpleWidget(20, 20, AnchorLayoutData{
+		HorizontalPosition: AnchorLayoutPositionCenter,
+		VerticalPosition:   AnchorLayoutPositionCenter,
+	})
+	left := newSimpleWidget(10, 10, AnchorLayoutData{
+		VerticalPosition: AnchorLayoutPositionCenter,
+		Sibling:          center,
+		SiblingSide:      AnchorLayoutSideLeft,
+		SiblingGap:       8,
+	})
+	below := newSimpleWidget(10, 4, AnchorLayoutData{
+		Sibling:     left,
+		SiblingSide: AnchorLayoutSideBelow,
+		SiblingGap:  2,
+	})
+
+	// Widgets are located after the siblings they are anchored to, regardless of their order.
+	l.Layout([]PreferredSizeLocateableWidget{below, left, center}, image.Rect(0, 0, 200, 100))
+
+	is.NoErr(l.Err())
+	is.Equal(center.GetWidget().Rect, image.Rect(90, 40, 110, 60))
+	is.Equal(left.GetWidget().Rect, image.Rect(72, 45, 82, 55))
+	is.Equal(below.GetWidget().Rect, image.Rect(72, 57, 82, 61))
+
+	// The preferred size fits the siblings on both sides of the centered widget.
+	w, h := l.PreferredSize([]PreferredSizeLocateableWidget{below, left, center})
+	is.Equal(w, 56)
+	is.Equal(h, 22)
+
+	l.Layout([]PreferredSizeLocateableWidget{below, left, center}, image.Rect(0, 0, w, h))
+	is.Equal(left.GetWidget().Rect, image.Rect(0, 6, 10, 16))
+	is.Equal(below.GetWidget().Rect, image.Rect(0, 18, 10, 22))
+}
+
+func TestAnchorLayout_PreferredSize_Percent(t *testing.T) {
+	is := is.New(t)
+
+	l := NewAnchorLayout(AnchorLayoutOpts.Padding(&Insets{Left: 1, Right: 1}))
+	small := newSimpleWidget(10, 10, nil)
+	percent := newSimpleWidget(20, 10, AnchorLayoutData{WidthPercent: 50, HeightPercent: 25})
+
+	w, h := l.PreferredSize([]PreferredSizeLocateableWidget{small, percent})
+	is.Equal(w, 42)
+	is.Equal(h, 40)
+}
+
+func TestAnchorLayout_SiblingErrors(t *testing.T) {
+	is := is.New(t)
+
+	l := NewAnchorLayout()
+
+	a := newSimpleWidget(10, 10, nil)
+	b := newSimpleWidget(10, 10, AnchorLayoutData{Sibling: a, SiblingSide: AnchorLayoutSideRight})
+	a.GetWidget().LayoutData = AnchorLayoutData{Sibling: b, SiblingSide: AnchorLayoutSideBelow}
+
+	c := newSimpleWidget(10, 10, AnchorLayoutData{Sibling: b, SiblingSide: AnchorLayoutSideBelow})
+
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	l.Layout([]PreferredSizeLocateableWidget{a, b, c}, image.Rect(0, 0, 200, 100))
+	is.True(errors.Is(l.Err(), ErrAnchorLayoutCycle))
+
+	// The error is logged once, not on every Layout.
+	l.Layout([]PreferredSizeLocateableWidget{a, b, c}, image.Rect(0, 0, 200, 100))
+	is.Equal(strings.Count(logged.String(), ErrAnchorLayoutCycle.Error()), 1)
+
+	// All widgets in the cycle are anchored to the rectangle instead, widgets anchored to them are not affected.
+	is.Equal(a.GetWidget().Rect, image.Rect(0, 0, 10, 10))
+	is.Equal(b.GetWidget().Rect, image.Rect(0, 0, 10, 10))
+	is.Equal(c.GetWidget().Rect, image.Rect(0, 10, 10, 20))
+
+	l.Layout([]PreferredSizeLocateableWidget{a}, image.Rect(0, 0, 200, 100))
+	is.True(errors.Is(l.Err(), ErrAnchorLayoutSibling))
+
+	a.GetWidget().LayoutData = AnchorLayoutData{}
+	l.Layout([]PreferredSizeLocateableWidget{a, b}, image.Rect(0, 0, 200, 100))
+	is.NoErr(l.Err())
+}
+
 func newAnchorLayout(t *testing.T, opts ...AnchorLayoutOpt) Layouter {
 	t.Helper()
 	l := NewAnchorLayout(opts...)
@@ -50,6 +50,7 @@ type Button struct {
 	graphic           *Graphic
 	text              *Text
 	textLabel         string
+	content           PreferredSizeLocateableWidget
 	textProcessBBCode bool
 	hovering          bool
 	pressing          bool
@@ -422,6 +423,14 @@ func (o ButtonOptions) Graphic(image *GraphicImage) ButtonOpt {
 	}
 }
 
+// Content shows w inside the button, on top of its image and within its text padding.
+// Unless w has layout data of its own, it is stretched to fill the button.
+func (o ButtonOptions) Content(w PreferredSizeLocateableWidget) ButtonOpt {
+	return func(b *Button) {
+		b.content = w
+	}
+}
+
 func (o ButtonOptions) GraphicPadding(i Insets) ButtonOpt {
 	return func(b *Button) {
 		b.definedParams.GraphicPadding = &i
@@ -834,8 +843,18 @@ func (b *Button) Release() {
 	}
 }
 
+// Activate presses and releases the button, the same way Enter or Space does while it is focused.
+func (b *Button) Activate() {
+	b.init.Do()
+	if b.widget.Disabled {
+		return
+	}
+	b.Press()
+	b.Release()
+}
+
 func (b *Button) handleSubmit() {
-	if input.KeyPressed(ebiten.KeyEnter) || input.KeyPressed(ebiten.KeySpace) {
+	if b.widget.Keymap().KeyPressed(KeyActionActivate) {
 		if !b.justSubmitted && b.focused {
 			b.justSubmitted = true
 			b.Press()
@@ -957,6 +976,15 @@ func (b *Button) initWidget() {
 	} else if b.text != nil {
 		b.container.AddChild(b.text)
 	}
+	if b.content != nil {
+		if b.content.GetWidget().LayoutData == nil {
+			b.content.GetWidget().LayoutData = AnchorLayoutData{
+				StretchHorizontal: true,
+				StretchVertical:   true,
+			}
+		}
+		b.container.AddChild(b.content)
+	}
 	b.container.Validate()
 }
 
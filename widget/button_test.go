@@ -1,6 +1,7 @@
 package widget
 
 import (
+	"image"
 	"testing"
 
 	"github.com/ebitenui/ebitenui/event"
@@ -69,6 +70,53 @@ func TestButton_ManualClick(t *testing.T) {
 	is.True(eventArgs != nil)
 }
 
+func TestButton_Activate(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *ButtonClickedEventArgs
+
+	b := newButton(t,
+		ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
+			eventArgs = args
+		}))
+
+	b.Activate()
+	event.ExecuteDeferred()
+
+	is.True(eventArgs != nil)
+}
+
+func TestButton_Activate_Disabled(t *testing.T) {
+	is := is.New(t)
+
+	b := newButton(t,
+		ButtonOpts.ClickedHandler(func(_ *ButtonClickedEventArgs) {
+			is.Fail() // received event even though widget is disabled
+		}))
+	b.GetWidget().Disabled = true
+
+	b.Activate()
+	event.ExecuteDeferred()
+}
+
+func TestButton_Content(t *testing.T) {
+	is := is.New(t)
+
+	content := NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.MinSize(80, 30)))
+	b := newButton(t,
+		ButtonOpts.Content(content),
+		ButtonOpts.TextPadding(&Insets{Left: 5, Right: 5, Top: 2, Bottom: 2}),
+	)
+
+	w, h := b.PreferredSize()
+	is.Equal(w, 90)
+	is.Equal(h, 34)
+
+	b.SetLocation(image.Rect(0, 0, 100, 60))
+	render(b, t)
+	is.Equal(content.GetWidget().Rect, image.Rect(5, 2, 95, 58))
+}
+
 func newButton(t *testing.T, opts ...ButtonOpt) *Button {
 	t.Helper()
 
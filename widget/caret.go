@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/utilities/clock"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -115,7 +116,7 @@ func (c *Caret) resetBlinking() {
 	c.state = c.blinkState(true, nil, nil)
 }
 
-func (c *Caret) blinkState(visible bool, timer *time.Timer, expired *atomic.Value) caretBlinkState {
+func (c *Caret) blinkState(visible bool, timer clock.Timer, expired *atomic.Value) caretBlinkState {
 	return func() caretBlinkState {
 		c.visible = visible
 
@@ -129,7 +130,7 @@ func (c *Caret) blinkState(visible bool, timer *time.Timer, expired *atomic.Valu
 			expired = &atomic.Value{}
 			expired.Store(false)
 
-			timer = time.AfterFunc(c.blinkInterval, func() {
+			timer = clock.AfterFunc(c.blinkInterval, func() {
 				expired.Store(true)
 			})
 		}
@@ -7,7 +7,6 @@ import (
 	"github.com/ebitenui/ebitenui/image"
 	"github.com/ebitenui/ebitenui/input"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 )
 
@@ -450,7 +449,7 @@ func (c *Checkbox) Update(updObj *UpdateObject) {
 
 func (c *Checkbox) handleDefaultInput() {
 	if !c.DisableDefaultKeys && c.focused &&
-		(inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace)) {
+		c.widget.Keymap().KeyJustPressed(KeyActionActivate) {
 		c.Click()
 	}
 }
@@ -488,6 +487,11 @@ func (c *Checkbox) Click() {
 	}
 }
 
+// Activate advances the checkbox to its next state, the same as Click.
+func (c *Checkbox) Activate() {
+	c.Click()
+}
+
 func (c *Checkbox) createWidget() {
 	c.widget = NewWidget(append([]WidgetOpt{
 		WidgetOpts.TrackHover(true),
@@ -0,0 +1,65 @@
+package widget
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/ebitenui/ebitenui/internal/jsUtil"
+)
+
+// Clipboard reads and writes the text used by cut, copy and paste in text widgets.
+//
+// Implementations backed by an asynchronous API may block until the text is available.
+type Clipboard interface {
+	ReadText() (string, error)
+	WriteText(text string) error
+}
+
+// DefaultClipboard is used by text widgets that have not been given a Clipboard of their own.
+//
+// It is a MemoryClipboard on desktop and mobile builds and uses the browser clipboard in JS builds.
+// Games that want to share text with other applications on desktop can replace it with an
+// implementation backed by the operating system clipboard.
+var DefaultClipboard Clipboard = newDefaultClipboard()
+
+// MemoryClipboard is a Clipboard that keeps its text in memory. It is only shared
+// within the application, which also makes it suitable for headless tests.
+type MemoryClipboard struct {
+	lock sync.Mutex
+	text string
+}
+
+// browserClipboard is a Clipboard backed by navigator.clipboard.
+type browserClipboard struct{}
+
+func NewMemoryClipboard() *MemoryClipboard {
+	return &MemoryClipboard{}
+}
+
+func newDefaultClipboard() Clipboard {
+	if runtime.GOOS == jsUtil.JS && runtime.GOARCH == jsUtil.WASM {
+		return browserClipboard{}
+	}
+	return NewMemoryClipboard()
+}
+
+func (c *MemoryClipboard) ReadText() (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.text, nil
+}
+
+func (c *MemoryClipboard) WriteText(text string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.text = text
+	return nil
+}
+
+func (c browserClipboard) ReadText() (string, error) {
+	return jsUtil.ReadClipboard()
+}
+
+func (c browserClipboard) WriteText(text string) error {
+	return jsUtil.WriteClipboard(text)
+}
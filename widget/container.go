@@ -113,6 +113,11 @@ func (c *Container) addChildInit(child PreferredSizeLocateableWidget) {
 			c.GetWidget().FireDragAndDropEvent(a.Window, a.Show, a.DnD)
 		}
 	})
+	child.GetWidget().PopupEvent.AddHandler(func(args interface{}) {
+		if a, ok := args.(*WidgetPopupEventArgs); ok {
+			c.GetWidget().FirePopupEvent(a.Window, a.Show)
+		}
+	})
 }
 
 func (c *Container) AddChild(children ...PreferredSizeLocateableWidget) RemoveChildFunc {
@@ -149,6 +154,15 @@ func (c *Container) ReplaceChild(remove PreferredSizeLocateableWidget, add Prefe
 
 func closeWidget(w *Widget) {
 	w.parent = nil
+
+	if w.self == nil {
+		releaseTransformImage(w)
+		return
+	}
+	Walk(w.self, func(c HasWidget) bool {
+		releaseTransformImage(c.GetWidget())
+		return true
+	})
 }
 
 func (c *Container) RemoveChild(child PreferredSizeLocateableWidget) {
@@ -188,6 +202,12 @@ func (c *Container) Children() []PreferredSizeLocateableWidget {
 	return c.children
 }
 
+// GetLayout returns the layout of c's children.
+func (c *Container) GetLayout() Layouter {
+	c.init.Do()
+	return c.layout
+}
+
 func (c *Container) RequestRelayout() {
 	c.init.Do()
 
@@ -206,6 +226,12 @@ func (c *Container) GetWidget() *Widget {
 }
 
 func (c *Container) PreferredSize() (int, int) {
+	return c.PreferredSizeFor(0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedPreferredSizer. If the layout of c implements ConstrainedLayouter,
+// it measures the children within width and height.
+func (c *Container) PreferredSizeFor(width int, height int) (int, int) {
 	c.init.Do()
 	w, h := 0, 0
 
@@ -220,7 +246,7 @@ func (c *Container) PreferredSize() (int, int) {
 	// If the preferred layout for the children is greater than the background image
 	// min size then use that
 	if c.layout != nil {
-		pW, pH := c.layout.PreferredSize(c.children)
+		pW, pH := preferredLayoutSize(c.layout, c.children, width, height)
 		if pW > w {
 			w = pW
 		}
@@ -290,7 +316,7 @@ func (c *Container) Render(screen *ebiten.Image) {
 			if !ch.GetWidget().IsVisible() {
 				continue
 			}
-			cr.Render(screen)
+			renderWithTransform(screen, ch.GetWidget(), cr)
 		}
 	}
 }
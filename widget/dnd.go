@@ -236,7 +236,7 @@ func (d *DragAndDrop) draggingState(srcX int, srcY int, dragWidget *Container, d
 			droppable := false
 			var element HasWidget
 
-			if !input.KeyPressed(ebiten.KeyEscape) && !d.dndStopped {
+			if !parent.GetWidget().Keymap().KeyPressed(KeyActionCancel) && !d.dndStopped {
 				args := &DragAndDropDroppedEventArgs{
 					Source:  parent,
 					SourceX: srcX,
@@ -265,7 +265,7 @@ func (d *DragAndDrop) draggingState(srcX int, srcY int, dragWidget *Container, d
 			u.Update(droppable, element, dragData)
 		}
 
-		if input.KeyPressed(ebiten.KeyEscape) || d.dndStopped {
+		if parent.GetWidget().Keymap().KeyPressed(KeyActionCancel) || d.dndStopped {
 			if dce, ok := d.contentsCreater.(DragContentsEnder); ok {
 				e := &event.Event{}
 				event.AddEventHandlerOneShot(e, func(_ interface{}) {
@@ -0,0 +1,407 @@
+package widget
+
+import (
+	"image"
+	"math"
+)
+
+// FlexLayout layouts widgets along a primary direction like a CSS flexbox: each widget starts out at its
+// basis size, and the space that is left over or missing in a line is distributed among the widgets in
+// proportion to their grow or shrink factors. Optionally, widgets wrap onto new lines when the space in
+// the primary direction runs out.
+//
+// Widget.LayoutData of widgets being layouted by FlexLayout may be of type FlexLayoutData.
+type FlexLayout struct {
+	direction Direction
+	padding   *Insets
+	mainGap   int
+	crossGap  int
+	justify   FlexJustify
+	align     FlexAlign
+	wrap      bool
+}
+
+type FlexLayoutOptions struct {
+}
+
+// FlexLayoutOpt is a function that configures f.
+type FlexLayoutOpt func(f *FlexLayout)
+
+// FlexLayoutData specifies layout settings for a widget. All sizes are in the primary direction of the layout.
+type FlexLayoutData struct {
+	// Grow specifies how much of the space left over in a line the widget takes, relative to the other
+	// widgets in the line. A widget with a Grow of 0 does not grow.
+	Grow float64
+
+	// Shrink specifies how much the widget shrinks when the widgets do not fit into a line, relative to the
+	// other widgets in the line and weighted by their basis. A widget with a Shrink of 0 does not shrink.
+	Shrink float64
+
+	// Basis specifies the size of the widget before growing or shrinking. If it is 0, the preferred size
+	// of the widget is used.
+	Basis int
+
+	// MinSize specifies the minimum size the widget shrinks to.
+	MinSize int
+
+	// MaxSize specifies the maximum size the widget grows to. If it is 0, the size is not limited.
+	MaxSize int
+}
+
+// FlexJustify is the type used to specify how the widgets of a line are distributed in the primary direction.
+type FlexJustify int
+
+const (
+	// FlexJustifyStart places the widgets at the start of the line.
+	FlexJustifyStart = FlexJustify(iota)
+
+	// FlexJustifyCenter places the widgets in the center of the line.
+	FlexJustifyCenter
+
+	// FlexJustifyEnd places the widgets at the end of the line.
+	FlexJustifyEnd
+
+	// FlexJustifySpaceBetween puts the first widget at the start, the last widget at the end, and spreads
+	// the left over space evenly between the widgets.
+	FlexJustifySpaceBetween
+
+	// FlexJustifySpaceAround gives each widget the same space on both of its sides, so that the space between
+	// two widgets is twice as large as the space at the start and end of the line.
+	FlexJustifySpaceAround
+
+	// FlexJustifySpaceEvenly spreads the left over space evenly between the widgets and the start and end of the line.
+	FlexJustifySpaceEvenly
+)
+
+// FlexAlign is the type used to specify how the widgets of a line are aligned in the direction that is not the
+// primary direction of the layout.
+type FlexAlign int
+
+const (
+	// FlexAlignStart aligns the widgets to the "top" (in the horizontal direction) or "left" (in the vertical direction.)
+	FlexAlignStart = FlexAlign(iota)
+
+	// FlexAlignCenter centers the widgets in the line.
+	FlexAlignCenter
+
+	// FlexAlignEnd aligns the widgets to the "bottom" (in the horizontal direction) or "right" (in the vertical direction.)
+	FlexAlignEnd
+
+	// FlexAlignStretch stretches the widgets over the whole line.
+	FlexAlignStretch
+)
+
+// FlexLayoutOpts contains functions that configure a FlexLayout.
+var FlexLayoutOpts FlexLayoutOptions
+
+// flexItem is a widget in a line, with its sizes in the primary direction (main) and the other one (cross).
+type flexItem struct {
+	widget PreferredSizeLocateableWidget
+	data   FlexLayoutData
+	basis  int
+	main   int
+	cross  int
+}
+
+// flexLine is a line of widgets.
+type flexLine struct {
+	items []flexItem
+	main  int
+	cross int
+}
+
+// NewFlexLayout constructs a new FlexLayout, configured by opts.
+func NewFlexLayout(opts ...FlexLayoutOpt) *FlexLayout {
+	f := &FlexLayout{}
+
+	for _, o := range opts {
+		o(f)
+	}
+
+	if f.padding == nil {
+		f.padding = &Insets{}
+	}
+
+	return f
+}
+
+// Direction configures a flex layout to layout widgets in the primary direction d.
+func (o FlexLayoutOptions) Direction(d Direction) FlexLayoutOpt {
+	return func(f *FlexLayout) {
+		f.direction = d
+	}
+}
+
+// Padding configures a flex layout to use padding i.
+func (o FlexLayoutOptions) Padding(i *Insets) FlexLayoutOpt {
+	return func(f *FlexLayout) {
+		f.padding = i
+	}
+}
+
+// Gap configures a flex layout to separate the widgets of a line by main pixels, and the lines by cross pixels.
+func (o FlexLayoutOptions) Gap(main int, cross int) FlexLayoutOpt {
+	return func(f *FlexLayout) {
+		f.mainGap = main
+		f.crossGap = cross
+	}
+}
+
+// Justify configures a flex layout to distribute the widgets of each line in the primary direction according to j.
+func (o FlexLayoutOptions) Justify(j FlexJustify) FlexLayoutOpt {
+	return func(f *FlexLayout) {
+		f.justify = j
+	}
+}
+
+// AlignItems configures a flex layout to align the widgets of each line in the other direction according to a.
+func (o FlexLayoutOptions) AlignItems(a FlexAlign) FlexLayoutOpt {
+	return func(f *FlexLayout) {
+		f.align = a
+	}
+}
+
+// Wrap configures a flex layout to wrap widgets onto a new line when the space in the primary direction runs out.
+func (o FlexLayoutOptions) Wrap(w bool) FlexLayoutOpt {
+	return func(f *FlexLayout) {
+		f.wrap = w
+	}
+}
+
+// GetPadding implements PaddedLayouter.
+func (f *FlexLayout) GetPadding() *Insets {
+	return f.padding
+}
+
+// PreferredSize implements Layouter.
+func (f *FlexLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int, int) {
+	return f.PreferredSizeFor(widgets, 0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedLayouter. If the space in the primary direction is limited, widgets
+// shrink or wrap to fit, but do not grow.
+func (f *FlexLayout) PreferredSizeFor(widgets []PreferredSizeLocateableWidget, width int, height int) (int, int) {
+	mainSpace, crossSpace := f.split(f.padding.constrainedSize(width, height))
+
+	main, cross := 0, 0
+	for i, l := range f.lines(widgets, mainSpace, crossSpace, false) {
+		main = max(main, l.main)
+		cross += l.cross
+		if i > 0 {
+			cross += f.crossGap
+		}
+	}
+
+	w, h := f.split(main, cross)
+	return w + f.padding.Dx(), h + f.padding.Dy()
+}
+
+// Layout implements Layouter.
+func (f *FlexLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle) {
+	rect = f.padding.Apply(rect)
+	mainSpace, crossSpace := f.split(rect.Dx(), rect.Dy())
+
+	lines := f.lines(widgets, max(mainSpace, 1), max(crossSpace, 0), true)
+	if !f.wrap && len(lines) == 1 {
+		// A single line takes up all the space.
+		lines[0].cross = crossSpace
+	}
+
+	cross := 0
+	for _, l := range lines {
+		start, spacing := f.lineStart(l, mainSpace)
+
+		main := start
+		for _, it := range l.items {
+			size := it.cross
+			offset := 0
+			switch f.align {
+			case FlexAlignCenter:
+				offset = (l.cross - size) / 2
+			case FlexAlignEnd:
+				offset = l.cross - size
+			case FlexAlignStretch:
+				size = l.cross
+			case FlexAlignStart:
+				// Do Nothing
+			}
+
+			x, y := f.split(int(math.Round(main)), cross+offset)
+			w, h := f.split(it.main, size)
+			it.widget.SetLocation(image.Rect(x, y, x+w, y+h).Add(rect.Min))
+
+			main += float64(it.main) + spacing
+		}
+
+		cross += l.cross + f.crossGap
+	}
+}
+
+// split returns the main and cross sizes for a width and height, or the width and height for main and cross sizes.
+func (f *FlexLayout) split(a int, b int) (int, int) {
+	if f.direction == DirectionVertical {
+		return b, a
+	}
+	return a, b
+}
+
+// measure returns the preferred size of w in the primary and the other direction, when at most main and cross
+// pixels are available.
+func (f *FlexLayout) measure(w PreferredSizeLocateableWidget, main int, cross int) (int, int) {
+	width, height := f.split(main, cross)
+	return f.split(PreferredSizeFor(w, width, height))
+}
+
+// lines distributes the visible widgets onto lines no longer than mainSpace, if wrapping, and resolves their
+// sizes. Widgets only grow into the space left over in a line if grow is true.
+func (f *FlexLayout) lines(widgets []PreferredSizeLocateableWidget, mainSpace int, crossSpace int, grow bool) []flexLine {
+	var lines []flexLine
+	var l *flexLine
+
+	for _, w := range widgets {
+		if w.GetWidget().GetVisibility() == Visibility_Hide {
+			continue
+		}
+
+		ld, _ := w.GetWidget().LayoutData.(FlexLayoutData)
+		basis, cross := f.measure(w, 0, crossSpace)
+		if ld.Basis > 0 {
+			basis = ld.Basis
+		}
+		basis = ld.clamp(basis)
+
+		if l == nil || f.wrap && mainSpace > 0 && l.main+f.mainGap+basis > mainSpace {
+			lines = append(lines, flexLine{})
+			l = &lines[len(lines)-1]
+		} else {
+			l.main += f.mainGap
+		}
+
+		l.items = append(l.items, flexItem{widget: w, data: ld, basis: basis, main: basis, cross: cross})
+		l.main += basis
+	}
+
+	for i := range lines {
+		l := &lines[i]
+		if mainSpace > 0 {
+			f.resolve(l, mainSpace, grow)
+		}
+
+		// Widgets may get taller when they get narrower, so the other direction is measured again.
+		l.main = f.mainGap * (len(l.items) - 1)
+		l.cross = 0
+		for j := range l.items {
+			it := &l.items[j]
+			if it.main != it.basis {
+				_, it.cross = f.measure(it.widget, it.main, crossSpace)
+			}
+			l.main += it.main
+			l.cross = max(l.cross, it.cross)
+		}
+	}
+
+	return lines
+}
+
+// resolve grows or shrinks the widgets of line l to fit into space. Widgets that reach their minimum or
+// maximum size are frozen at it, and the rest of the space is distributed among the other widgets.
+func (f *FlexLayout) resolve(l *flexLine, space int, grow bool) {
+	free := space - l.main
+	if free == 0 || free > 0 && !grow {
+		return
+	}
+
+	frozen := make([]bool, len(l.items))
+	targets := make([]float64, len(l.items))
+
+	for {
+		remaining := float64(space - f.mainGap*(len(l.items)-1))
+		factors := 0.0
+		for i, it := range l.items {
+			if frozen[i] {
+				remaining -= float64(it.main)
+				continue
+			}
+			remaining -= float64(it.basis)
+			factors += f.factor(it, free > 0)
+		}
+		if factors == 0 {
+			break
+		}
+
+		clamped := false
+		for i, it := range l.items {
+			if frozen[i] {
+				continue
+			}
+			targets[i] = float64(it.basis) + remaining*f.factor(it, free > 0)/factors
+			if c := float64(it.data.clamp(int(math.Round(targets[i])))); c != math.Round(targets[i]) {
+				l.items[i].main = int(c)
+				frozen[i] = true
+				clamped = true
+			}
+		}
+		if clamped {
+			continue
+		}
+
+		// Round so that the rounding errors do not add up over the line.
+		sum, prev := 0.0, 0
+		for i := range l.items {
+			if frozen[i] {
+				continue
+			}
+			sum += targets[i]
+			n := int(math.Round(sum))
+			l.items[i].main = max(n-prev, 0)
+			prev = n
+		}
+		break
+	}
+}
+
+// factor returns how much item it grows, or shrinks if grow is false.
+func (f *FlexLayout) factor(it flexItem, grow bool) float64 {
+	if grow {
+		return it.data.Grow
+	}
+	return it.data.Shrink * float64(it.basis)
+}
+
+// lineStart returns where line l starts in the primary direction, and the spacing between its widgets.
+func (f *FlexLayout) lineStart(l flexLine, space int) (float64, float64) {
+	extra := float64(space - l.main)
+	gap := float64(f.mainGap)
+	n := float64(len(l.items))
+	if extra <= 0 {
+		return 0, gap
+	}
+
+	switch f.justify {
+	case FlexJustifyCenter:
+		return extra / 2, gap
+	case FlexJustifyEnd:
+		return extra, gap
+	case FlexJustifySpaceBetween:
+		if n > 1 {
+			return 0, gap + extra/(n-1)
+		}
+	case FlexJustifySpaceAround:
+		return extra / n / 2, gap + extra/n
+	case FlexJustifySpaceEvenly:
+		return extra / (n + 1), gap + extra/(n+1)
+	case FlexJustifyStart:
+		// Do Nothing
+	}
+
+	return 0, gap
+}
+
+// clamp returns size limited to the minimum and maximum size of d.
+func (d FlexLayoutData) clamp(size int) int {
+	size = max(size, d.MinSize, 0)
+	if d.MaxSize > 0 {
+		size = min(size, d.MaxSize)
+	}
+	return size
+}
@@ -0,0 +1,154 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestFlexLayout_Grow(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlexLayout()
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(20, 10, FlexLayoutData{Grow: 1}),
+		newSimpleWidget(20, 10, FlexLayoutData{Grow: 3}),
+	}
+	l.Layout(widgets, image.Rect(0, 0, 200, 50))
+
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(0, 0, 60, 10))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(60, 0, 200, 10))
+}
+
+func TestFlexLayout_Shrink(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlexLayout()
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(80, 10, FlexLayoutData{Shrink: 1}),
+		newSimpleWidget(80, 10, FlexLayoutData{Shrink: 3}),
+		newSimpleWidget(20, 10, nil),
+	}
+	l.Layout(widgets, image.Rect(0, 0, 120, 50))
+
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(0, 0, 65, 10))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(65, 0, 100, 10))
+	is.Equal(widgets[2].GetWidget().Rect, image.Rect(100, 0, 120, 10))
+}
+
+func TestFlexLayout_BasisMinMax(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlexLayout()
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(20, 10, FlexLayoutData{Grow: 1, MaxSize: 50}),
+		newSimpleWidget(20, 10, FlexLayoutData{Grow: 1}),
+		newSimpleWidget(20, 10, FlexLayoutData{Basis: 30}),
+	}
+
+	w, h := l.PreferredSize(widgets)
+	is.Equal(w, 70)
+	is.Equal(h, 10)
+
+	// The first widget stops growing at its maximum size, and the second one takes the rest.
+	l.Layout(widgets, image.Rect(0, 0, 200, 50))
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(0, 0, 50, 10))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(50, 0, 170, 10))
+	is.Equal(widgets[2].GetWidget().Rect, image.Rect(170, 0, 200, 10))
+
+	// When shrinking, widgets stop at their minimum size.
+	widgets = []PreferredSizeLocateableWidget{
+		newSimpleWidget(50, 10, FlexLayoutData{Shrink: 1, MinSize: 40}),
+		newSimpleWidget(50, 10, FlexLayoutData{Shrink: 1}),
+	}
+	l.Layout(widgets, image.Rect(0, 0, 70, 50))
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(0, 0, 40, 10))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(40, 0, 70, 10))
+}
+
+func TestFlexLayout_Justify(t *testing.T) {
+	tests := []struct {
+		justify FlexJustify
+		x       []int
+	}{
+		{FlexJustifyStart, []int{0, 20, 40}},
+		{FlexJustifyCenter, []int{20, 40, 60}},
+		{FlexJustifyEnd, []int{40, 60, 80}},
+		{FlexJustifySpaceBetween, []int{0, 40, 80}},
+		{FlexJustifySpaceAround, []int{7, 40, 73}},
+		{FlexJustifySpaceEvenly, []int{10, 40, 70}},
+	}
+
+	for _, tc := range tests {
+		is := is.New(t)
+
+		l := NewFlexLayout(FlexLayoutOpts.Justify(tc.justify))
+
+		widgets := []PreferredSizeLocateableWidget{
+			newSimpleWidget(20, 10, nil),
+			newSimpleWidget(20, 10, nil),
+			newSimpleWidget(20, 10, nil),
+		}
+		l.Layout(widgets, image.Rect(0, 0, 100, 50))
+
+		for i, x := range tc.x {
+			is.Equal(widgets[i].GetWidget().Rect.Min.X, x)
+		}
+	}
+}
+
+func TestFlexLayout_AlignItems(t *testing.T) {
+	tests := []struct {
+		align FlexAlign
+		rect  image.Rectangle
+	}{
+		{FlexAlignStart, image.Rect(0, 0, 20, 10)},
+		{FlexAlignCenter, image.Rect(0, 20, 20, 30)},
+		{FlexAlignEnd, image.Rect(0, 40, 20, 50)},
+		{FlexAlignStretch, image.Rect(0, 0, 20, 50)},
+	}
+
+	for _, tc := range tests {
+		is := is.New(t)
+
+		l := NewFlexLayout(FlexLayoutOpts.AlignItems(tc.align))
+
+		widgets := []PreferredSizeLocateableWidget{newSimpleWidget(20, 10, nil)}
+		l.Layout(widgets, image.Rect(0, 0, 100, 50))
+
+		is.Equal(widgets[0].GetWidget().Rect, tc.rect)
+	}
+}
+
+func TestFlexLayout_Wrap(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlexLayout(
+		FlexLayoutOpts.Direction(DirectionVertical),
+		FlexLayoutOpts.Padding(NewInsetsSimple(10)),
+		FlexLayoutOpts.Gap(10, 5),
+		FlexLayoutOpts.Wrap(true))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 40, nil),
+		newSimpleWidget(20, 40, nil),
+		newSimpleWidget(10, 40, nil),
+	}
+
+	w, h := l.PreferredSize(widgets)
+	is.Equal(w, 40)
+	is.Equal(h, 160)
+
+	w, h = l.PreferredSizeFor(widgets, 0, 120)
+	is.Equal(w, 55)
+	is.Equal(h, 110)
+
+	l.Layout(widgets, image.Rect(0, 0, 100, 120))
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(10, 10, 20, 50))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(10, 60, 30, 100))
+	is.Equal(widgets[2].GetWidget().Rect, image.Rect(35, 10, 45, 50))
+}
@@ -0,0 +1,234 @@
+package widget
+
+import (
+	"image"
+)
+
+// FlowLayout layouts widgets from left to right in rows, like words in a paragraph, and wraps them
+// onto a new row when the available width runs out. Unlike GridLayout, the number of widgets in a row
+// follows the width, so the widgets reflow when their container is resized.
+//
+// Widget.LayoutData of widgets being layouted by FlowLayout may be of type FlowLayoutData.
+type FlowLayout struct {
+	padding       *Insets
+	columnSpacing int
+	rowSpacing    int
+	alignment     FlowLayoutAlignment
+}
+
+type FlowLayoutOptions struct {
+}
+
+// FlowLayoutOpt is a function that configures f.
+type FlowLayoutOpt func(f *FlowLayout)
+
+// FlowLayoutData specifies layout settings for a widget.
+type FlowLayoutData struct {
+	// Position specifies the vertical anchoring position inside the row.
+	Position FlowLayoutPosition
+
+	// Stretch specifies whether to stretch the widget to the height of the row.
+	Stretch bool
+}
+
+// FlowLayoutPosition is the type used to specify an anchoring position.
+type FlowLayoutPosition int
+
+const (
+	// FlowLayoutPositionStart is the anchoring position for "top".
+	FlowLayoutPositionStart = FlowLayoutPosition(iota)
+
+	// FlowLayoutPositionCenter is the center anchoring position.
+	FlowLayoutPositionCenter
+
+	// FlowLayoutPositionEnd is the anchoring position for "bottom".
+	FlowLayoutPositionEnd
+)
+
+// FlowLayoutAlignment is the type used to specify how the widgets of a row are aligned horizontally.
+type FlowLayoutAlignment int
+
+const (
+	// FlowLayoutAlignmentStart aligns the widgets of each row to the left.
+	FlowLayoutAlignmentStart = FlowLayoutAlignment(iota)
+
+	// FlowLayoutAlignmentCenter centers the widgets of each row.
+	FlowLayoutAlignmentCenter
+
+	// FlowLayoutAlignmentEnd aligns the widgets of each row to the right.
+	FlowLayoutAlignmentEnd
+
+	// FlowLayoutAlignmentJustify spreads the widgets of each row over the whole width by enlarging the
+	// spacing between them. The last row is aligned to the left.
+	FlowLayoutAlignmentJustify
+)
+
+// FlowLayoutOpts contains functions that configure a FlowLayout.
+var FlowLayoutOpts FlowLayoutOptions
+
+// flowRow is a row of widgets that fit into the available width.
+type flowRow struct {
+	widgets []PreferredSizeLocateableWidget
+	sizes   []image.Point
+	width   int
+	height  int
+}
+
+// NewFlowLayout constructs a new FlowLayout, configured by opts.
+func NewFlowLayout(opts ...FlowLayoutOpt) *FlowLayout {
+	f := &FlowLayout{}
+
+	for _, o := range opts {
+		o(f)
+	}
+
+	if f.padding == nil {
+		f.padding = &Insets{}
+	}
+
+	return f
+}
+
+// Padding configures a flow layout to use padding i.
+func (o FlowLayoutOptions) Padding(i *Insets) FlowLayoutOpt {
+	return func(f *FlowLayout) {
+		f.padding = i
+	}
+}
+
+// Spacing configures a flow layout to separate the widgets of a row by spacing c and the rows by spacing r.
+func (o FlowLayoutOptions) Spacing(c int, r int) FlowLayoutOpt {
+	return func(f *FlowLayout) {
+		f.columnSpacing = c
+		f.rowSpacing = r
+	}
+}
+
+// Alignment configures a flow layout to align the widgets of each row according to a.
+func (o FlowLayoutOptions) Alignment(a FlowLayoutAlignment) FlowLayoutOpt {
+	return func(f *FlowLayout) {
+		f.alignment = a
+	}
+}
+
+// GetPadding implements PaddedLayouter.
+func (f *FlowLayout) GetPadding() *Insets {
+	return f.padding
+}
+
+// PreferredSize implements Layouter. The widgets are placed in a single row, use PreferredSizeFor to wrap them
+// at a width.
+func (f *FlowLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int, int) {
+	return f.PreferredSizeFor(widgets, 0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedLayouter. The widgets are wrapped at width, or placed in a single row
+// if width is 0 or less. The height does not constrain a flow layout and is ignored.
+func (f *FlowLayout) PreferredSizeFor(widgets []PreferredSizeLocateableWidget, width int, _ int) (int, int) {
+	maxWidth, _ := f.padding.constrainedSize(width, 0)
+
+	rows := f.rows(widgets, maxWidth)
+	w, h := 0, 0
+	for i, r := range rows {
+		w = max(w, r.width)
+		h += r.height
+		if i > 0 {
+			h += f.rowSpacing
+		}
+	}
+	return w + f.padding.Dx(), h + f.padding.Dy()
+}
+
+// Layout implements Layouter.
+func (f *FlowLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle) {
+	rect = f.padding.Apply(rect)
+	rows := f.rows(widgets, max(rect.Dx(), 1))
+
+	y := rect.Min.Y
+	for i, r := range rows {
+		x, spacing, remainder := f.rowStart(r, rect.Dx(), i == len(rows)-1)
+		x += rect.Min.X
+
+		for j, w := range r.widgets {
+			size := r.sizes[j]
+			wy := y
+			if ld, ok := w.GetWidget().LayoutData.(FlowLayoutData); ok {
+				switch {
+				case ld.Stretch:
+					size.Y = r.height
+				case ld.Position == FlowLayoutPositionCenter:
+					wy += (r.height - size.Y) / 2
+				case ld.Position == FlowLayoutPositionEnd:
+					wy += r.height - size.Y
+				}
+			}
+
+			w.SetLocation(image.Rect(x, wy, x+size.X, wy+size.Y))
+
+			x += size.X + spacing
+			if j < remainder {
+				x++
+			}
+		}
+
+		y += r.height + f.rowSpacing
+	}
+}
+
+// rows distributes the visible widgets onto rows that are at most maxWidth wide. If maxWidth is 0,
+// all widgets are placed in a single row.
+func (f *FlowLayout) rows(widgets []PreferredSizeLocateableWidget, maxWidth int) []flowRow {
+	var rows []flowRow
+	var r *flowRow
+
+	for _, w := range widgets {
+		if w.GetWidget().GetVisibility() == Visibility_Hide {
+			continue
+		}
+
+		ww, wh := PreferredSizeFor(w, maxWidth, 0)
+		if maxWidth > 0 && ww > maxWidth {
+			ww = maxWidth
+		}
+
+		if r == nil || maxWidth > 0 && r.width+f.columnSpacing+ww > maxWidth {
+			rows = append(rows, flowRow{})
+			r = &rows[len(rows)-1]
+		} else {
+			r.width += f.columnSpacing
+		}
+
+		r.widgets = append(r.widgets, w)
+		r.sizes = append(r.sizes, image.Pt(ww, wh))
+		r.width += ww
+		r.height = max(r.height, wh)
+	}
+
+	return rows
+}
+
+// rowStart returns where row r starts horizontally, the spacing between its widgets, and the number
+// of widgets that are followed by one more pixel of spacing to fill the width when justifying.
+func (f *FlowLayout) rowStart(r flowRow, width int, last bool) (int, int, int) {
+	extra := width - r.width
+	if extra <= 0 {
+		return 0, f.columnSpacing, 0
+	}
+
+	switch f.alignment {
+	case FlowLayoutAlignmentCenter:
+		return extra / 2, f.columnSpacing, 0
+	case FlowLayoutAlignmentEnd:
+		return extra, f.columnSpacing, 0
+	case FlowLayoutAlignmentJustify:
+		if last || len(r.widgets) < 2 {
+			return 0, f.columnSpacing, 0
+		}
+		gaps := len(r.widgets) - 1
+		return 0, f.columnSpacing + extra/gaps, extra % gaps
+	case FlowLayoutAlignmentStart:
+		// Do Nothing
+	}
+
+	return 0, f.columnSpacing, 0
+}
@@ -0,0 +1,102 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestFlowLayout_Layout(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlowLayout(
+		FlowLayoutOpts.Padding(NewInsetsSimple(10)),
+		FlowLayoutOpts.Spacing(5, 7))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(35, 20, nil),
+		newSimpleWidget(35, 30, FlowLayoutData{Position: FlowLayoutPositionCenter}),
+		newSimpleWidget(35, 10, FlowLayoutData{Position: FlowLayoutPositionEnd}),
+		newSimpleWidget(35, 20, FlowLayoutData{Stretch: true}),
+		newSimpleWidget(35, 40, nil),
+	}
+
+	// 100 pixels minus padding leave room for two widgets per row.
+	l.Layout(widgets, image.Rect(0, 0, 100, 200))
+
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(10, 10, 45, 30))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(50, 10, 85, 40))
+	is.Equal(widgets[2].GetWidget().Rect, image.Rect(10, 57, 45, 67))
+	is.Equal(widgets[3].GetWidget().Rect, image.Rect(50, 47, 85, 67))
+	is.Equal(widgets[4].GetWidget().Rect, image.Rect(10, 74, 45, 114))
+
+	// At the same width, the layout asks for the height it needs, and layouting does not change that.
+	w, h := l.PreferredSizeFor(widgets, 100, 0)
+	is.Equal(w, 95)
+	is.Equal(h, 124)
+
+	w, h = l.PreferredSize(widgets)
+	is.Equal(w, 215)
+	is.Equal(h, 60)
+}
+
+func TestFlowLayout_PreferredSizeFor(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlowLayout(FlowLayoutOpts.Spacing(10, 10))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(30, 20, nil),
+		newSimpleWidget(30, 20, nil),
+		newSimpleWidget(30, 20, nil),
+		newSimpleWidget(30, 20, nil),
+	}
+
+	w, h := l.PreferredSize(widgets)
+	is.Equal(w, 150)
+	is.Equal(h, 20)
+
+	w, h = l.PreferredSizeFor(widgets, 100, 0)
+	is.Equal(w, 70)
+	is.Equal(h, 50)
+
+	// Hidden widgets take no space, and wide widgets are narrowed to the width.
+	widgets[1].GetWidget().SetVisibility(Visibility_Hide)
+	widgets = append(widgets, newSimpleWidget(200, 20, nil))
+	w, h = l.PreferredSizeFor(widgets, 100, 0)
+	is.Equal(w, 100)
+	is.Equal(h, 80)
+}
+
+func TestFlowLayout_Alignment(t *testing.T) {
+	tests := []struct {
+		alignment FlowLayoutAlignment
+		first     []int
+		last      int
+	}{
+		{FlowLayoutAlignmentStart, []int{0, 30, 60}, 0},
+		{FlowLayoutAlignmentCenter, []int{5, 35, 65}, 35},
+		{FlowLayoutAlignmentEnd, []int{10, 40, 70}, 70},
+		{FlowLayoutAlignmentJustify, []int{0, 35, 70}, 0},
+	}
+
+	for _, tc := range tests {
+		is := is.New(t)
+
+		l := NewFlowLayout(FlowLayoutOpts.Alignment(tc.alignment))
+
+		widgets := []PreferredSizeLocateableWidget{
+			newSimpleWidget(30, 10, nil),
+			newSimpleWidget(30, 10, nil),
+			newSimpleWidget(30, 10, nil),
+			newSimpleWidget(30, 10, nil),
+		}
+		l.Layout(widgets, image.Rect(0, 0, 100, 100))
+
+		for i, x := range tc.first {
+			is.Equal(widgets[i].GetWidget().Rect.Min.X, x)
+		}
+		is.Equal(widgets[3].GetWidget().Rect.Min, image.Pt(tc.last, 10))
+	}
+}
@@ -0,0 +1,109 @@
+package widget
+
+import (
+	"image"
+	"math"
+)
+
+// NearestFocuser returns the candidate that is geometrically closest to rect in the given direction,
+// or nil if there is none. Candidates are compared by their widget's Rect; disabled, invisible and
+// not yet laid out candidates are skipped.
+//
+// For the north, east, south and west directions, candidates that overlap rect on the perpendicular
+// axis are preferred over candidates that only lie diagonally in that direction. The diagonal
+// directions pick the nearest candidate whose center lies in that quadrant.
+// FOCUS_NEXT and FOCUS_PREVIOUS are not spatial and always return nil.
+func NearestFocuser(rect image.Rectangle, candidates []Focuser, direction FocusDirection) Focuser {
+	var best Focuser
+	bestRank, bestScore, bestTie := math.MaxInt, math.MaxInt, math.MaxInt
+
+	for _, c := range candidates {
+		w := c.GetWidget()
+		if w.Disabled || !w.IsVisible() || w.Rect.Empty() {
+			continue
+		}
+
+		rank, score, tie, ok := focusNavigationScore(rect, w.Rect, direction)
+		if !ok {
+			continue
+		}
+		if rank < bestRank ||
+			(rank == bestRank && score < bestScore) ||
+			(rank == bestRank && score == bestScore && tie < bestTie) {
+			best, bestRank, bestScore, bestTie = c, rank, score, tie
+		}
+	}
+
+	return best
+}
+
+// focusNavigationScore rates how well to lies in the given direction from from.
+// Targets are ordered by rank first, then by score and finally by tie; lower is better.
+func focusNavigationScore(from image.Rectangle, to image.Rectangle, direction FocusDirection) (int, int, int, bool) {
+	switch direction {
+	case FOCUS_NORTH, FOCUS_EAST, FOCUS_SOUTH, FOCUS_WEST:
+		fMin, fMax, sMin, sMax := focusNavigationAxes(from, direction)
+		tMin, tMax, tsMin, tsMax := focusNavigationAxes(to, direction)
+
+		// The target has to lie further along the direction than the source.
+		if tMin+tMax <= fMin+fMax || tMax <= fMax {
+			return 0, 0, 0, false
+		}
+
+		gap := max(0, tMin-fMax)
+		side := max(0, max(tsMin-sMax, sMin-tsMax))
+		centerDistance := abs((tsMin + tsMax) - (sMin + sMax))
+		rank := 0
+		if side > 0 {
+			rank = 1
+		}
+		return rank, gap + 2*side, centerDistance, true
+
+	case FOCUS_NORTHEAST, FOCUS_SOUTHEAST, FOCUS_SOUTHWEST, FOCUS_NORTHWEST:
+		dx := (to.Min.X + to.Max.X) - (from.Min.X + from.Max.X)
+		dy := (to.Min.Y + to.Max.Y) - (from.Min.Y + from.Max.Y)
+
+		var ok bool
+		switch direction {
+		case FOCUS_NORTHEAST:
+			ok = dx > 0 && dy < 0
+		case FOCUS_SOUTHEAST:
+			ok = dx > 0 && dy > 0
+		case FOCUS_SOUTHWEST:
+			ok = dx < 0 && dy > 0
+		default:
+			ok = dx < 0 && dy < 0
+		}
+		if !ok {
+			return 0, 0, 0, false
+		}
+
+		// Prefer targets close to the diagonal.
+		return 0, dx*dx + dy*dy, abs(abs(dx) - abs(dy)), true
+
+	default:
+		return 0, 0, 0, false
+	}
+}
+
+// focusNavigationAxes returns the extents of r along the given cardinal direction and perpendicular to it,
+// oriented so that moving in the direction increases the first pair of values.
+func focusNavigationAxes(r image.Rectangle, direction FocusDirection) (int, int, int, int) {
+	switch direction {
+	case FOCUS_NORTH:
+		return -r.Max.Y, -r.Min.Y, r.Min.X, r.Max.X
+	case FOCUS_SOUTH:
+		return r.Min.Y, r.Max.Y, r.Min.X, r.Max.X
+	case FOCUS_WEST:
+		return -r.Max.X, -r.Min.X, r.Min.Y, r.Max.Y
+	default:
+		return r.Min.X, r.Max.X, r.Min.Y, r.Max.Y
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
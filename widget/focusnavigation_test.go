@@ -0,0 +1,60 @@
+package widget
+
+import (
+	img "image"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestNearestFocuser_Cardinal(t *testing.T) {
+	is := is.New(t)
+
+	// [a] [b]
+	// [c]     [d]
+	a := newFocusNavigationButton(t, img.Rect(0, 0, 50, 20))
+	b := newFocusNavigationButton(t, img.Rect(60, 0, 110, 20))
+	c := newFocusNavigationButton(t, img.Rect(0, 30, 50, 50))
+	d := newFocusNavigationButton(t, img.Rect(120, 30, 170, 50))
+	candidates := []Focuser{a, b, c, d}
+
+	is.Equal(NearestFocuser(a.GetWidget().Rect, candidates, FOCUS_EAST), b)
+	is.Equal(NearestFocuser(a.GetWidget().Rect, candidates, FOCUS_SOUTH), c)
+	is.Equal(NearestFocuser(c.GetWidget().Rect, candidates, FOCUS_EAST), d) // same row beats the closer b
+	is.Equal(NearestFocuser(d.GetWidget().Rect, candidates, FOCUS_NORTH), b)
+	is.Equal(NearestFocuser(a.GetWidget().Rect, candidates, FOCUS_NORTH), nil)
+	is.Equal(NearestFocuser(a.GetWidget().Rect, candidates, FOCUS_WEST), nil)
+}
+
+func TestNearestFocuser_Diagonal(t *testing.T) {
+	is := is.New(t)
+
+	a := newFocusNavigationButton(t, img.Rect(0, 0, 50, 20))
+	b := newFocusNavigationButton(t, img.Rect(60, 0, 110, 20))
+	c := newFocusNavigationButton(t, img.Rect(60, 30, 110, 50))
+	candidates := []Focuser{a, b, c}
+
+	is.Equal(NearestFocuser(a.GetWidget().Rect, candidates, FOCUS_SOUTHEAST), c)
+	is.Equal(NearestFocuser(c.GetWidget().Rect, candidates, FOCUS_NORTHWEST), a)
+	is.Equal(NearestFocuser(a.GetWidget().Rect, candidates, FOCUS_NORTHEAST), nil)
+}
+
+func TestNearestFocuser_SkipsDisabled(t *testing.T) {
+	is := is.New(t)
+
+	a := newFocusNavigationButton(t, img.Rect(0, 0, 50, 20))
+	b := newFocusNavigationButton(t, img.Rect(60, 0, 110, 20))
+	c := newFocusNavigationButton(t, img.Rect(120, 0, 170, 20))
+	b.GetWidget().Disabled = true
+
+	is.Equal(NearestFocuser(a.GetWidget().Rect, []Focuser{a, b, c}, FOCUS_EAST), c)
+	is.Equal(NearestFocuser(a.GetWidget().Rect, []Focuser{a, b, c}, FOCUS_NEXT), nil)
+}
+
+func newFocusNavigationButton(t *testing.T, rect img.Rectangle) *Button {
+	t.Helper()
+
+	b := newButton(t)
+	b.GetWidget().Rect = rect
+	return b
+}
@@ -0,0 +1,203 @@
+package widget
+
+import (
+	"github.com/ebitenui/ebitenui/input"
+)
+
+// WidgetTapEventArgs are the arguments of a TapEvent.
+type WidgetTapEventArgs struct { //nolint:golint
+	Widget  *Widget
+	OffsetX int
+	OffsetY int
+}
+
+// WidgetTouchLongPressEventArgs are the arguments of a TouchLongPressEvent.
+type WidgetTouchLongPressEventArgs struct { //nolint:golint
+	Widget  *Widget
+	OffsetX int
+	OffsetY int
+}
+
+// WidgetSwipeEventArgs are the arguments of a SwipeEvent. DeltaX and DeltaY are the movement from
+// the start of the swipe, VelocityX and VelocityY the velocity it was released with in pixels per second.
+type WidgetSwipeEventArgs struct { //nolint:golint
+	Widget    *Widget
+	DeltaX    float64
+	DeltaY    float64
+	VelocityX float64
+	VelocityY float64
+}
+
+// WidgetPanEventArgs are the arguments of a PanEvent. DeltaX and DeltaY are the movement since the last
+// event. With Phase input.GesturePhaseInertia, the fingers have been released and the movement decays.
+type WidgetPanEventArgs struct { //nolint:golint
+	Widget    *Widget
+	Phase     input.GesturePhase
+	DeltaX    float64
+	DeltaY    float64
+	VelocityX float64
+	VelocityY float64
+	Touches   int
+}
+
+// WidgetPinchEventArgs are the arguments of a PinchEvent. Scale is the change of the distance between
+// the fingers since the last event, the offsets are the center between the fingers.
+type WidgetPinchEventArgs struct { //nolint:golint
+	Widget  *Widget
+	Phase   input.GesturePhase
+	Scale   float64
+	OffsetX int
+	OffsetY int
+}
+
+type WidgetTapHandlerFunc func(args *WidgetTapEventArgs) //nolint:golint
+
+type WidgetTouchLongPressHandlerFunc func(args *WidgetTouchLongPressEventArgs) //nolint:golint
+
+type WidgetSwipeHandlerFunc func(args *WidgetSwipeEventArgs) //nolint:golint
+
+type WidgetPanHandlerFunc func(args *WidgetPanEventArgs) //nolint:golint
+
+type WidgetPinchHandlerFunc func(args *WidgetPinchEventArgs) //nolint:golint
+
+// TapHandler configures a Widget with tap event handler f.
+func (o WidgetOptions) TapHandler(f WidgetTapHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.TapEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*WidgetTapEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// TouchLongPressHandler configures a Widget with touch long press event handler f.
+func (o WidgetOptions) TouchLongPressHandler(f WidgetTouchLongPressHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.TouchLongPressEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*WidgetTouchLongPressEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// SwipeHandler configures a Widget with swipe event handler f.
+func (o WidgetOptions) SwipeHandler(f WidgetSwipeHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.SwipeEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*WidgetSwipeEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// PanHandler configures a Widget with pan event handler f.
+func (o WidgetOptions) PanHandler(f WidgetPanHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.PanEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*WidgetPanEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// PinchHandler configures a Widget with pinch event handler f.
+func (o WidgetOptions) PinchHandler(f WidgetPinchHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.PinchEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*WidgetPinchEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// fireGestureEvents fires the events of the gestures of the current frame that started within w.
+func (w *Widget) fireGestureEvents(layer *input.Layer) {
+	for _, g := range input.Gestures() {
+		if !w.In(g.StartX, g.StartY) || !layer.ActiveFor(g.StartX, g.StartY, input.LayerEventTypeAny) {
+			continue
+		}
+
+		offX, offY := g.X-w.Rect.Min.X, g.Y-w.Rect.Min.Y
+		switch g.Type {
+		case input.GestureTap:
+			w.TapEvent.Fire(&WidgetTapEventArgs{
+				Widget:  w,
+				OffsetX: offX,
+				OffsetY: offY,
+			})
+		case input.GestureLongPress:
+			w.TouchLongPressEvent.Fire(&WidgetTouchLongPressEventArgs{
+				Widget:  w,
+				OffsetX: offX,
+				OffsetY: offY,
+			})
+		case input.GestureSwipe:
+			w.SwipeEvent.Fire(&WidgetSwipeEventArgs{
+				Widget:    w,
+				DeltaX:    g.DeltaX,
+				DeltaY:    g.DeltaY,
+				VelocityX: g.VelocityX,
+				VelocityY: g.VelocityY,
+			})
+		case input.GesturePan:
+			if !w.ownsPan(g.StartX, g.StartY) {
+				continue
+			}
+			w.PanEvent.Fire(&WidgetPanEventArgs{
+				Widget:    w,
+				Phase:     g.Phase,
+				DeltaX:    g.DeltaX,
+				DeltaY:    g.DeltaY,
+				VelocityX: g.VelocityX,
+				VelocityY: g.VelocityY,
+				Touches:   g.Touches,
+			})
+		case input.GesturePinch:
+			w.PinchEvent.Fire(&WidgetPinchEventArgs{
+				Widget:  w,
+				Phase:   g.Phase,
+				Scale:   g.Scale,
+				OffsetX: offX,
+				OffsetY: offY,
+			})
+		}
+	}
+}
+
+// panHandler is implemented by widgets that handle pans without a PanEvent handler.
+type panHandler interface {
+	handlesPan() bool
+}
+
+// ownsPan returns whether w should receive a pan that started at x, y. A pan only goes to the innermost
+// widget below its start that handles pans, so that a Slider within a ScrollContainer, for example,
+// does not drag the ScrollContainer along.
+func (w *Widget) ownsPan(x int, y int) bool {
+	if w.self == nil {
+		return true
+	}
+	owns := true
+	Walk(w.self, func(c HasWidget) bool {
+		if c.GetWidget() != w && handlesPan(c, x, y) {
+			owns = false
+		}
+		return owns
+	})
+	return owns
+}
+
+func handlesPan(c HasWidget, x int, y int) bool {
+	w := c.GetWidget()
+	if w.Disabled || !w.IsVisible() || !w.In(x, y) {
+		return false
+	}
+	if p, ok := c.(panHandler); ok && p.handlesPan() {
+		return true
+	}
+	return w.PanEvent.HasHandlers()
+}
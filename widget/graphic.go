@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/utilities/clock"
 
 	img "image"
 
@@ -180,11 +181,11 @@ func (g *Graphic) Update(updObj *UpdateObject) {
 
 	if g.gif != nil {
 		if g.gifCurrentImageAt.IsZero() {
-			g.gifCurrentImageAt = time.Now()
+			g.gifCurrentImageAt = clock.Now()
 		}
 
-		if time.Since(g.gifCurrentImageAt) > time.Duration(g.gif.Delay[g.gifCurrentImage]*10)*time.Millisecond {
-			g.gifCurrentImageAt = time.Now()
+		if clock.Since(g.gifCurrentImageAt) > time.Duration(g.gif.Delay[g.gifCurrentImage]*10)*time.Millisecond {
+			g.gifCurrentImageAt = clock.Now()
 			g.gifCurrentImage += 1
 			if g.gifCurrentImage >= len(g.gifImages) {
 				g.gifCurrentImage = 0
@@ -122,9 +122,28 @@ func (o GridLayoutOptions) DefaultStretch(c bool, r bool) GridLayoutOpt {
 	}
 }
 
+// GetPadding implements PaddedLayouter.
+func (g *GridLayout) GetPadding() *Insets {
+	return g.padding
+}
+
 // PreferredSize implements Layouter.
 func (g *GridLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int, int) {
+	return g.PreferredSizeFor(widgets, 0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedLayouter. If the columns are wider than width, the stretched columns
+// are narrowed to fit, and the rows are measured within the resulting column widths.
+func (g *GridLayout) PreferredSizeFor(widgets []PreferredSizeLocateableWidget, width int, _ int) (int, int) {
 	colWidths, rowHeights := g.preferredColumnWidthsAndRowHeights(widgets)
+
+	maxWidth, _ := g.padding.constrainedSize(width, 0)
+	if maxWidth > 0 && g.columnSpacing*(len(colWidths)-1)+sumInts(colWidths) > maxWidth {
+		stretchedColWidth, firstStretchedColWidth := stretchedSize(colWidths, g.columnStretched, maxWidth, g.columnSpacing)
+		colWidths = cellSizes(colWidths, g.columnStretched, stretchedColWidth, firstStretchedColWidth)
+		rowHeights = g.rowHeightsFor(widgets, colWidths)
+	}
+
 	return g.padding.Dx() + g.columnSpacing*(len(colWidths)-1) + sumInts(colWidths),
 		g.padding.Dy() + g.rowSpacing*(len(rowHeights)-1) + sumInts(rowHeights)
 }
@@ -133,8 +152,11 @@ func (g *GridLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int
 func (g *GridLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle) {
 	rect = g.padding.Apply(rect)
 
-	colWidths, rowHeights := g.preferredColumnWidthsAndRowHeights(widgets)
-	stretchedColWidth, stretchedRowHeight, firstStretchedColWidth, firstStretchedRowHeight := g.stretchedCellSizes(colWidths, rowHeights, rect)
+	colWidths, _ := g.preferredColumnWidthsAndRowHeights(widgets)
+	stretchedColWidth, firstStretchedColWidth := stretchedSize(colWidths, g.columnStretched, rect.Dx(), g.columnSpacing)
+	// The rows are as tall as their widgets are at the widths of their columns.
+	rowHeights := g.rowHeightsFor(widgets, cellSizes(colWidths, g.columnStretched, stretchedColWidth, firstStretchedColWidth))
+	stretchedRowHeight, firstStretchedRowHeight := stretchedSize(rowHeights, g.rowStretched, rect.Dy(), g.rowSpacing)
 
 	c, r := 0, 0
 	x, y := 0, 0
@@ -183,40 +205,42 @@ func (g *GridLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.
 	}
 }
 
-func (g *GridLayout) stretchedCellSizes(colWidths []int, rowHeights []int, rect image.Rectangle) (int, int, int, int) {
-	stretchedColWidth, stretchedRowHeight := 0, 0
-
-	remainingWidth := rect.Dx() - g.columnSpacing*(len(colWidths)-1)
-	remainingHeight := rect.Dy() - g.rowSpacing*(len(rowHeights)-1)
-	stretchedCols, stretchedRows := 0, 0
-
-	for c, cw := range colWidths {
-		if g.columnStretched(c) {
-			stretchedCols++
+// stretchedSize returns the size of stretched columns or rows, and the size of the first one of them, which
+// also gets what is left after dividing space among them. sizes are the preferred sizes, separated by spacing.
+func stretchedSize(sizes []int, stretched func(i int) bool, space int, spacing int) (int, int) {
+	remaining := space - spacing*(len(sizes)-1)
+	count := 0
+	for i, s := range sizes {
+		if stretched(i) {
+			count++
 		} else {
-			remainingWidth -= cw
+			remaining -= s
 		}
 	}
 
-	for r, rh := range rowHeights {
-		if g.rowStretched(r) {
-			stretchedRows++
-		} else {
-			remainingHeight -= rh
-		}
+	size := 0
+	if count > 0 {
+		size = int(math.Floor(float64(remaining) / float64(count)))
 	}
+	return size, size + (remaining - size*count)
+}
 
-	if stretchedCols > 0 {
-		stretchedColWidth = int(math.Floor(float64(remainingWidth) / float64(stretchedCols)))
-	}
-	if stretchedRows > 0 {
-		stretchedRowHeight = int(math.Floor(float64(remainingHeight) / float64(stretchedRows)))
+// cellSizes returns sizes with the stretched columns or rows changed to size, and the first of them to firstSize.
+func cellSizes(sizes []int, stretched func(i int) bool, size int, firstSize int) []int {
+	result := make([]int, len(sizes))
+	first := true
+	for i, s := range sizes {
+		switch {
+		case !stretched(i):
+			result[i] = s
+		case first:
+			result[i] = firstSize
+			first = false
+		default:
+			result[i] = size
+		}
 	}
-
-	firstStretchedColWidth := stretchedColWidth + (remainingWidth - stretchedColWidth*stretchedCols)
-	firstStretchedRowHeight := stretchedRowHeight + (remainingHeight - stretchedRowHeight*stretchedRows)
-
-	return stretchedColWidth, stretchedRowHeight, firstStretchedColWidth, firstStretchedRowHeight
+	return result
 }
 
 func (g *GridLayout) columnStretched(c int) bool {
@@ -272,6 +296,39 @@ func (g *GridLayout) preferredColumnWidthsAndRowHeights(widgets []PreferredSizeL
 	return colWidths, rowHeights
 }
 
+// rowHeightsFor returns the preferred heights of the rows when the widgets are measured within colWidths.
+func (g *GridLayout) rowHeightsFor(widgets []PreferredSizeLocateableWidget, colWidths []int) []int {
+	rowHeights := make([]int, int(math.Ceil(float64(len(widgets))/float64(g.columns))))
+
+	c := 0
+	r := 0
+	for _, w := range widgets {
+		maxWidth, maxHeight := colWidths[c], 0
+		ld := w.GetWidget().LayoutData
+		gld, ok := ld.(GridLayoutData)
+		if ok {
+			maxWidth, maxHeight = constrainMax(maxWidth, gld.MaxWidth), gld.MaxHeight
+		}
+
+		ww, wh := PreferredSizeFor(w, maxWidth, maxHeight)
+		if ok {
+			_, wh = g.applyMaxSize(gld, ww, wh)
+		}
+
+		if wh > rowHeights[r] {
+			rowHeights[r] = wh
+		}
+
+		c++
+		if c >= g.columns {
+			c = 0
+			r++
+		}
+	}
+
+	return rowHeights
+}
+
 func (g *GridLayout) applyLayoutData(ld GridLayoutData, wx int, wy int, ww int, wh int, x int, y int, cw int, ch int) (int, int, int, int) {
 	if ld.MaxWidth > 0 && ww > ld.MaxWidth {
 		ww = ld.MaxWidth
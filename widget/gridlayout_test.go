@@ -0,0 +1,33 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestGridLayout_PreferredSizeFor(t *testing.T) {
+	is := is.New(t)
+
+	l := NewGridLayout(
+		GridLayoutOpts.Columns(2),
+		GridLayoutOpts.Stretch([]bool{false, true}, nil))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(30, 10, nil),
+		newWrappingWidget(100, 10, nil),
+	}
+
+	w, h := l.PreferredSizeFor(widgets, 0, 0)
+	is.Equal(w, 130)
+	is.Equal(h, 10)
+
+	// The stretched column is narrowed to fit, and its row gets taller.
+	w, h = l.PreferredSizeFor(widgets, 80, 0)
+	is.Equal(w, 80)
+	is.Equal(h, 20)
+
+	l.Layout(widgets, image.Rect(0, 0, 80, 100))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(30, 0, 80, 20))
+}
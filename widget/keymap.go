@@ -0,0 +1,152 @@
+package widget
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/ebitenui/ebitenui/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// KeyAction is something the built-in widgets and the UI do in response to a key or gamepad button.
+type KeyAction int
+
+const (
+	// KeyActionActivate presses the focused button, toggles the focused checkbox or activates a menu item.
+	KeyActionActivate KeyAction = iota
+	// KeyActionCancel closes menus, cancels drag and drop or closes the topmost window.
+	KeyActionCancel
+	// KeyActionUp, KeyActionDown, KeyActionLeft and KeyActionRight move within a widget,
+	// for example between list entries, or move focus with spatial navigation.
+	KeyActionUp
+	KeyActionDown
+	KeyActionLeft
+	KeyActionRight
+	// KeyActionNext and KeyActionPrevious move focus in tab order.
+	KeyActionNext
+	KeyActionPrevious
+)
+
+// KeyBinding are the keys and gamepad buttons that trigger a KeyAction.
+//
+// A key chord without modifiers matches regardless of the modifiers that are held, so that for
+// example Shift+Down still moves down in a List to extend the selection. A chord with modifiers
+// matches only if exactly those are held.
+type KeyBinding struct {
+	Keys           []input.KeyChord
+	GamepadButtons []ebiten.StandardGamepadButton
+}
+
+// Keymap maps key actions to the keys and gamepad buttons that trigger them. A Keymap is set on the UI
+// and can be overridden for a widget and its descendants with WidgetOpts.Keymap.
+//
+// Widgets react to the keys of a binding. The gamepad buttons are used by the UI's spatial navigation,
+// which moves focus and activates or cancels the focused widget.
+type Keymap struct {
+	bindings map[KeyAction]KeyBinding
+}
+
+// defaultKeymap is used by widgets that have no Keymap set.
+var defaultKeymap = DefaultKeymap()
+
+// DefaultKeymap returns a new Keymap with the default bindings: Enter and Space activate, Escape cancels,
+// the arrow keys navigate and Tab and Shift+Tab move focus. On a gamepad the A button activates,
+// the B button cancels and the D-pad navigates.
+func DefaultKeymap() *Keymap {
+	return NewKeymap(map[KeyAction]KeyBinding{
+		KeyActionActivate: {
+			Keys:           []input.KeyChord{{Key: ebiten.KeyEnter}, {Key: ebiten.KeySpace}},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonRightBottom},
+		},
+		KeyActionCancel: {
+			Keys:           []input.KeyChord{{Key: ebiten.KeyEscape}},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonRightRight},
+		},
+		KeyActionUp: {
+			Keys:           []input.KeyChord{{Key: ebiten.KeyUp}},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonLeftTop},
+		},
+		KeyActionDown: {
+			Keys:           []input.KeyChord{{Key: ebiten.KeyDown}},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonLeftBottom},
+		},
+		KeyActionLeft: {
+			Keys:           []input.KeyChord{{Key: ebiten.KeyLeft}},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonLeftLeft},
+		},
+		KeyActionRight: {
+			Keys:           []input.KeyChord{{Key: ebiten.KeyRight}},
+			GamepadButtons: []ebiten.StandardGamepadButton{ebiten.StandardGamepadButtonLeftRight},
+		},
+		KeyActionNext: {
+			Keys: []input.KeyChord{{Key: ebiten.KeyTab}},
+		},
+		KeyActionPrevious: {
+			Keys: []input.KeyChord{{Key: ebiten.KeyTab, Modifiers: input.ModifierShift}},
+		},
+	})
+}
+
+// NewKeymap returns a Keymap with the given bindings. Actions without a binding are never triggered.
+func NewKeymap(bindings map[KeyAction]KeyBinding) *Keymap {
+	return &Keymap{
+		bindings: maps.Clone(bindings),
+	}
+}
+
+// Bind replaces the binding of action.
+func (k *Keymap) Bind(action KeyAction, binding KeyBinding) {
+	if k.bindings == nil {
+		k.bindings = map[KeyAction]KeyBinding{}
+	}
+	k.bindings[action] = binding
+}
+
+// Binding returns the binding of action.
+func (k *Keymap) Binding(action KeyAction) KeyBinding {
+	return k.bindings[action]
+}
+
+// Clone returns a copy of k that can be changed without affecting k.
+func (k *Keymap) Clone() *Keymap {
+	c := &Keymap{
+		bindings: make(map[KeyAction]KeyBinding, len(k.bindings)),
+	}
+	for a, b := range k.bindings {
+		c.bindings[a] = KeyBinding{
+			Keys:           slices.Clone(b.Keys),
+			GamepadButtons: slices.Clone(b.GamepadButtons),
+		}
+	}
+	return c
+}
+
+// KeyPressed reports whether a key bound to action is held.
+func (k *Keymap) KeyPressed(action KeyAction) bool {
+	return k.matchKey(action, input.KeyPressed)
+}
+
+// KeyJustPressed reports whether a key bound to action has just been pressed.
+func (k *Keymap) KeyJustPressed(action KeyAction) bool {
+	return k.matchKey(action, input.KeyJustPressed)
+}
+
+// GamepadButtonPressed reports whether a gamepad button bound to action is held.
+func (k *Keymap) GamepadButtonPressed(action KeyAction) bool {
+	for _, b := range k.bindings[action].GamepadButtons {
+		if input.GamepadButtonPressed(b) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *Keymap) matchKey(action KeyAction, pressed func(ebiten.Key) bool) bool {
+	for _, c := range k.bindings[action].Keys {
+		if pressed(c.Key) && (c.Modifiers == 0 || input.ModifiersPressed() == c.Modifiers) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestKeymap_KeyPressed(t *testing.T) {
+	is := is.New(t)
+
+	source := input.NewFakeSource()
+	input.SetSource(source)
+	t.Cleanup(func() {
+		input.SetSource(nil)
+	})
+
+	k := DefaultKeymap()
+	source.PressKey(ebiten.KeyShift)
+	source.PressKey(ebiten.KeyTab)
+	input.Update()
+
+	// Chords without modifiers match regardless of the held modifiers.
+	is.True(k.KeyPressed(KeyActionNext))
+	is.True(k.KeyPressed(KeyActionPrevious))
+
+	source.ReleaseKey(ebiten.KeyShift)
+	input.Update()
+	is.True(k.KeyPressed(KeyActionNext))
+	is.True(!k.KeyPressed(KeyActionPrevious))
+
+	// Changing a clone does not change the original.
+	c := k.Clone()
+	c.Bind(KeyActionNext, KeyBinding{Keys: []input.KeyChord{{Key: ebiten.KeyN}}})
+	is.True(!c.KeyPressed(KeyActionNext))
+	is.True(k.KeyPressed(KeyActionNext))
+}
+
+func TestKeymap_Widget(t *testing.T) {
+	is := is.New(t)
+
+	source := input.NewFakeSource()
+	input.SetSource(source)
+	t.Cleanup(func() {
+		input.SetSource(nil)
+	})
+
+	keymap := NewKeymap(map[KeyAction]KeyBinding{
+		KeyActionActivate: {Keys: []input.KeyChord{{Key: ebiten.KeyE}}},
+	})
+	override := NewKeymap(map[KeyAction]KeyBinding{
+		KeyActionActivate: {Keys: []input.KeyChord{{Key: ebiten.KeyF}}},
+	})
+
+	clicks := map[*Button]int{}
+	var buttons []*Button
+	for _, opts := range [][]WidgetOpt{nil, {WidgetOpts.Keymap(override)}} {
+		var b *Button
+		b = newButton(t,
+			ButtonOpts.WidgetOpts(opts...),
+			ButtonOpts.ClickedHandler(func(_ *ButtonClickedEventArgs) {
+				clicks[b]++
+			}),
+		)
+		b.focused = true
+		buttons = append(buttons, b)
+	}
+	c := NewContainer()
+	c.AddChild(buttons[0])
+	c.AddChild(buttons[1])
+	c.GetWidget().SetKeymap(keymap)
+	event.ExecuteDeferred()
+
+	pressKey := func(k ebiten.Key) {
+		source.PressKey(k)
+		input.Update()
+		c.Update(&UpdateObject{})
+		source.ReleaseKey(k)
+		input.Update()
+		c.Update(&UpdateObject{})
+		event.ExecuteDeferred()
+	}
+
+	pressKey(ebiten.KeyEnter)
+	is.Equal(clicks[buttons[0]], 0)
+	is.Equal(clicks[buttons[1]], 0)
+
+	pressKey(ebiten.KeyE)
+	is.Equal(clicks[buttons[0]], 1)
+	is.Equal(clicks[buttons[1]], 0)
+
+	pressKey(ebiten.KeyF)
+	is.Equal(clicks[buttons[0]], 1)
+	is.Equal(clicks[buttons[1]], 1)
+}
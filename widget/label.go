@@ -181,6 +181,12 @@ func (l *Label) PreferredSize() (int, int) {
 	return l.text.PreferredSize()
 }
 
+// PreferredSizeFor implements ConstrainedPreferredSizer.
+func (l *Label) PreferredSizeFor(width int, height int) (int, int) {
+	l.init.Do()
+	return l.text.PreferredSizeFor(width, height)
+}
+
 func (l *Label) Render(screen *ebiten.Image) {
 	l.init.Do()
 
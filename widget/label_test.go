@@ -1,6 +1,7 @@
 package widget
 
 import (
+	"image"
 	"image/color"
 	"testing"
 
@@ -19,6 +20,31 @@ func TestLabel_SetLabel(t *testing.T) {
 	is.Equal(labelText(l).Label, "foo")
 }
 
+func TestLabel_PreferredSizeFor(t *testing.T) {
+	is := is.New(t)
+
+	l := newLabel(t, LabelOpts.TextOpts(TextOpts.MaxWidth(1000)))
+
+	l.Label = "foo bar baz qux"
+	render(l, t)
+
+	w, h := l.PreferredSize()
+
+	// Narrower than MaxWidth, the label wraps to more lines.
+	w2, h2 := l.PreferredSizeFor(w/2, 0)
+	is.True(w2 <= w/2)
+	is.True(h2 > h)
+
+	// Wider than the label, nothing changes.
+	w3, h3 := l.PreferredSizeFor(w*2, 0)
+	is.Equal(w3, w)
+	is.Equal(h3, h)
+
+	l.SetLocation(image.Rect(0, 0, w/2, h2))
+	render(l, t)
+	is.True(len(labelText(l).measurements.processedLines) > 1)
+}
+
 func newLabel(t *testing.T, opts ...LabelOpt) *Label {
 	t.Helper()
 
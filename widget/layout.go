@@ -9,6 +9,61 @@ type Layouter interface {
 	Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle)
 }
 
+// ConstrainedLayouter is implemented by layouts that can tell their preferred size when the space for them
+// is limited, for example how tall they are at a given width when widgets wrap their content.
+//
+// PreferredSizeFor returns the preferred size of widgets when at most width and height are available.
+// A width or height of 0 or less means that the space is not limited in that direction.
+type ConstrainedLayouter interface {
+	Layouter
+	PreferredSizeFor(widgets []PreferredSizeLocateableWidget, width int, height int) (int, int)
+}
+
+// ConstrainedPreferredSizer is implemented by widgets whose preferred size depends on the space available
+// to them, like wrapped Text, which gets taller as it gets narrower.
+//
+// PreferredSizeFor returns the preferred size of the widget when at most width and height are available.
+// A width or height of 0 or less means that the space is not limited in that direction.
+type ConstrainedPreferredSizer interface {
+	PreferredSizeFor(width int, height int) (int, int)
+}
+
+// PreferredSizeFor returns the preferred size of w when at most width and height are available, if w implements
+// ConstrainedPreferredSizer, or w's PreferredSize otherwise. Layouts use it to measure their widgets.
+func PreferredSizeFor(w PreferredSizer, width int, height int) (int, int) {
+	if c, ok := w.(ConstrainedPreferredSizer); ok {
+		return c.PreferredSizeFor(width, height)
+	}
+	return w.PreferredSize()
+}
+
+// preferredLayoutSize returns the preferred size of widgets in l when at most width and height are available,
+// if l implements ConstrainedLayouter, or l's PreferredSize otherwise.
+func preferredLayoutSize(l Layouter, widgets []PreferredSizeLocateableWidget, width int, height int) (int, int) {
+	if c, ok := l.(ConstrainedLayouter); ok {
+		return c.PreferredSizeFor(widgets, width, height)
+	}
+	return l.PreferredSize(widgets)
+}
+
+// constrainedSize returns the space left for content when at most width and height are available
+// around insets i, or 0 in a direction that is not limited.
+func (i Insets) constrainedSize(width int, height int) (int, int) {
+	if width > 0 {
+		width = max(width-i.Dx(), 1)
+	}
+	if height > 0 {
+		height = max(height-i.Dy(), 1)
+	}
+	return max(width, 0), max(height, 0)
+}
+
+// PaddedLayouter is implemented by layouts that keep padding around their widgets.
+type PaddedLayouter interface {
+	Layouter
+	GetPadding() *Insets
+}
+
 type Relayoutable interface {
 	RequestRelayout()
 }
@@ -57,3 +112,12 @@ func (i Insets) Dx() int {
 func (i Insets) Dy() int {
 	return i.Top + i.Bottom
 }
+
+// constrainMax returns the smaller of the available space size and a maximum size m, where 0 or less
+// means no limit for either.
+func constrainMax(size int, m int) int {
+	if m > 0 && (size <= 0 || m < size) {
+		return m
+	}
+	return size
+}
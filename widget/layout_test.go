@@ -53,3 +53,20 @@ func TestInsets_Dy(t *testing.T) {
 
 	is.Equal(i.Dy(), 70)
 }
+
+func TestPreferredSizeFor(t *testing.T) {
+	is := is.New(t)
+
+	w, h := PreferredSizeFor(newWrappingWidget(100, 10, nil), 0, 0)
+	is.Equal(w, 100)
+	is.Equal(h, 10)
+
+	w, h = PreferredSizeFor(newWrappingWidget(100, 10, nil), 40, 0)
+	is.Equal(w, 40)
+	is.Equal(h, 25)
+
+	// Widgets that cannot be constrained keep their preferred size.
+	w, h = PreferredSizeFor(newSimpleWidget(100, 10, nil), 40, 0)
+	is.Equal(w, 100)
+	is.Equal(h, 10)
+}
@@ -45,13 +45,21 @@ type List struct {
 
 	EntrySelectedEvent *event.Event
 
+	// SelectionChangedEvent fires with *ListSelectionChangedEventArgs whenever entries are added to
+	// or removed from the selection.
+	SelectionChangedEvent *event.Event
+
 	containerOpts        []ContainerOpt
+	scrollContainerOpts  []ScrollContainerOpt
 	hideHorizontalSlider bool
 	hideVerticalSlider   bool
 
-	entries        []any
-	entryLabelFunc ListEntryLabelFunc
-	entrySortFunc  ListEntrySortFunc
+	entries         []any
+	entryLabelFunc  ListEntryLabelFunc
+	entryWidgetFunc ListEntryWidgetFunc
+	entrySortFunc   ListEntrySortFunc
+	// entryFaceRequired is set by widgets whose EntryWidgetFunc shows text in EntryFace.
+	entryFaceRequired bool
 
 	init            *MultiOnce
 	container       *Container
@@ -62,7 +70,21 @@ type List struct {
 	hSlider         *Slider
 	buttons         []*Button
 	selectedEntry   any
+	selected        map[any]bool
+	multiSelect     bool
+	anchorIndex     int
 	validated       bool
+	// entriesDisabled is whether the entries were last styled as disabled.
+	entriesDisabled bool
+
+	virtualized      bool
+	virtualRowHeight int
+	rowHeight        int
+	rowWidth         int
+	rows             []*Button
+	rowIndices       []int
+	rowContents      []*Container
+	rowEntries       []any
 
 	focused        bool
 	tabOrder       int
@@ -76,6 +98,10 @@ type List struct {
 type ListOpt func(l *List)
 
 type ListEntryLabelFunc func(e any) string
+
+// ListEntryWidgetFunc returns the widget that shows entry e. The List places it inside the entry's button,
+// which takes care of selection styling, focus, keyboard navigation and scrolling.
+type ListEntryWidgetFunc func(e any) PreferredSizeLocateableWidget
 type ListEntrySortFunc func(a, b any) int
 
 type ListEntryColor struct {
@@ -99,6 +125,16 @@ type ListEntrySelectedEventArgs struct {
 
 type ListEntrySelectedHandlerFunc func(args *ListEntrySelectedEventArgs)
 
+type ListSelectionChangedEventArgs struct {
+	List *List
+	// Added are the entries that have been added to the selection, in list order.
+	Added []any
+	// Removed are the entries that have been removed from the selection, in list order.
+	Removed []any
+}
+
+type ListSelectionChangedHandlerFunc func(args *ListSelectionChangedEventArgs)
+
 type ListOptions struct {
 }
 
@@ -106,9 +142,11 @@ var ListOpts ListOptions
 
 func NewList(opts ...ListOpt) *List {
 	l := &List{
-		EntrySelectedEvent: &event.Event{},
+		EntrySelectedEvent:    &event.Event{},
+		SelectionChangedEvent: &event.Event{},
 
 		init:           &MultiOnce{},
+		anchorIndex:    -1,
 		focusIndex:     0,
 		prevFocusIndex: -1,
 		focusMap:       make(map[FocusDirection]Focuser),
@@ -127,11 +165,11 @@ func (l *List) Validate() {
 	l.init.Do()
 	l.populateComputedParams()
 
-	if l.computedParams.EntryFace == nil {
+	if l.computedParams.EntryFace == nil && (l.entryWidgetFunc == nil || l.entryFaceRequired) {
 		panic("List: EntryFontFace is required.")
 	}
-	if l.entryLabelFunc == nil {
-		panic("List: EntryLabelFunc is required.")
+	if l.entryLabelFunc == nil && l.entryWidgetFunc == nil {
+		panic("List: EntryLabelFunc or EntryWidgetFunc is required.")
 	}
 	l.initWidget()
 	l.resetFocusIndex()
@@ -298,6 +336,14 @@ func (o ListOptions) ContainerOpts(opts ...ContainerOpt) ListOpt {
 	}
 }
 
+// ScrollContainerOpts configures the scroll container of the list, for example with
+// ScrollContainerOpts.SmoothScroll or ScrollContainerOpts.DragToScroll.
+func (o ListOptions) ScrollContainerOpts(opts ...ScrollContainerOpt) ListOpt {
+	return func(l *List) {
+		l.scrollContainerOpts = append(l.scrollContainerOpts, opts...)
+	}
+}
+
 // Specify the images for the scroll container.
 func (o ListOptions) ScrollContainerImage(image *ScrollContainerImage) ListOpt {
 	return func(l *List) {
@@ -349,6 +395,16 @@ func (o ListOptions) EntryLabelFunc(f ListEntryLabelFunc) ListOpt {
 	}
 }
 
+// EntryWidgetFunc uses f to create a widget for each entry instead of a text label. EntryFontFace is
+// not required then.
+// The widget is stretched to fill the entry's button unless it has layout data of its own.
+// If the list is virtualized, f is called again whenever a row is reused for another entry.
+func (o ListOptions) EntryWidgetFunc(f ListEntryWidgetFunc) ListOpt {
+	return func(l *List) {
+		l.entryWidgetFunc = f
+	}
+}
+
 func (o ListOptions) EntrySortFunc(f ListEntrySortFunc) ListOpt {
 	return func(l *List) {
 		l.entrySortFunc = f
@@ -399,6 +455,25 @@ func (o ListOptions) EntrySelectedHandler(f ListEntrySelectedHandlerFunc) ListOp
 	}
 }
 
+func (o ListOptions) SelectionChangedHandler(f ListSelectionChangedHandlerFunc) ListOpt {
+	return func(l *List) {
+		l.SelectionChangedEvent.AddHandler(func(args any) {
+			if arg, ok := args.(*ListSelectionChangedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// MultiSelect allows selecting several entries: Control-click toggles an entry, Shift-click selects
+// a range, Control+A selects all entries and Shift+Up/Down extend the selection with the keyboard.
+// SelectedEntry then returns the entry that was selected last.
+func (o ListOptions) MultiSelect() ListOpt {
+	return func(l *List) {
+		l.multiSelect = true
+	}
+}
+
 func (o ListOptions) AllowReselect() ListOpt {
 	return func(l *List) {
 		l.definedParams.AllowReselect = constantutil.ConstantToPointer(true)
@@ -419,6 +494,16 @@ func (o ListOptions) SelectPressed() ListOpt {
 	}
 }
 
+// Virtualized only creates buttons for the entries that are visible and reuses them while scrolling,
+// which keeps lists with many entries fast. All entries have the same height: rowHeight, or, if it is 0,
+// the preferred height of the first entry. The rows are as wide as the preferred width of the first entry.
+func (o ListOptions) Virtualized(rowHeight int) ListOpt {
+	return func(l *List) {
+		l.virtualized = true
+		l.virtualRowHeight = rowHeight
+	}
+}
+
 func (o ListOptions) TabOrder(tabOrder int) ListOpt {
 	return func(l *List) {
 		l.tabOrder = tabOrder
@@ -461,9 +546,17 @@ func (l *List) Render(screen *ebiten.Image) {
 		l.hSlider.DrawTrackDisabled = d
 	}
 	l.scrollContainer.GetWidget().Disabled = d
+	if d != l.entriesDisabled {
+		l.entriesDisabled = d
+		l.updateEntryStyles()
+	}
+
+	if l.virtualized {
+		l.updateRowPool()
+	}
 
-	if l.focusIndex != l.prevFocusIndex && l.focusIndex >= 0 && l.focusIndex < len(l.buttons) {
-		l.scrollVisible(l.buttons[l.focusIndex])
+	if l.focusIndex != l.prevFocusIndex && l.focusIndex >= 0 && l.focusIndex < l.entryCount() {
+		l.scrollVisible(l.focusIndex)
 	}
 
 	if *l.computedParams.SelectFocus {
@@ -506,70 +599,99 @@ func (l *List) AddFocus(direction FocusDirection, focus Focuser) {
 
 /** Focuser Interface - End **/
 
+// HandlesDirection reports that the up and down arrow keys move between entries,
+// unless the default keys have been disabled.
+func (l *List) HandlesDirection(direction FocusDirection) bool {
+	l.init.Do()
+	return !*l.computedParams.DisableDefaultKeys && (direction == FOCUS_NORTH || direction == FOCUS_SOUTH)
+}
+
+// Activate selects the focused entry.
+func (l *List) Activate() {
+	l.init.Do()
+	if !l.GetWidget().Disabled {
+		l.SelectFocused()
+	}
+}
+
 func (l *List) handleInput() {
-	if l.focused && !l.GetWidget().Disabled && len(l.buttons) > 0 {
-		if !*l.computedParams.DisableDefaultKeys && (input.KeyPressed(ebiten.KeyUp) || input.KeyPressed(ebiten.KeyDown)) {
+	if l.focused && !l.GetWidget().Disabled && l.entryCount() > 0 {
+		keymap := l.GetWidget().Keymap()
+		if !*l.computedParams.DisableDefaultKeys && (keymap.KeyPressed(KeyActionUp) || keymap.KeyPressed(KeyActionDown)) {
 			if !l.justMoved {
-				if input.KeyPressed(ebiten.KeyDown) {
+				if keymap.KeyPressed(KeyActionDown) {
 					l.FocusNext()
 				} else {
 					l.FocusPrevious()
 				}
+				if l.multiSelect {
+					if !input.KeyPressed(ebiten.KeyShift) {
+						l.anchorIndex = l.focusIndex
+					} else {
+						if l.anchorIndex < 0 {
+							l.anchorIndex = l.prevFocusIndex
+						}
+						l.selectRange(l.anchorIndex, l.focusIndex, false)
+					}
+				}
 			}
 		} else {
 			l.justMoved = false
 		}
-		l.buttons[l.focusIndex].focused = true
-	} else if len(l.buttons) > 0 && l.focusIndex <= len(l.buttons) {
-		l.buttons[l.focusIndex].focused = false
+		if l.multiSelect && !*l.computedParams.DisableDefaultKeys && listControlPressed() && input.KeyJustPressed(ebiten.KeyA) {
+			l.SelectAll()
+		}
+		l.setEntryFocused(l.focusIndex, true)
+	} else if l.entryCount() > 0 && l.focusIndex <= l.entryCount() {
+		l.setEntryFocused(l.focusIndex, false)
 	}
 }
 
 func (l *List) FocusNext() {
-	if len(l.buttons) > 0 {
+	if l.entryCount() > 0 {
 		direction := 1
-		l.buttons[l.focusIndex].focused = false
+		l.setEntryFocused(l.focusIndex, false)
 		l.prevFocusIndex = l.focusIndex
 		l.focusIndex += direction
 		if l.focusIndex < 0 {
-			l.focusIndex = len(l.buttons) - 1
+			l.focusIndex = l.entryCount() - 1
 		}
-		if l.focusIndex >= len(l.buttons) {
+		if l.focusIndex >= l.entryCount() {
 			l.focusIndex = 0
 		}
 		l.justMoved = true
-		l.buttons[l.focusIndex].focused = true
+		l.setEntryFocused(l.focusIndex, true)
 	}
 }
 
 func (l *List) FocusPrevious() {
-	if len(l.buttons) > 0 {
+	if l.entryCount() > 0 {
 		direction := -1
-		l.buttons[l.focusIndex].focused = false
+		l.setEntryFocused(l.focusIndex, false)
 		l.prevFocusIndex = l.focusIndex
 		l.focusIndex += direction
 		if l.focusIndex < 0 {
-			l.focusIndex = len(l.buttons) - 1
+			l.focusIndex = l.entryCount() - 1
 		}
-		if l.focusIndex >= len(l.buttons) {
+		if l.focusIndex >= l.entryCount() {
 			l.focusIndex = 0
 		}
 		l.justMoved = true
-		l.buttons[l.focusIndex].focused = true
+		l.setEntryFocused(l.focusIndex, true)
 	}
 }
 
 func (l *List) SelectFocused() {
-	if l.focusIndex >= 0 && l.focusIndex < len(l.buttons) {
-		l.scrollVisible(l.buttons[l.focusIndex])
+	if l.focusIndex >= 0 && l.focusIndex < l.entryCount() {
+		l.scrollVisible(l.focusIndex)
 		l.setSelectedEntry(l.entries[l.focusIndex], false)
 	}
 }
 
 func (l *List) resetFocusIndex() {
-	if len(l.buttons) > 0 {
-		if l.focusIndex != -1 && l.focusIndex < len(l.buttons) {
-			l.buttons[l.focusIndex].focused = false
+	if l.entryCount() > 0 {
+		if l.focusIndex != -1 && l.focusIndex < l.entryCount() {
+			l.setEntryFocused(l.focusIndex, false)
 		}
 		for i := 0; i < len(l.entries); i++ {
 			if l.entries[i] == l.selectedEntry {
@@ -614,27 +736,43 @@ func (l *List) initWidget() {
 		l.container.GetWidget().MinHeight = l.computedParams.MinSize.Y
 	}
 
+	var contentLayout Layouter = NewRowLayout(RowLayoutOpts.Direction(DirectionVertical))
+	if l.virtualized {
+		contentLayout = &virtualListLayout{list: l}
+	}
 	l.listContent = NewContainer(
-		ContainerOpts.Layout(NewRowLayout(
-			RowLayoutOpts.Direction(DirectionVertical))),
+		ContainerOpts.Layout(contentLayout),
 		ContainerOpts.AutoDisableChildren(),
 	)
 
-	l.buttons = make([]*Button, 0, len(l.entries))
-	for _, e := range l.entries {
-		e := e
-		but := l.createEntry(e)
+	l.buttons = nil
+	l.rows = nil
+	l.rowIndices = nil
+	l.rowContents = nil
+	l.rowEntries = nil
+	if l.virtualized {
+		l.measureRows()
+	} else {
+		l.buttons = make([]*Button, 0, len(l.entries))
+		for _, e := range l.entries {
+			e := e
+			but := l.createEntry(e)
 
-		l.buttons = append(l.buttons, but)
-		l.listContent.AddChild(but)
+			l.buttons = append(l.buttons, but)
+			l.listContent.AddChild(but)
+		}
 	}
 
-	l.scrollContainer = NewScrollContainer(
+	l.scrollContainer = NewScrollContainer(append([]ScrollContainerOpt{
 		ScrollContainerOpts.Content(l.listContent),
 		ScrollContainerOpts.StretchContentWidth(),
 		ScrollContainerOpts.Image(l.computedParams.ScrollContainerImage),
 		ScrollContainerOpts.Padding(l.computedParams.ScrollContainerPadding),
-	)
+		ScrollContainerOpts.ScrollChangedHandler(func(args *ScrollContainerScrollChangedEventArgs) {
+			l.setScrollTop(args.ScrollTop)
+			l.setScrollLeft(args.ScrollLeft)
+		}),
+	}, l.scrollContainerOpts...)...)
 
 	l.container.AddChild(l.scrollContainer)
 
@@ -679,6 +817,11 @@ func (l *List) initWidget() {
 				if pageSizeFunc() >= 1000 {
 					current = 0
 				}
+				// While the scroll container animates or is dragged, the slider follows it. Setting the rounded
+				// position of the slider would stop the animation.
+				if l.scrollContainer.Scrolling() && current == int(math.Round(l.scrollContainer.ScrollTop*1000)) {
+					return
+				}
 				l.scrollContainer.ScrollTop = float64(current) / 1000
 			}),
 		}...)...)
@@ -690,6 +833,12 @@ func (l *List) initWidget() {
 				if p < 1 {
 					p = 1
 				}
+				if l.scrollContainer.smoothScroll > 0 {
+					// Continue from where an ongoing animation is headed, so that fast wheel ticks add up.
+					left, top := l.scrollContainer.ScrollTarget()
+					l.scrollContainer.ScrollToPosition(left, top-a.Y*float64(p)/1000, true)
+					return
+				}
 				l.vSlider.Current -= int(math.Round(a.Y * float64(p)))
 			}
 		})
@@ -703,16 +852,21 @@ func (l *List) initWidget() {
 				return int(math.Round(float64(l.scrollContainer.ViewRect().Dx()) / float64(l.listContent.GetWidget().Rect.Dx()) * 1000))
 			}),
 			SliderOpts.ChangedHandler(func(args *SliderChangedEventArgs) {
+				if l.scrollContainer.Scrolling() && args.Slider.Current == int(math.Round(l.scrollContainer.ScrollLeft*1000)) {
+					return
+				}
 				l.scrollContainer.ScrollLeft = float64(args.Slider.Current) / 1000
 			}),
 		}...)...)
 		l.container.AddChild(l.hSlider)
 	}
 
-	if l.selectedEntry != nil {
-		se := l.selectedEntry
+	if l.selectedEntry != nil || len(l.selected) > 0 {
+		selection := l.SelectedEntries()
+		lead := l.selectedEntry
 		l.selectedEntry = nil
-		l.SetSelectedEntry(se)
+		l.selected = nil
+		l.setSelection(selection, lead, false)
 	}
 }
 
@@ -728,7 +882,9 @@ func (l *List) SetEntries(newEntries []any) {
 	l.entries = l.normalizeEntries(newEntries)
 	l.buttons = nil
 
-	if l.validated {
+	if l.validated && l.virtualized {
+		l.entriesChanged()
+	} else if l.validated {
 		l.buttons = make([]*Button, 0, len(l.entries))
 		for _, entry := range l.entries {
 			but := l.createEntry(entry)
@@ -738,9 +894,50 @@ func (l *List) SetEntries(newEntries []any) {
 	}
 
 	l.selectedEntry = nil
+	l.selected = nil
+	l.anchorIndex = -1
 	l.resetFocusIndex()
 }
 
+// replaceEntries replaces the entries like SetEntries, but keeps the selection and the focused entry
+// as far as they are still there, without firing any events.
+func (l *List) replaceEntries(entries []any) {
+	lead, selected := l.selectedEntry, l.selected
+	var focused any
+	if l.focusIndex >= 0 && l.focusIndex < len(l.entries) {
+		focused = l.entries[l.focusIndex]
+	}
+
+	l.SetEntries(entries)
+
+	if slices.Contains(l.entries, lead) {
+		l.selectedEntry = lead
+	}
+	l.selected = make(map[any]bool, len(selected))
+	for _, e := range l.entries {
+		if selected[e] {
+			l.selected[e] = true
+		}
+	}
+	for i, but := range l.buttons {
+		l.setEntryButtonStyle(but, l.isSelected(l.entries[i]))
+	}
+	if i := slices.Index(l.entries, focused); i >= 0 {
+		l.focusEntry(i)
+	}
+}
+
+// focusEntry moves the keyboard focus to the entry at index.
+func (l *List) focusEntry(index int) {
+	if index < 0 || index >= l.entryCount() {
+		return
+	}
+	l.setEntryFocused(l.focusIndex, false)
+	l.prevFocusIndex = l.focusIndex
+	l.focusIndex = index
+	l.setEntryFocused(index, l.focused)
+}
+
 // UpdateEntry recreates the button for the passed in entry if it exists.
 func (l *List) UpdateEntry(entry any) {
 	l.init.Do()
@@ -749,12 +946,24 @@ func (l *List) UpdateEntry(entry any) {
 		return
 	}
 
+	if l.virtualized {
+		for k, e := range l.rowEntries {
+			if e == entry {
+				// Forget the row's widget so that it is created again on the next layout.
+				l.rowEntries[k] = nil
+				l.rowContents[k].RemoveChildren()
+			}
+		}
+		l.listContent.RequestRelayout()
+		return
+	}
+
 	for i, e := range l.entries {
 		if e == entry {
 			oldButton := l.buttons[i]
 			newButton := l.createEntry(entry)
 			newButton.focused = oldButton.focused
-			l.setEntryButtonStyle(newButton, entry == l.selectedEntry)
+			l.setEntryButtonStyle(newButton, l.isSelected(entry))
 			l.buttons[i] = newButton
 			l.listContent.ReplaceChild(oldButton, newButton)
 			return
@@ -769,17 +978,34 @@ func (l *List) RemoveEntry(entry any) {
 	if len(l.entries) > 0 && entry != nil {
 		for i, e := range l.entries {
 			if e == entry {
-				but := l.buttons[i]
 				l.entries = append(l.entries[:i], l.entries[i+1:]...)
-				l.buttons = append(l.buttons[:i], l.buttons[i+1:]...)
-				l.listContent.RemoveChild(but)
+				if l.virtualized {
+					if l.validated {
+						l.entriesChanged()
+					}
+				} else if i < len(l.buttons) {
+					but := l.buttons[i]
+					l.buttons = append(l.buttons[:i], l.buttons[i+1:]...)
+					l.listContent.RemoveChild(but)
+				}
 
 				entryLen := len(l.entries)
 				if l.focusIndex >= entryLen {
 					l.focusIndex = i - 1
 				}
 
-				if l.focusIndex >= 0 && l.focusIndex < entryLen {
+				if l.multiSelect {
+					l.anchorIndex = -1
+					if l.isSelected(entry) {
+						lead := l.selectedEntry
+						if lead == entry {
+							lead = nil
+						}
+						l.setSelection(slices.DeleteFunc(l.SelectedEntries(), func(e any) bool {
+							return e == entry
+						}), lead, false)
+					}
+				} else if l.focusIndex >= 0 && l.focusIndex < entryLen {
 					l.setSelectedEntry(l.entries[l.focusIndex], false)
 				}
 				break
@@ -797,14 +1023,18 @@ func (l *List) AddEntry(entry any) {
 		if l.entrySortFunc != nil {
 			index, _ := slices.BinarySearchFunc(l.entries, entry, l.entrySortFunc)
 			l.entries = slices.Insert(l.entries, index, entry)
-			if l.validated {
+			if l.validated && l.virtualized {
+				l.entriesChanged()
+			} else if l.validated {
 				but := l.createEntry(entry)
 				l.buttons = slices.Insert(l.buttons, index, but)
 				l.insertListContentChild(index, but)
 			}
 		} else {
 			l.entries = append(l.entries, entry)
-			if l.validated {
+			if l.validated && l.virtualized {
+				l.entriesChanged()
+			} else if l.validated {
 				but := l.createEntry(entry)
 				l.buttons = append(l.buttons, but)
 				l.listContent.AddChild(but)
@@ -820,34 +1050,196 @@ func (l *List) Entries() []any {
 	return l.entries
 }
 
-// Return the currently selected entry in the list.
+// Return the currently selected entry in the list. If the list allows multiple selected entries,
+// this is the entry that was selected last.
 func (l *List) SelectedEntry() any {
 	l.init.Do()
 	return l.selectedEntry
 }
 
-// Set the Selected Entry to e if it is found.
+// Set the Selected Entry to e if it is found. Other selected entries are deselected.
 func (l *List) SetSelectedEntry(entry any) {
 	l.setSelectedEntry(entry, false)
 }
 
+// SelectedEntries returns the selected entries in list order.
+func (l *List) SelectedEntries() []any {
+	l.init.Do()
+	var result []any
+	for _, e := range l.entries {
+		if l.selected[e] {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// SetSelectedEntries selects entries and deselects all others.
+// If the list does not allow multiple selected entries, only the first one is selected.
+func (l *List) SetSelectedEntries(entries []any) {
+	l.init.Do()
+	if !l.multiSelect {
+		var e any
+		if len(entries) > 0 {
+			e = entries[0]
+		}
+		l.setSelectedEntry(e, false)
+		return
+	}
+
+	lead := l.selectedEntry
+	if !slices.Contains(entries, lead) {
+		lead = nil
+		if len(entries) > 0 {
+			lead = entries[0]
+		}
+	}
+	l.anchorIndex = -1
+	l.setSelection(entries, lead, false)
+}
+
+// SelectAll selects all entries if the list allows multiple selected entries.
+func (l *List) SelectAll() {
+	l.init.Do()
+	if !l.multiSelect || len(l.entries) == 0 {
+		return
+	}
+	lead := l.selectedEntry
+	if lead == nil && l.focusIndex >= 0 && l.focusIndex < len(l.entries) {
+		lead = l.entries[l.focusIndex]
+	}
+	l.setSelection(slices.Clone(l.entries), lead, false)
+}
+
 func (l *List) setSelectedEntry(e any, user bool) {
-	if e != l.selectedEntry || (user && *l.computedParams.AllowReselect) {
-		l.init.Do()
+	var selection []any
+	if e != nil {
+		selection = []any{e}
+	}
+	l.setSelection(selection, e, user)
+}
 
-		prev := l.selectedEntry
-		l.selectedEntry = e
-		l.resetFocusIndex()
-		for i := range l.buttons {
-			l.setEntryButtonStyle(l.buttons[i], l.entries[i] == e)
+// userSelectEntry selects entry after it has been clicked. In multi-select lists, Control toggles the entry
+// and Shift selects the range from the previously clicked entry.
+func (l *List) userSelectEntry(entry any) {
+	if !l.multiSelect {
+		l.setSelectedEntry(entry, true)
+		return
+	}
+
+	index := slices.Index(l.entries, entry)
+	switch {
+	case input.KeyPressed(ebiten.KeyShift) && l.anchorIndex >= 0:
+		l.selectRange(l.anchorIndex, index, listControlPressed())
+	case listControlPressed():
+		selection := l.SelectedEntries()
+		lead := any(entry)
+		if l.selected[entry] {
+			selection = slices.DeleteFunc(selection, func(e any) bool {
+				return e == entry
+			})
+			lead = nil
+		} else {
+			selection = append(selection, entry)
+		}
+		l.anchorIndex = index
+		l.setSelection(selection, lead, true)
+	default:
+		l.anchorIndex = index
+		l.setSelection([]any{entry}, entry, true)
+	}
+
+	if index >= 0 && index != l.focusIndex {
+		l.setEntryFocused(l.focusIndex, false)
+		l.prevFocusIndex = l.focusIndex
+		l.focusIndex = index
+	}
+}
+
+// selectRange selects the entries between the indices from and to, inclusive. If add is true,
+// they are added to the current selection, otherwise they replace it.
+func (l *List) selectRange(from int, to int, add bool) {
+	if from < 0 || to < 0 || from >= len(l.entries) || to >= len(l.entries) {
+		return
+	}
+	var selection []any
+	if add {
+		selection = l.SelectedEntries()
+	}
+	lo, hi := min(from, to), max(from, to)
+	selection = append(selection, l.entries[lo:hi+1]...)
+	l.setSelection(selection, l.entries[to], false)
+}
+
+// setSelection makes entries the selected entries and lead the entry returned by SelectedEntry,
+// then fires the events for what has changed.
+func (l *List) setSelection(entries []any, lead any, user bool) {
+	l.init.Do()
+
+	selected := make(map[any]bool, len(entries))
+	for _, e := range entries {
+		if e != nil {
+			selected[e] = true
 		}
+	}
 
+	var added, removed []any
+	for _, e := range l.entries {
+		if selected[e] && !l.selected[e] {
+			added = append(added, e)
+		} else if !selected[e] && l.selected[e] {
+			removed = append(removed, e)
+		}
+	}
+
+	leadChanged := lead != l.selectedEntry || (user && *l.computedParams.AllowReselect)
+	if !leadChanged && len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	prev := l.selectedEntry
+	l.selectedEntry = lead
+	l.selected = selected
+	if lead != nil || !l.multiSelect {
+		l.resetFocusIndex()
+	}
+	l.updateEntryStyles()
+
+	if leadChanged {
 		l.EntrySelectedEvent.Fire(&ListEntrySelectedEventArgs{
 			List:          l,
-			Entry:         e,
+			Entry:         lead,
 			PreviousEntry: prev,
 		})
 	}
+	if len(added) > 0 || len(removed) > 0 {
+		l.SelectionChangedEvent.Fire(&ListSelectionChangedEventArgs{
+			List:    l,
+			Added:   added,
+			Removed: removed,
+		})
+	}
+}
+
+func (l *List) isSelected(entry any) bool {
+	return l.selected[entry]
+}
+
+// listControlPressed reports whether Control, or Meta for macOS users, is pressed.
+func listControlPressed() bool {
+	return input.KeyPressed(ebiten.KeyControl) || input.KeyPressed(ebiten.KeyMeta)
+}
+
+// updateEntryStyles styles the entry buttons according to the current selection.
+func (l *List) updateEntryStyles() {
+	for i := range l.buttons {
+		l.setEntryButtonStyle(l.buttons[i], l.isSelected(l.entries[i]))
+	}
+	for k, i := range l.rowIndices {
+		if i >= 0 {
+			l.setEntryButtonStyle(l.rows[k], l.isSelected(l.entries[i]))
+		}
+	}
 }
 
 func (l *List) setEntryButtonStyle(button *Button, selected bool) {
@@ -858,8 +1250,23 @@ func (l *List) setEntryButtonStyle(button *Button, selected bool) {
 		textColor = l.computedParams.entryTextColor
 	}
 	button.definedParams.Image = image
-	button.definedParams.TextColor = textColor
 	button.computedParams.Image = image
+	if button.content != nil {
+		// Entry widgets are styled by the EntryWidgetFunc, except for the labels Table and TreeView
+		// create for it, which take the text color of the entry.
+		c := textColor.Idle
+		if l.entriesDisabled && textColor.Disabled != nil {
+			c = textColor.Disabled
+		}
+		Walk(button.content, func(w HasWidget) bool {
+			if t, ok := w.(*Text); ok && t.listEntryColor {
+				t.SetColor(c)
+			}
+			return true
+		})
+		return
+	}
+	button.definedParams.TextColor = textColor
 	button.computedParams.TextColor = textColor
 }
 
@@ -891,21 +1298,41 @@ func (l *List) insertListContentChild(index int, child PreferredSizeLocateableWi
 }
 
 func (l *List) createEntry(entry any) *Button {
-	but := NewButton(
+	selectEntry := func() {
+		l.userSelectEntry(entry)
+	}
+	if l.entryWidgetFunc != nil {
+		but := l.newEntryButton("", l.entryWidgetFunc(entry), selectEntry)
+		l.setEntryButtonStyle(but, l.isSelected(entry))
+		return but
+	}
+	return l.newEntryButton(l.entryLabelFunc(entry), nil, selectEntry)
+}
+
+// newEntryButton creates an entry button that shows either label or, if it is not nil, content.
+func (l *List) newEntryButton(label string, content PreferredSizeLocateableWidget, selectEntry func()) *Button {
+	opts := []ButtonOpt{
 		ButtonOpts.WidgetOpts(WidgetOpts.LayoutData(RowLayoutData{
 			Stretch: true,
 		})),
 		ButtonOpts.Image(l.computedParams.entryUnselectedColor),
-		ButtonOpts.Text(l.entryLabelFunc(entry), l.computedParams.EntryFace, l.computedParams.entryUnselectedTextColor),
 		ButtonOpts.TextPadding(l.computedParams.EntryTextPadding),
-		ButtonOpts.TextPosition(*l.computedParams.EntryTextHorizontalPosition, *l.computedParams.EntryTextVerticalPosition),
-	)
+	}
+	if content != nil {
+		opts = append(opts, ButtonOpts.Content(content))
+	} else {
+		opts = append(opts,
+			ButtonOpts.Text(label, l.computedParams.EntryFace, l.computedParams.entryUnselectedTextColor),
+			ButtonOpts.TextPosition(*l.computedParams.EntryTextHorizontalPosition, *l.computedParams.EntryTextVerticalPosition),
+		)
+	}
+	but := NewButton(opts...)
 	events := but.ClickedEvent
 	if *l.computedParams.SelectPressed {
 		events = but.PressedEvent
 	}
 	events.AddHandler(func(_ interface{}) {
-		l.setSelectedEntry(entry, true)
+		selectEntry()
 	})
 	but.Validate()
 	return but
@@ -927,10 +1354,13 @@ func (l *List) setScrollLeft(left float64) {
 	l.scrollContainer.ScrollLeft = left
 }
 
-func (l *List) scrollVisible(w HasWidget) {
+// scrollVisible scrolls the entry at index into view.
+func (l *List) scrollVisible(index int) {
 	vrect := l.scrollContainer.ViewRect()
-	wrect := w.GetWidget().Rect
-	if !wrect.In(vrect) {
+	wrect := l.entryRect(index)
+	if !wrect.In(vrect) && l.scrollContainer.smoothScroll > 0 {
+		l.scrollContainer.scrollRectIntoView(wrect, true)
+	} else if !wrect.In(vrect) {
 		crect := l.scrollContainer.ContentRect()
 		scrollTop := l.scrollContainer.ScrollTop
 		scrollHeight := crect.Dy() - vrect.Dy()
@@ -959,3 +1389,164 @@ func scrollClamp(targetScroll, currentScroll float64) float64 {
 	maxScroll := currentScroll + maxScrollStep
 	return math.Max(minScroll, math.Min(targetScroll, maxScroll))
 }
+
+// entryCount returns the number of entries that can be focused: all entries if the list is virtualized,
+// otherwise those that have a button.
+func (l *List) entryCount() int {
+	if l.virtualized {
+		if l.listContent == nil {
+			return 0
+		}
+		return len(l.entries)
+	}
+	return len(l.buttons)
+}
+
+// entryButton returns the button showing the entry at index, or nil if there is none.
+// In virtualized lists, only visible entries have a button.
+func (l *List) entryButton(index int) *Button {
+	if l.virtualized {
+		for k, i := range l.rowIndices {
+			if i == index {
+				return l.rows[k]
+			}
+		}
+		return nil
+	}
+	if index >= 0 && index < len(l.buttons) {
+		return l.buttons[index]
+	}
+	return nil
+}
+
+func (l *List) setEntryFocused(index int, focused bool) {
+	if b := l.entryButton(index); b != nil {
+		b.focused = focused
+	}
+}
+
+// entryRect returns the location of the entry at index, whether or not it currently has a button.
+func (l *List) entryRect(index int) img.Rectangle {
+	if !l.virtualized {
+		return l.buttons[index].GetWidget().Rect
+	}
+	crect := l.listContent.GetWidget().Rect
+	top := crect.Min.Y + index*l.rowHeight
+	return img.Rect(crect.Min.X, top, crect.Max.X, top+l.rowHeight)
+}
+
+// entriesChanged updates a virtualized list after its entries have changed.
+func (l *List) entriesChanged() {
+	l.measureRows()
+	l.listContent.RequestRelayout()
+}
+
+// measureRows determines the size of the rows of a virtualized list.
+func (l *List) measureRows() {
+	l.rowHeight = l.virtualRowHeight
+	l.rowWidth = 0
+	if len(l.entries) == 0 {
+		return
+	}
+	w, h := l.createEntry(l.entries[0]).PreferredSize()
+	l.rowWidth = w
+	if l.rowHeight <= 0 {
+		l.rowHeight = h
+	}
+}
+
+// updateRowPool makes sure that a virtualized list has enough row buttons to fill its view.
+func (l *List) updateRowPool() {
+	if l.rowHeight <= 0 {
+		return
+	}
+	needed := int(math.Ceil(float64(l.scrollContainer.ViewRect().Dy())/float64(l.rowHeight))) + 1
+	needed = min(needed, len(l.entries))
+	for len(l.rows) < needed {
+		slot := len(l.rows)
+		selectEntry := func() {
+			if i := l.rowIndices[slot]; i >= 0 && i < len(l.entries) {
+				l.userSelectEntry(l.entries[i])
+			}
+		}
+		var but *Button
+		if l.entryWidgetFunc != nil {
+			content := NewContainer(ContainerOpts.Layout(NewAnchorLayout()))
+			but = l.newEntryButton("", content, selectEntry)
+			l.rowContents = append(l.rowContents, content)
+		} else {
+			but = l.newEntryButton("", nil, selectEntry)
+		}
+		l.rows = append(l.rows, but)
+		l.rowIndices = append(l.rowIndices, -1)
+		l.rowEntries = append(l.rowEntries, nil)
+		l.listContent.AddChild(but)
+	}
+}
+
+// virtualListLayout lays out the row buttons of a virtualized list over the entries that are visible.
+type virtualListLayout struct {
+	list *List
+}
+
+func (v *virtualListLayout) PreferredSize(_ []PreferredSizeLocateableWidget) (int, int) {
+	return v.list.rowWidth, v.list.rowHeight * len(v.list.entries)
+}
+
+func (v *virtualListLayout) Layout(widgets []PreferredSizeLocateableWidget, rect img.Rectangle) {
+	l := v.list
+	first := 0
+	if l.rowHeight > 0 {
+		first = max(0, (l.scrollContainer.ViewRect().Min.Y-rect.Min.Y)/l.rowHeight)
+	}
+
+	for k, w := range widgets {
+		but := l.rows[k]
+		index := first + k
+		if index >= len(l.entries) {
+			l.rowIndices[k] = -1
+			but.focused = false
+			l.bindRow(k, nil)
+			w.SetLocation(img.Rectangle{Min: rect.Min, Max: rect.Min})
+			continue
+		}
+
+		entry := l.entries[index]
+		l.rowIndices[k] = index
+		l.bindRow(k, entry)
+		l.setEntryButtonStyle(but, l.isSelected(entry))
+		but.focused = l.focused && index == l.focusIndex
+
+		top := rect.Min.Y + index*l.rowHeight
+		w.SetLocation(img.Rect(rect.Min.X, top, rect.Max.X, top+l.rowHeight))
+	}
+}
+
+// bindRow makes row k of a virtualized list show entry, or nothing if entry is nil.
+func (l *List) bindRow(k int, entry any) {
+	if l.entryWidgetFunc == nil {
+		label := ""
+		if entry != nil {
+			label = l.entryLabelFunc(entry)
+		}
+		l.rows[k].SetText(label)
+		return
+	}
+
+	if l.rowEntries[k] == entry {
+		return
+	}
+	l.rowEntries[k] = entry
+	l.rowContents[k].RemoveChildren()
+	if entry == nil {
+		return
+	}
+	w := l.entryWidgetFunc(entry)
+	if w.GetWidget().LayoutData == nil {
+		w.GetWidget().LayoutData = AnchorLayoutData{
+			StretchHorizontal: true,
+			StretchVertical:   true,
+		}
+	}
+	l.rowContents[k].AddChild(w)
+}
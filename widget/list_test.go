@@ -1,11 +1,16 @@
 package widget
 
 import (
+	"fmt"
+	img "image"
 	"image/color"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -289,25 +294,22 @@ func TestList_AddEntry_ResortsAndReusesButtons(t *testing.T) {
 	is.Equal(children[2], button4)
 }
 
-func newList(t *testing.T, opts ...ListOpt) *List {
+// newListOptsWithoutFace returns opts together with the options a list requires, except EntryFontFace.
+func newListOptsWithoutFace(t *testing.T, opts ...ListOpt) []ListOpt {
 	t.Helper()
 
-	l := NewList(append(opts, []ListOpt{
+	return append(opts,
 		ListOpts.ScrollContainerImage(&ScrollContainerImage{
 			Idle:     newNineSliceEmpty(t),
 			Disabled: newNineSliceEmpty(t),
 			Mask:     newNineSliceEmpty(t),
 		}),
-
 		ListOpts.SliderParams(&SliderParams{
 			TrackImage: &SliderTrackImage{},
 			HandleImage: &ButtonImage{
 				Idle:    newNineSliceEmpty(t),
 				Pressed: newNineSliceEmpty(t),
 			}}),
-
-		ListOpts.EntryFontFace(loadFont(t)),
-
 		ListOpts.EntryColor(&ListEntryColor{
 			Unselected:                 color.Transparent,
 			Selected:                   color.Transparent,
@@ -316,7 +318,13 @@ func newList(t *testing.T, opts ...ListOpt) *List {
 			SelectedBackground:         color.Transparent,
 			DisabledSelectedBackground: color.Transparent,
 		}),
-	}...)...)
+	)
+}
+
+func newList(t *testing.T, opts ...ListOpt) *List {
+	t.Helper()
+
+	l := NewList(newListOptsWithoutFace(t, append(opts, ListOpts.EntryFontFace(loadFont(t)))...)...)
 
 	event.ExecuteDeferred()
 	render(l, t)
@@ -326,3 +334,323 @@ func newList(t *testing.T, opts ...ListOpt) *List {
 func listEntryButtons(l *List) []*Button {
 	return l.buttons
 }
+
+func TestList_Virtualized(t *testing.T) {
+	is := is.New(t)
+
+	entries := make([]any, 10000)
+	for i := range entries {
+		entries[i] = i
+	}
+
+	list := newList(t,
+		ListOpts.Entries(entries),
+		ListOpts.EntryLabelFunc(func(e any) string {
+			return fmt.Sprint(e)
+		}),
+		ListOpts.Virtualized(20),
+		ListOpts.HideHorizontalSlider(),
+	)
+	list.SetLocation(img.Rect(0, 0, 100, 100))
+	list.RequestRelayout()
+	renderList(list)
+
+	// Only the visible rows have buttons.
+	is.Equal(list.listContent.GetWidget().Rect.Dy(), 20*10000)
+	is.Equal(len(list.rows), 6)
+	is.Equal(list.rows[0].Text().Label, "0")
+	is.Equal(list.rows[0].GetWidget().Rect, img.Rect(0, 0, list.scrollContainer.ViewRect().Dx(), 20))
+
+	leftMouseButtonClick(list.rows[2], t)
+	is.Equal(list.SelectedEntry(), 2)
+
+	// Scrolling reuses the rows for other entries.
+	list.setScrollTop(0.5)
+	renderList(list)
+	is.Equal(len(list.rows), 6)
+	first := list.rowIndices[0]
+	is.True(first > 4900 && first < 5100)
+	is.Equal(list.rows[0].Text().Label, strconv.Itoa(first))
+
+	leftMouseButtonClick(list.rows[1], t)
+	is.Equal(list.SelectedEntry(), first+1)
+	is.Equal(list.focusIndex, first+1)
+
+	// Keyboard focus works on the logical index, even for entries without a row.
+	list.SetSelectedEntry(9999)
+	is.Equal(list.focusIndex, 9999)
+	list.FocusNext()
+	is.Equal(list.focusIndex, 0)
+	list.FocusPrevious()
+	list.SelectFocused()
+	event.ExecuteDeferred()
+	is.Equal(list.SelectedEntry(), 9999)
+
+	list.SetEntries([]any{"a", "b"})
+	renderList(list)
+	is.Equal(list.listContent.GetWidget().Rect.Dy(), 40)
+	is.Equal(list.rowIndices, []int{0, 1, -1, -1, -1, -1})
+
+	// The rows are measured again for the new entries.
+	width := list.rowWidth
+	list.SetEntries([]any{"a much longer entry than before", "b"})
+	w, h := list.listContent.PreferredSize()
+	is.True(w > width)
+	is.Equal(h, 40)
+}
+
+func TestList_SmoothScroll(t *testing.T) {
+	is := is.New(t)
+
+	entries := make([]any, 1000)
+	for i := range entries {
+		entries[i] = i
+	}
+
+	list := newList(t,
+		ListOpts.Entries(entries),
+		ListOpts.EntryLabelFunc(func(e any) string {
+			return fmt.Sprint(e)
+		}),
+		ListOpts.Virtualized(20),
+		ListOpts.HideHorizontalSlider(),
+		ListOpts.ScrollContainerOpts(ScrollContainerOpts.SmoothScroll(100*time.Millisecond)),
+	)
+	list.SetLocation(img.Rect(0, 0, 100, 100))
+	list.RequestRelayout()
+	renderList(list)
+	source, frame := useFakeInput(t, list)
+
+	// The wheel animates towards the new position, and the slider follows.
+	source.SetCursorPosition(50, 50)
+	source.Scroll(0, -3)
+	frame()
+	is.Equal(list.scrollContainer.ScrollTop, 0.0)
+	_, target := list.scrollContainer.ScrollTarget()
+	is.Equal(target, 0.003)
+	for i := 0; i < 10; i++ {
+		frame()
+		renderList(list)
+	}
+	is.Equal(list.scrollContainer.ScrollTop, 0.003)
+	is.Equal(list.vSlider.Current, 3)
+
+	// So does keyboard focus.
+	list.SetSelectedEntry(500)
+	renderList(list)
+	_, target = list.scrollContainer.ScrollTarget()
+	is.True(list.scrollContainer.ScrollTop < 0.01)
+	is.True(target > 0.49 && target < 0.51)
+	for i := 0; i < 10; i++ {
+		frame()
+		renderList(list)
+	}
+	is.Equal(list.scrollContainer.ScrollTop, target)
+	is.True(list.entryRect(500).In(list.scrollContainer.ViewRect()))
+}
+
+func renderList(l *List) {
+	screen := ebiten.NewImage(100, 100)
+	for i := 0; i < 2; i++ {
+		l.Render(screen)
+		event.ExecuteDeferred()
+	}
+}
+
+func TestList_MultiSelect(t *testing.T) {
+	is := is.New(t)
+
+	entries := []any{"a", "b", "c", "d", "e"}
+	var changes []*ListSelectionChangedEventArgs
+	list := newList(t,
+		ListOpts.Entries(entries),
+		ListOpts.EntryLabelFunc(func(e any) string {
+			return e.(string)
+		}),
+		ListOpts.MultiSelect(),
+		ListOpts.SelectionChangedHandler(func(args *ListSelectionChangedEventArgs) {
+			changes = append(changes, args)
+		}),
+	)
+	source := input.NewFakeSource()
+	input.SetSource(source)
+	t.Cleanup(func() {
+		input.SetSource(nil)
+	})
+	withKeys := func(f func(), keys ...ebiten.Key) {
+		for _, k := range keys {
+			source.PressKey(k)
+		}
+		input.Update()
+		f()
+		for _, k := range keys {
+			source.ReleaseKey(k)
+		}
+		input.Update()
+	}
+
+	leftMouseButtonClick(list.buttons[1], t)
+	is.Equal(list.SelectedEntries(), []any{"b"})
+
+	withKeys(func() { leftMouseButtonClick(list.buttons[3], t) }, ebiten.KeyControl)
+	is.Equal(list.SelectedEntries(), []any{"b", "d"})
+	is.Equal(list.SelectedEntry(), "d")
+	is.Equal(changes[len(changes)-1].Added, []any{"d"})
+
+	// Shift selects the range from the entry clicked last.
+	withKeys(func() { leftMouseButtonClick(list.buttons[0], t) }, ebiten.KeyShift)
+	is.Equal(list.SelectedEntries(), []any{"a", "b", "c", "d"})
+	is.Equal(changes[len(changes)-1].Added, []any{"a", "c"})
+
+	// Control toggles an entry off.
+	withKeys(func() { leftMouseButtonClick(list.buttons[2], t) }, ebiten.KeyControl)
+	is.Equal(list.SelectedEntries(), []any{"a", "b", "d"})
+	is.Equal(changes[len(changes)-1].Removed, []any{"c"})
+
+	// A plain click selects only the clicked entry.
+	leftMouseButtonClick(list.buttons[4], t)
+	is.Equal(list.SelectedEntries(), []any{"e"})
+	is.Equal(changes[len(changes)-1].Removed, []any{"a", "b", "d"})
+
+	list.SetSelectedEntries([]any{"c", "a"})
+	event.ExecuteDeferred()
+	is.Equal(list.SelectedEntries(), []any{"a", "c"})
+	is.Equal(list.SelectedEntry(), "c")
+
+	list.Focus(true)
+	withKeys(func() { list.Update(&UpdateObject{}) }, ebiten.KeyControl, ebiten.KeyA)
+	event.ExecuteDeferred()
+	is.Equal(list.SelectedEntries(), entries)
+}
+
+func TestList_MultiSelect_ShiftArrows(t *testing.T) {
+	is := is.New(t)
+
+	list := newList(t,
+		ListOpts.Entries([]any{"a", "b", "c", "d"}),
+		ListOpts.EntryLabelFunc(func(e any) string {
+			return e.(string)
+		}),
+		ListOpts.MultiSelect(),
+	)
+	source := input.NewFakeSource()
+	input.SetSource(source)
+	t.Cleanup(func() {
+		input.SetSource(nil)
+	})
+
+	leftMouseButtonClick(list.buttons[1], t)
+	list.Focus(true)
+
+	source.PressKey(ebiten.KeyShift)
+	for i := 0; i < 2; i++ {
+		source.PressKey(ebiten.KeyDown)
+		input.Update()
+		list.Update(&UpdateObject{})
+		source.ReleaseKey(ebiten.KeyDown)
+		input.Update()
+		list.Update(&UpdateObject{})
+	}
+	event.ExecuteDeferred()
+
+	is.Equal(list.SelectedEntries(), []any{"b", "c", "d"})
+	is.Equal(list.SelectedEntry(), "d")
+}
+
+func TestList_EntryWidgetFunc(t *testing.T) {
+	is := is.New(t)
+
+	widgets := map[any]*Container{}
+	list := newList(t,
+		ListOpts.Entries([]any{"a", "b", "c"}),
+		ListOpts.EntryWidgetFunc(func(e any) PreferredSizeLocateableWidget {
+			c := NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.MinSize(50, 20)))
+			widgets[e] = c
+			return c
+		}),
+	)
+	list.SetLocation(img.Rect(0, 0, 100, 100))
+	list.RequestRelayout()
+	renderList(list)
+
+	is.Equal(len(widgets), 3)
+	is.Equal(widgets["b"].GetWidget().Parent(), list.buttons[1].container.GetWidget())
+	is.Equal(widgets["b"].GetWidget().Rect.Dy(), list.buttons[1].GetWidget().Rect.Dy()-
+		list.computedParams.EntryTextPadding.Top-list.computedParams.EntryTextPadding.Bottom)
+
+	leftMouseButtonClick(list.buttons[1], t)
+	is.Equal(list.SelectedEntry(), "b")
+	is.Equal(list.buttons[1].computedParams.Image, list.computedParams.entrySelectedColor)
+
+	list.UpdateEntry("c")
+	is.Equal(widgets["c"].GetWidget().Parent(), list.buttons[2].container.GetWidget())
+}
+
+func TestList_EntryWidgetFunc_NoFace(t *testing.T) {
+	is := is.New(t)
+
+	list := NewList(newListOptsWithoutFace(t,
+		ListOpts.Entries([]any{"a"}),
+		ListOpts.EntryWidgetFunc(func(_ any) PreferredSizeLocateableWidget {
+			return NewContainer()
+		}),
+	)...)
+	list.Validate()
+
+	is.Equal(len(list.buttons), 1)
+}
+
+func TestList_EntryLabelFunc_NoFacePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+
+	list := NewList(newListOptsWithoutFace(t,
+		ListOpts.Entries([]any{"a"}),
+		ListOpts.EntryLabelFunc(func(e any) string {
+			return e.(string)
+		}),
+	)...)
+	list.Validate()
+}
+
+func TestList_EntryWidgetFunc_Virtualized(t *testing.T) {
+	is := is.New(t)
+
+	entries := make([]any, 100)
+	for i := range entries {
+		entries[i] = i
+	}
+	created := map[any]int{}
+	list := newList(t,
+		ListOpts.Entries(entries),
+		ListOpts.EntryWidgetFunc(func(e any) PreferredSizeLocateableWidget {
+			created[e]++
+			return NewText(TextOpts.Text(fmt.Sprint(e), loadFont(t), color.White))
+		}),
+		ListOpts.Virtualized(20),
+		ListOpts.HideHorizontalSlider(),
+	)
+	list.SetLocation(img.Rect(0, 0, 100, 100))
+	list.RequestRelayout()
+	renderList(list)
+
+	is.Equal(len(list.rows), 6)
+	is.Equal(list.rowContents[0].Children()[0].(*Text).Label, "0")
+	is.Equal(created[1], 1) // rows are not rebuilt on every layout
+
+	list.setScrollTop(0.5)
+	renderList(list)
+	first := list.rowIndices[0]
+	is.Equal(list.rowContents[0].Children()[0].(*Text).Label, strconv.Itoa(first))
+	is.Equal(len(list.rowContents[0].Children()), 1)
+
+	leftMouseButtonClick(list.rows[1], t)
+	is.Equal(list.SelectedEntry(), first+1)
+
+	list.UpdateEntry(first)
+	renderList(list)
+	is.Equal(created[first], 2)
+}
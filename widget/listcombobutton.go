@@ -324,6 +324,35 @@ func (l *ListComboButton) AddFocus(direction FocusDirection, focus Focuser) {
 
 /** Focuser Interface - End **/
 
+// HandlesDirection reports that the up and down arrow keys open the list and move between entries,
+// unless the default keys have been disabled.
+func (l *ListComboButton) HandlesDirection(direction FocusDirection) bool {
+	l.init.Do()
+	return !*l.computedParams.DisableDefaultKeys && (direction == FOCUS_NORTH || direction == FOCUS_SOUTH)
+}
+
+// Activate opens the list, or selects the focused entry if the list is already open.
+func (l *ListComboButton) Activate() {
+	l.init.Do()
+	if l.GetWidget().Disabled {
+		return
+	}
+	if l.ContentVisible() {
+		l.SelectFocused()
+	} else {
+		l.SetContentVisible(true)
+	}
+}
+
+// Cancel closes the list if it is open.
+func (l *ListComboButton) Cancel() bool {
+	if !l.ContentVisible() {
+		return false
+	}
+	l.SetContentVisible(false)
+	return true
+}
+
 func (l *ListComboButton) FocusNext() {
 	if l.list != nil {
 		l.SetContentVisible(true)
@@ -381,7 +410,8 @@ func (l *ListComboButton) Update(updObj *UpdateObject) {
 
 	if l.button.button.button.focused {
 		if !*l.computedParams.DisableDefaultKeys {
-			if input.KeyPressed(ebiten.KeyDown) || input.KeyPressed(ebiten.KeyUp) {
+			keymap := l.widget.Keymap()
+			if keymap.KeyPressed(KeyActionDown) || keymap.KeyPressed(KeyActionUp) {
 				l.SetContentVisible(true)
 			}
 		}
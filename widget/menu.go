@@ -0,0 +1,779 @@
+package widget
+
+import (
+	img "image"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/constantutil"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+type MenuParams struct {
+	BackgroundImage *image.NineSlice
+	Padding         *Insets
+	MinWidth        *int
+
+	ItemFace      *text.Face
+	ItemImage     *ButtonImage
+	ItemTextColor *ButtonTextColor
+	ItemPadding   *Insets
+	// ItemSpacing is the horizontal space between the icon, label, accelerator and submenu arrow of an item.
+	ItemSpacing *int
+
+	SeparatorImage   *image.NineSlice
+	SeparatorPadding *Insets
+
+	// CheckIcon is shown in front of checked items. If it is not set, "x" is shown instead.
+	CheckIcon *GraphicImage
+	// SubmenuIcon is shown behind items that open a submenu. If it is not set, ">" is shown instead.
+	SubmenuIcon *GraphicImage
+}
+
+// MenuItem is an entry of a Menu. Changes to an item are shown the next time its menu is opened.
+type MenuItem struct {
+	Label string
+	// Icon is shown in front of the label.
+	Icon *GraphicImage
+	// Accelerator is the text of the shortcut that activates the item, for example "Ctrl+S".
+	// It is only shown, the shortcut itself has to be handled elsewhere.
+	Accelerator string
+
+	// Checkable items toggle Checked when they are activated.
+	Checkable bool
+	Checked   bool
+	Disabled  bool
+
+	// Separator makes the item a line that separates groups of items. All other fields are ignored.
+	Separator bool
+
+	// Submenu is opened when the cursor hovers over the item or the item is activated.
+	Submenu *Menu
+
+	// ActivatedHandler is called when the item is activated.
+	ActivatedHandler MenuItemActivatedHandlerFunc
+}
+
+type Menu struct {
+	definedParams  MenuParams
+	computedParams MenuParams
+
+	// ItemActivatedEvent fires with *MenuItemActivatedEventArgs when an item of the menu or one of its submenus
+	// is activated.
+	ItemActivatedEvent *event.Event
+	// ClosedEvent fires with *MenuClosedEventArgs when the menu is closed.
+	ClosedEvent *event.Event
+
+	items      []*MenuItem
+	widgetOpts []WidgetOpt
+
+	init      *MultiOnce
+	container *Container
+	buttons   []*Button
+	texts     [][]*Text
+
+	owner       *Widget
+	window      *Window
+	opened      bool
+	parent      *Menu
+	submenu     *Menu
+	bar         *MenuBar
+	highlighted int
+}
+
+type MenuOpt func(m *Menu)
+
+type MenuItemActivatedEventArgs struct {
+	// Menu is the menu that contains Item.
+	Menu *Menu
+	Item *MenuItem
+}
+
+type MenuItemActivatedHandlerFunc func(args *MenuItemActivatedEventArgs)
+
+type MenuClosedEventArgs struct {
+	Menu *Menu
+}
+
+type MenuClosedHandlerFunc func(args *MenuClosedEventArgs)
+
+type MenuOptions struct {
+}
+
+var MenuOpts MenuOptions
+
+// NewMenu constructs a new Menu. A Menu is shown in a window of its own, either with Open, as the
+// popup menu of a widget (see WidgetOptions.PopupMenu), as a menu of a MenuBar or as a submenu.
+// The windows of menus are ephemeral, so they are closed by the UI like tooltips. Menus also close
+// when an item is activated, when Escape is pressed and when the mouse is pressed outside of them.
+func NewMenu(opts ...MenuOpt) *Menu {
+	m := &Menu{
+		ItemActivatedEvent: &event.Event{},
+		ClosedEvent:        &event.Event{},
+
+		init:        &MultiOnce{},
+		highlighted: -1,
+	}
+
+	m.init.Append(m.createWidget)
+
+	for _, o := range opts {
+		o(m)
+	}
+
+	return m
+}
+
+func (o MenuOptions) WidgetOpts(opts ...WidgetOpt) MenuOpt {
+	return func(m *Menu) {
+		m.widgetOpts = append(m.widgetOpts, opts...)
+	}
+}
+
+func (o MenuOptions) Items(items ...*MenuItem) MenuOpt {
+	return func(m *Menu) {
+		m.items = append(m.items, items...)
+	}
+}
+
+func (o MenuOptions) BackgroundImage(i *image.NineSlice) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.BackgroundImage = i
+	}
+}
+
+func (o MenuOptions) Padding(i *Insets) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.Padding = i
+	}
+}
+
+func (o MenuOptions) MinWidth(w int) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.MinWidth = &w
+	}
+}
+
+func (o MenuOptions) ItemFace(face *text.Face) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.ItemFace = face
+	}
+}
+
+func (o MenuOptions) ItemImage(i *ButtonImage) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.ItemImage = i
+	}
+}
+
+func (o MenuOptions) ItemTextColor(c *ButtonTextColor) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.ItemTextColor = c
+	}
+}
+
+func (o MenuOptions) ItemPadding(i *Insets) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.ItemPadding = i
+	}
+}
+
+func (o MenuOptions) ItemSpacing(s int) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.ItemSpacing = &s
+	}
+}
+
+func (o MenuOptions) Separator(i *image.NineSlice, padding *Insets) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.SeparatorImage = i
+		m.definedParams.SeparatorPadding = padding
+	}
+}
+
+func (o MenuOptions) Icons(check *GraphicImage, submenu *GraphicImage) MenuOpt {
+	return func(m *Menu) {
+		m.definedParams.CheckIcon = check
+		m.definedParams.SubmenuIcon = submenu
+	}
+}
+
+func (o MenuOptions) ItemActivatedHandler(f MenuItemActivatedHandlerFunc) MenuOpt {
+	return func(m *Menu) {
+		m.ItemActivatedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*MenuItemActivatedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+func (o MenuOptions) ClosedHandler(f MenuClosedHandlerFunc) MenuOpt {
+	return func(m *Menu) {
+		m.ClosedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*MenuClosedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+func (m *Menu) Validate() {
+	m.init.Do()
+	m.populateComputedParams()
+
+	if m.computedParams.ItemFace == nil {
+		panic("Menu: ItemFace is required.")
+	}
+	if m.computedParams.ItemTextColor == nil || m.computedParams.ItemTextColor.Idle == nil {
+		panic("Menu: ItemTextColor.Idle is required.")
+	}
+	if m.computedParams.ItemImage == nil {
+		panic("Menu: ItemImage is required.")
+	}
+
+	m.initWidget()
+}
+
+func (m *Menu) populateComputedParams() {
+	params := MenuParams{}
+
+	theme := m.GetWidget().GetTheme()
+
+	// Set theme values
+	if theme != nil {
+		params.ItemFace = theme.DefaultFace
+		if theme.DefaultTextColor != nil {
+			params.ItemTextColor = &ButtonTextColor{Idle: theme.DefaultTextColor}
+		}
+		if theme.MenuTheme != nil {
+			params.BackgroundImage = theme.MenuTheme.BackgroundImage
+			params.Padding = theme.MenuTheme.Padding
+			params.MinWidth = theme.MenuTheme.MinWidth
+			if theme.MenuTheme.ItemFace != nil {
+				params.ItemFace = theme.MenuTheme.ItemFace
+			}
+			params.ItemImage = theme.MenuTheme.ItemImage
+			if theme.MenuTheme.ItemTextColor != nil {
+				params.ItemTextColor = theme.MenuTheme.ItemTextColor
+			}
+			params.ItemPadding = theme.MenuTheme.ItemPadding
+			params.ItemSpacing = theme.MenuTheme.ItemSpacing
+			params.SeparatorImage = theme.MenuTheme.SeparatorImage
+			params.SeparatorPadding = theme.MenuTheme.SeparatorPadding
+			params.CheckIcon = theme.MenuTheme.CheckIcon
+			params.SubmenuIcon = theme.MenuTheme.SubmenuIcon
+		}
+	}
+
+	// Set definedParam values
+	if m.definedParams.BackgroundImage != nil {
+		params.BackgroundImage = m.definedParams.BackgroundImage
+	}
+	if m.definedParams.Padding != nil {
+		params.Padding = m.definedParams.Padding
+	}
+	if m.definedParams.MinWidth != nil {
+		params.MinWidth = m.definedParams.MinWidth
+	}
+	if m.definedParams.ItemFace != nil {
+		params.ItemFace = m.definedParams.ItemFace
+	}
+	if m.definedParams.ItemImage != nil {
+		params.ItemImage = m.definedParams.ItemImage
+	}
+	if m.definedParams.ItemTextColor != nil {
+		params.ItemTextColor = m.definedParams.ItemTextColor
+	}
+	if m.definedParams.ItemPadding != nil {
+		params.ItemPadding = m.definedParams.ItemPadding
+	}
+	if m.definedParams.ItemSpacing != nil {
+		params.ItemSpacing = m.definedParams.ItemSpacing
+	}
+	if m.definedParams.SeparatorImage != nil {
+		params.SeparatorImage = m.definedParams.SeparatorImage
+	}
+	if m.definedParams.SeparatorPadding != nil {
+		params.SeparatorPadding = m.definedParams.SeparatorPadding
+	}
+	if m.definedParams.CheckIcon != nil {
+		params.CheckIcon = m.definedParams.CheckIcon
+	}
+	if m.definedParams.SubmenuIcon != nil {
+		params.SubmenuIcon = m.definedParams.SubmenuIcon
+	}
+
+	// Set defaults
+	if params.Padding == nil {
+		params.Padding = &Insets{}
+	}
+	if params.MinWidth == nil {
+		params.MinWidth = constantutil.ConstantToPointer(0)
+	}
+	if params.ItemPadding == nil {
+		params.ItemPadding = &Insets{}
+	}
+	if params.ItemSpacing == nil {
+		params.ItemSpacing = constantutil.ConstantToPointer(10)
+	}
+	if params.SeparatorPadding == nil {
+		params.SeparatorPadding = &Insets{}
+	}
+
+	m.computedParams = params
+}
+
+func (m *Menu) GetWidget() *Widget {
+	m.init.Do()
+	return m.container.GetWidget()
+}
+
+func (m *Menu) PreferredSize() (int, int) {
+	m.init.Do()
+	return m.container.PreferredSize()
+}
+
+func (m *Menu) SetLocation(rect img.Rectangle) {
+	m.init.Do()
+	m.container.SetLocation(rect)
+}
+
+func (m *Menu) RequestRelayout() {
+	m.init.Do()
+	m.container.RequestRelayout()
+}
+
+func (m *Menu) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
+	m.init.Do()
+	m.container.SetupInputLayer(def)
+}
+
+func (m *Menu) Render(screen *ebiten.Image) {
+	m.init.Do()
+	m.container.Render(screen)
+}
+
+func (m *Menu) Update(updObj *UpdateObject) {
+	m.init.Do()
+	m.container.Update(updObj)
+
+	if !m.opened {
+		return
+	}
+	if m.parent == nil && m.pressedOutside() {
+		m.Close()
+		return
+	}
+	if m.submenu == nil {
+		m.handleKeys()
+	}
+}
+
+// Items returns the items of the menu.
+func (m *Menu) Items() []*MenuItem {
+	return m.items
+}
+
+// SetItems replaces the items of the menu.
+func (m *Menu) SetItems(items ...*MenuItem) {
+	m.init.Do()
+	m.items = items
+	if m.container.IsValidated() {
+		m.Validate()
+	}
+}
+
+// Open shows the menu with its top left corner at location. Events of the menu window are fired
+// through owner, which must be part of the UI.
+func (m *Menu) Open(owner HasWidget, location img.Point) {
+	m.openRoot(owner.GetWidget(), location)
+}
+
+// Close closes the menu and its open submenus.
+func (m *Menu) Close() {
+	if !m.opened {
+		return
+	}
+	m.opened = false
+
+	m.closeSubmenu()
+	m.setHighlighted(-1)
+	m.owner.FirePopupEvent(m.window, false)
+
+	if m.parent != nil && m.parent.submenu == m {
+		m.parent.submenu = nil
+	}
+	if m.bar != nil {
+		m.bar.menuClosed(m)
+	}
+	m.ClosedEvent.Fire(&MenuClosedEventArgs{
+		Menu: m,
+	})
+}
+
+// IsOpen reports whether the menu is currently shown.
+func (m *Menu) IsOpen() bool {
+	return m.opened
+}
+
+// openRoot opens the menu on its own, not as a submenu or a menu of a MenuBar.
+func (m *Menu) openRoot(owner *Widget, location img.Point) {
+	m.Close()
+	m.parent = nil
+	m.bar = nil
+	m.open(owner, location)
+}
+
+func (m *Menu) open(owner *Widget, location img.Point) {
+	m.init.Do()
+	m.owner = owner
+	m.highlighted = -1
+
+	if m.window == nil {
+		contents := NewContainer(ContainerOpts.Layout(NewAnchorLayout()))
+		contents.AddChild(m)
+		m.window = NewWindow(
+			WindowOpts.Contents(contents),
+			WindowOpts.CloseMode(NONE),
+			WindowOpts.ClosedHandler(func(_ *WindowClosedEventArgs) {
+				// The UI closed the window, for example because another window was opened.
+				m.Close()
+			}),
+		)
+		m.window.popup = true
+	}
+
+	// The menu takes its theme from the widget that opened it.
+	m.window.container.GetWidget().parent = owner
+	m.window.container.Validate()
+	w, h := m.window.container.PreferredSize()
+	m.window.SetLocation(img.Rect(0, 0, w, h).Add(location))
+
+	m.opened = true
+	owner.FirePopupEvent(m.window, true)
+}
+
+func (m *Menu) root() *Menu {
+	r := m
+	for r.parent != nil {
+		r = r.parent
+	}
+	return r
+}
+
+// pressedOutside reports whether a mouse button has just been pressed outside of the menu, its
+// submenus and its menu bar.
+func (m *Menu) pressedOutside() bool {
+	if !input.MouseButtonJustPressed(ebiten.MouseButtonLeft) && !input.MouseButtonJustPressed(ebiten.MouseButtonRight) {
+		return false
+	}
+	p := img.Pt(input.CursorPosition())
+	if m.bar != nil && p.In(m.bar.GetWidget().Rect) {
+		return false
+	}
+	for s := m; s != nil; s = s.submenu {
+		if p.In(s.GetWidget().Rect) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Menu) handleKeys() {
+	keymap := m.GetWidget().Keymap()
+	switch {
+	case keymap.KeyJustPressed(KeyActionDown):
+		m.moveHighlight(1)
+	case keymap.KeyJustPressed(KeyActionUp):
+		m.moveHighlight(-1)
+	case keymap.KeyJustPressed(KeyActionRight):
+		if i := m.highlighted; i >= 0 && m.items[i].Submenu != nil && !m.items[i].Disabled {
+			m.openSubmenu(i)
+			m.submenu.moveHighlight(1)
+		} else if r := m.root(); r.bar != nil {
+			r.bar.openAdjacent(r, 1)
+		}
+	case keymap.KeyJustPressed(KeyActionLeft):
+		if m.parent != nil {
+			m.Close()
+		} else if m.bar != nil {
+			m.bar.openAdjacent(m, -1)
+		}
+	case keymap.KeyJustPressed(KeyActionActivate):
+		if m.highlighted >= 0 {
+			m.activate(m.highlighted)
+		}
+	case keymap.KeyJustPressed(KeyActionCancel):
+		m.Close()
+	}
+}
+
+// moveHighlight highlights the next item in direction that can be activated.
+func (m *Menu) moveHighlight(direction int) {
+	n := len(m.items)
+	i := m.highlighted
+	if i < 0 && direction < 0 {
+		i = 0
+	}
+	for range n {
+		i = (i + direction + n) % n
+		if item := m.items[i]; !item.Separator && !item.Disabled {
+			m.setHighlighted(i)
+			return
+		}
+	}
+}
+
+func (m *Menu) setHighlighted(index int) {
+	m.highlighted = index
+	for i, b := range m.buttons {
+		if b == nil {
+			continue
+		}
+		b.focused = i == index
+		c := m.computedParams.ItemTextColor.Idle
+		switch {
+		case m.items[i].Disabled && m.computedParams.ItemTextColor.Disabled != nil:
+			c = m.computedParams.ItemTextColor.Disabled
+		case b.focused && m.computedParams.ItemTextColor.Hover != nil:
+			c = m.computedParams.ItemTextColor.Hover
+		}
+		for _, t := range m.texts[i] {
+			t.SetColor(c)
+		}
+	}
+}
+
+// activate activates the item at index. Submenus are opened, other items close all menus.
+func (m *Menu) activate(index int) {
+	item := m.items[index]
+	if item.Separator || item.Disabled {
+		return
+	}
+	if item.Submenu != nil {
+		m.openSubmenu(index)
+		return
+	}
+	if item.Checkable {
+		item.Checked = !item.Checked
+	}
+
+	args := &MenuItemActivatedEventArgs{
+		Menu: m,
+		Item: item,
+	}
+	if item.ActivatedHandler != nil {
+		item.ActivatedHandler(args)
+	}
+	for s := m; s != nil; s = s.parent {
+		s.ItemActivatedEvent.Fire(args)
+	}
+	r := m.root()
+	if r.bar != nil {
+		r.bar.ItemActivatedEvent.Fire(args)
+	}
+	r.Close()
+}
+
+func (m *Menu) openSubmenu(index int) {
+	sub := m.items[index].Submenu
+	if m.submenu == sub {
+		return
+	}
+	m.closeSubmenu()
+	sub.Close()
+	sub.parent = m
+	sub.bar = nil
+	m.submenu = sub
+
+	sub.open(m.owner, img.Pt(m.GetWidget().Rect.Max.X, m.buttons[index].GetWidget().Rect.Min.Y))
+
+	// Line up the first item of the submenu with the item that opened it.
+	rect := sub.window.container.GetWidget().Rect
+	sub.window.SetLocation(rect.Sub(img.Pt(0, sub.computedParams.Padding.Top)))
+}
+
+func (m *Menu) closeSubmenu() {
+	if m.submenu != nil {
+		m.submenu.Close()
+		m.submenu = nil
+	}
+}
+
+func (m *Menu) createWidget() {
+	m.container = NewContainer(
+		ContainerOpts.WidgetOpts(WidgetOpts.LayoutData(AnchorLayoutData{
+			StretchHorizontal: true,
+			StretchVertical:   true,
+		})),
+		ContainerOpts.WidgetOpts(m.widgetOpts...),
+	)
+	m.widgetOpts = nil
+}
+
+func (m *Menu) initWidget() {
+	m.container.RemoveChildren()
+	m.container.SetBackgroundImage(m.computedParams.BackgroundImage)
+	m.container.layout = NewRowLayout(
+		RowLayoutOpts.Direction(DirectionVertical),
+		RowLayoutOpts.Padding(m.computedParams.Padding),
+	)
+	m.container.GetWidget().MinWidth = *m.computedParams.MinWidth
+
+	// Icons, labels and accelerators are laid out in columns that are the same in every item.
+	iconWidth, hasSubmenus := 0, false
+	for _, item := range m.items {
+		if item.Icon != nil && item.Icon.Idle != nil {
+			iconWidth = max(iconWidth, item.Icon.Idle.Bounds().Dx())
+		}
+		if item.Checkable {
+			if icon := m.computedParams.CheckIcon; icon != nil && icon.Idle != nil {
+				iconWidth = max(iconWidth, icon.Idle.Bounds().Dx())
+			} else {
+				iconWidth = max(iconWidth, m.textWidth("x"))
+			}
+		}
+		hasSubmenus = hasSubmenus || item.Submenu != nil
+	}
+	acceleratorWidth := 0
+	for _, item := range m.items {
+		acceleratorWidth = max(acceleratorWidth, m.textWidth(item.Accelerator))
+	}
+
+	m.buttons = make([]*Button, len(m.items))
+	m.texts = make([][]*Text, len(m.items))
+	for i, item := range m.items {
+		if item.Separator {
+			m.container.AddChild(m.createSeparator())
+			continue
+		}
+		m.buttons[i] = m.createItem(i, iconWidth, acceleratorWidth, hasSubmenus)
+		m.container.AddChild(m.buttons[i])
+	}
+
+	m.container.Validate()
+	m.setHighlighted(m.highlighted)
+}
+
+func (m *Menu) textWidth(s string) int {
+	w, _ := text.Measure(s, *m.computedParams.ItemFace, 0)
+	return int(w)
+}
+
+func (m *Menu) createSeparator() PreferredSizeLocateableWidget {
+	c := NewContainer(
+		ContainerOpts.WidgetOpts(WidgetOpts.LayoutData(RowLayoutData{Stretch: true})),
+		ContainerOpts.Layout(NewAnchorLayout(AnchorLayoutOpts.Padding(m.computedParams.SeparatorPadding))),
+	)
+	if m.computedParams.SeparatorImage != nil {
+		c.AddChild(NewGraphic(
+			GraphicOpts.WidgetOpts(WidgetOpts.LayoutData(AnchorLayoutData{
+				StretchHorizontal: true,
+				VerticalPosition:  AnchorLayoutPositionCenter,
+			})),
+			GraphicOpts.ImageNineSlice(m.computedParams.SeparatorImage),
+		))
+	}
+	return c
+}
+
+func (m *Menu) createItem(index int, iconWidth int, acceleratorWidth int, hasSubmenus bool) *Button {
+	item := m.items[index]
+	face := m.computedParams.ItemFace
+
+	columns := 3
+	stretch := []bool{false, true, false}
+	if hasSubmenus {
+		columns++
+		stretch = append(stretch, false)
+	}
+	row := NewContainer(
+		ContainerOpts.Layout(NewGridLayout(
+			GridLayoutOpts.Columns(columns),
+			GridLayoutOpts.Stretch(stretch, []bool{true}),
+			GridLayoutOpts.Spacing(*m.computedParams.ItemSpacing, 0),
+		)),
+	)
+
+	icon := NewContainer(
+		ContainerOpts.WidgetOpts(WidgetOpts.MinSize(iconWidth, 0)),
+		ContainerOpts.Layout(NewAnchorLayout()),
+	)
+	center := WidgetOpts.LayoutData(AnchorLayoutData{
+		HorizontalPosition: AnchorLayoutPositionCenter,
+		VerticalPosition:   AnchorLayoutPositionCenter,
+	})
+	var texts []*Text
+	switch {
+	case item.Checkable && item.Checked && m.computedParams.CheckIcon != nil:
+		icon.AddChild(NewGraphic(GraphicOpts.WidgetOpts(center), GraphicOpts.Images(m.computedParams.CheckIcon)))
+	case item.Checkable && item.Checked:
+		t := NewText(TextOpts.WidgetOpts(center), TextOpts.Text("x", face, m.computedParams.ItemTextColor.Idle))
+		texts = append(texts, t)
+		icon.AddChild(t)
+	case item.Icon != nil:
+		icon.AddChild(NewGraphic(GraphicOpts.WidgetOpts(center), GraphicOpts.Images(item.Icon)))
+	}
+	row.AddChild(icon)
+
+	label := NewText(
+		TextOpts.Text(item.Label, face, m.computedParams.ItemTextColor.Idle),
+		TextOpts.Position(TextPositionStart, TextPositionCenter),
+	)
+	accelerator := NewText(
+		TextOpts.WidgetOpts(WidgetOpts.MinSize(acceleratorWidth, 0)),
+		TextOpts.Text(item.Accelerator, face, m.computedParams.ItemTextColor.Idle),
+		TextOpts.Position(TextPositionEnd, TextPositionCenter),
+	)
+	texts = append(texts, label, accelerator)
+	row.AddChild(label, accelerator)
+
+	if hasSubmenus {
+		arrow := NewContainer(ContainerOpts.Layout(NewAnchorLayout()))
+		switch {
+		case item.Submenu != nil && m.computedParams.SubmenuIcon != nil:
+			arrow.AddChild(NewGraphic(GraphicOpts.WidgetOpts(center), GraphicOpts.Images(m.computedParams.SubmenuIcon)))
+		case item.Submenu != nil:
+			t := NewText(TextOpts.WidgetOpts(center), TextOpts.Text(">", face, m.computedParams.ItemTextColor.Idle))
+			texts = append(texts, t)
+			arrow.AddChild(t)
+		default:
+			arrow.GetWidget().MinWidth = m.textWidth(">")
+			if icon := m.computedParams.SubmenuIcon; icon != nil && icon.Idle != nil {
+				arrow.GetWidget().MinWidth = icon.Idle.Bounds().Dx()
+			}
+		}
+		row.AddChild(arrow)
+	}
+	m.texts[index] = texts
+
+	button := NewButton(
+		ButtonOpts.WidgetOpts(
+			WidgetOpts.LayoutData(RowLayoutData{Stretch: true}),
+			WidgetOpts.CursorEnterHandler(func(_ *WidgetCursorEnterEventArgs) {
+				if item.Disabled {
+					return
+				}
+				m.setHighlighted(index)
+				if item.Submenu != nil {
+					m.openSubmenu(index)
+				} else {
+					m.closeSubmenu()
+				}
+			}),
+		),
+		ButtonOpts.Image(m.computedParams.ItemImage),
+		ButtonOpts.TextPadding(m.computedParams.ItemPadding),
+		ButtonOpts.Content(row),
+		ButtonOpts.DisableDefaultKeys(),
+		ButtonOpts.ClickedHandler(func(_ *ButtonClickedEventArgs) {
+			m.activate(index)
+		}),
+	)
+	button.GetWidget().Disabled = item.Disabled
+	return button
+}
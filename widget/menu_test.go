@@ -0,0 +1,234 @@
+package widget
+
+import (
+	img "image"
+	"image/color"
+	"testing"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestMenu_Open(t *testing.T) {
+	is := is.New(t)
+
+	owner := newSimpleWidget(10, 10, nil)
+	var popups []*WidgetPopupEventArgs
+	owner.GetWidget().PopupEvent.AddHandler(func(args interface{}) {
+		popups = append(popups, args.(*WidgetPopupEventArgs))
+	})
+	event.ExecuteDeferred()
+
+	m := newMenu(t,
+		&MenuItem{Label: "Open", Accelerator: "Ctrl+O"},
+		&MenuItem{Separator: true},
+		&MenuItem{Label: "Close", Disabled: true},
+	)
+	m.Open(owner, img.Pt(20, 30))
+	event.ExecuteDeferred()
+
+	is.True(m.IsOpen())
+	is.Equal(len(popups), 1)
+	is.True(popups[0].Show)
+	is.Equal(popups[0].Window.container.GetWidget().Rect.Min, img.Pt(20, 30))
+	is.True(popups[0].Window.popup)
+
+	is.True(m.buttons[1] == nil)
+	is.True(m.buttons[2].GetWidget().Disabled)
+	is.Equal(m.texts[0][1].Label, "Ctrl+O")
+
+	m.Close()
+	event.ExecuteDeferred()
+	is.True(!m.IsOpen())
+	is.True(!popups[1].Show)
+}
+
+func TestMenu_Activate(t *testing.T) {
+	is := is.New(t)
+
+	var activated []*MenuItemActivatedEventArgs
+	check := &MenuItem{Label: "Grid", Checkable: true, ActivatedHandler: func(args *MenuItemActivatedEventArgs) {
+		activated = append(activated, args)
+	}}
+	m := newMenu(t, check)
+	var events int
+	m.ItemActivatedEvent.AddHandler(func(_ interface{}) {
+		events++
+	})
+	m.Open(newSimpleWidget(10, 10, nil), img.Point{})
+	event.ExecuteDeferred()
+
+	leftMouseButtonClick(m.buttons[0], t)
+	is.True(check.Checked)
+	is.Equal(len(activated), 1)
+	is.Equal(activated[0].Item, check)
+	is.Equal(events, 1)
+	is.True(!m.IsOpen())
+
+	// The check mark is shown the next time the menu opens.
+	m.Open(newSimpleWidget(10, 10, nil), img.Point{})
+	is.Equal(m.texts[0][0].Label, "x")
+}
+
+func TestMenu_Keys(t *testing.T) {
+	is := is.New(t)
+
+	source := input.NewFakeSource()
+	input.SetSource(source)
+	t.Cleanup(func() {
+		input.SetSource(nil)
+	})
+
+	var activated *MenuItem
+	sub := newMenu(t, &MenuItem{Label: "Recent"})
+	m := newMenu(t,
+		&MenuItem{Label: "New", Disabled: true},
+		&MenuItem{Separator: true},
+		&MenuItem{Label: "Open"},
+		&MenuItem{Label: "Open Recent", Submenu: sub},
+	)
+	m.ItemActivatedEvent.AddHandler(func(args interface{}) {
+		activated = args.(*MenuItemActivatedEventArgs).Item
+	})
+	m.Open(newSimpleWidget(10, 10, nil), img.Point{})
+	render(m.window.container, t)
+
+	pressKey := func(menu *Menu, k ebiten.Key) {
+		source.PressKey(k)
+		input.Update()
+		menu.Update(&UpdateObject{})
+		source.ReleaseKey(k)
+		input.Update()
+		event.ExecuteDeferred()
+	}
+
+	// Disabled items and separators are skipped.
+	pressKey(m, ebiten.KeyDown)
+	is.Equal(m.highlighted, 2)
+	is.True(m.buttons[2].focused)
+	pressKey(m, ebiten.KeyDown)
+	is.Equal(m.highlighted, 3)
+	pressKey(m, ebiten.KeyDown)
+	is.Equal(m.highlighted, 2)
+	pressKey(m, ebiten.KeyUp)
+
+	pressKey(m, ebiten.KeyRight)
+	is.True(sub.IsOpen())
+	is.Equal(sub.highlighted, 0)
+	is.Equal(sub.window.container.GetWidget().Rect.Min.X, m.GetWidget().Rect.Max.X)
+
+	// Only the innermost menu handles keys.
+	pressKey(m, ebiten.KeyLeft)
+	is.True(sub.IsOpen())
+	pressKey(sub, ebiten.KeyLeft)
+	is.True(!sub.IsOpen())
+	is.True(m.IsOpen())
+
+	pressKey(m, ebiten.KeyRight)
+	pressKey(sub, ebiten.KeyEnter)
+	is.Equal(activated.Label, "Recent")
+	is.True(!sub.IsOpen())
+	is.True(!m.IsOpen())
+}
+
+func TestMenu_Hover(t *testing.T) {
+	is := is.New(t)
+
+	sub := newMenu(t, &MenuItem{Label: "Recent"})
+	m := newMenu(t,
+		&MenuItem{Label: "Open"},
+		&MenuItem{Label: "Open Recent", Submenu: sub},
+	)
+	m.Open(newSimpleWidget(10, 10, nil), img.Point{})
+	render(m.window.container, t)
+
+	cursorEnter(m.buttons[1], t)
+	is.True(sub.IsOpen())
+	is.Equal(m.highlighted, 1)
+
+	cursorEnter(m.buttons[0], t)
+	is.True(!sub.IsOpen())
+	is.Equal(m.highlighted, 0)
+}
+
+func TestMenu_ClosedByUI(t *testing.T) {
+	is := is.New(t)
+
+	var closed int
+	m := newMenu(t, &MenuItem{Label: "Open"})
+	m.ClosedEvent.AddHandler(func(_ interface{}) {
+		closed++
+	})
+	m.Open(newSimpleWidget(10, 10, nil), img.Point{})
+	event.ExecuteDeferred()
+
+	// The UI fires the closed event of ephemeral windows it closes.
+	m.window.ClosedEvent.Fire(&WindowClosedEventArgs{Window: m.window})
+	event.ExecuteDeferred()
+	is.True(!m.IsOpen())
+	is.Equal(closed, 1)
+}
+
+func TestMenuBar(t *testing.T) {
+	is := is.New(t)
+
+	file := newMenu(t, &MenuItem{Label: "Open"})
+	edit := newMenu(t, &MenuItem{Label: "Undo"})
+	bar := NewMenuBar(
+		MenuBarOpts.Menu("File", file),
+		MenuBarOpts.Menu("Edit", edit),
+		MenuBarOpts.ButtonFace(loadFont(t)),
+		MenuBarOpts.ButtonTextColor(&ButtonTextColor{Idle: color.White}),
+		MenuBarOpts.ButtonImage(&ButtonImage{
+			Idle:    newNineSliceEmpty(t),
+			Pressed: newNineSliceEmpty(t),
+		}),
+	)
+	bar.SetLocation(img.Rect(0, 0, 200, 30))
+	render(bar, t)
+
+	// Hovering over titles does nothing until a menu is open.
+	cursorEnter(bar.buttons[1], t)
+	is.Equal(bar.OpenMenuIndex(), -1)
+
+	leftMouseButtonPress(bar.buttons[0], t)
+	is.True(file.IsOpen())
+	is.Equal(file.window.container.GetWidget().Rect.Min, img.Pt(0, 30))
+
+	cursorEnter(bar.buttons[1], t)
+	is.True(!file.IsOpen())
+	is.True(edit.IsOpen())
+	is.Equal(bar.OpenMenuIndex(), 1)
+
+	bar.openAdjacent(edit, 1)
+	is.True(file.IsOpen())
+	is.Equal(file.highlighted, 0)
+
+	leftMouseButtonPress(bar.buttons[0], t)
+	is.True(!file.IsOpen())
+	is.Equal(bar.OpenMenuIndex(), -1)
+}
+
+func newMenu(t *testing.T, items ...*MenuItem) *Menu {
+	t.Helper()
+
+	return NewMenu(
+		MenuOpts.Items(items...),
+		MenuOpts.ItemFace(loadFont(t)),
+		MenuOpts.ItemTextColor(&ButtonTextColor{Idle: color.White}),
+		MenuOpts.ItemImage(&ButtonImage{
+			Idle:    newNineSliceEmpty(t),
+			Pressed: newNineSliceEmpty(t),
+		}),
+	)
+}
+
+func cursorEnter(w HasWidget, t *testing.T) {
+	t.Helper()
+	w.GetWidget().CursorEnterEvent.Fire(&WidgetCursorEnterEventArgs{
+		Widget: w.GetWidget(),
+	})
+	event.ExecuteDeferred()
+}
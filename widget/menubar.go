@@ -0,0 +1,349 @@
+package widget
+
+import (
+	img "image"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/constantutil"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+type MenuBarParams struct {
+	BackgroundImage *image.NineSlice
+	Padding         *Insets
+	Spacing         *int
+
+	ButtonFace      *text.Face
+	ButtonImage     *ButtonImage
+	ButtonTextColor *ButtonTextColor
+	ButtonPadding   *Insets
+}
+
+type MenuBar struct {
+	definedParams  MenuBarParams
+	computedParams MenuBarParams
+
+	// ItemActivatedEvent fires with *MenuItemActivatedEventArgs when an item of any of the menus is activated.
+	ItemActivatedEvent *event.Event
+
+	widgetOpts []WidgetOpt
+	titles     []string
+	menus      []*Menu
+
+	init      *MultiOnce
+	container *Container
+	buttons   []*Button
+	openIndex int
+}
+
+type MenuBarOpt func(b *MenuBar)
+
+type MenuBarOptions struct {
+}
+
+var MenuBarOpts MenuBarOptions
+
+// NewMenuBar constructs a new MenuBar. A MenuBar is a row of titles that open a Menu below them.
+// While a menu is open, hovering over another title opens its menu instead, and Left and Right
+// move between the menus.
+func NewMenuBar(opts ...MenuBarOpt) *MenuBar {
+	b := &MenuBar{
+		ItemActivatedEvent: &event.Event{},
+
+		init:      &MultiOnce{},
+		openIndex: -1,
+	}
+
+	b.init.Append(b.createWidget)
+
+	for _, o := range opts {
+		o(b)
+	}
+
+	return b
+}
+
+func (o MenuBarOptions) WidgetOpts(opts ...WidgetOpt) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.widgetOpts = append(b.widgetOpts, opts...)
+	}
+}
+
+// Menu adds a title to the menu bar that opens m.
+func (o MenuBarOptions) Menu(title string, m *Menu) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.titles = append(b.titles, title)
+		b.menus = append(b.menus, m)
+	}
+}
+
+func (o MenuBarOptions) BackgroundImage(i *image.NineSlice) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.definedParams.BackgroundImage = i
+	}
+}
+
+func (o MenuBarOptions) Padding(i *Insets) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.definedParams.Padding = i
+	}
+}
+
+func (o MenuBarOptions) Spacing(s int) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.definedParams.Spacing = &s
+	}
+}
+
+func (o MenuBarOptions) ButtonFace(face *text.Face) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.definedParams.ButtonFace = face
+	}
+}
+
+func (o MenuBarOptions) ButtonImage(i *ButtonImage) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.definedParams.ButtonImage = i
+	}
+}
+
+func (o MenuBarOptions) ButtonTextColor(c *ButtonTextColor) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.definedParams.ButtonTextColor = c
+	}
+}
+
+func (o MenuBarOptions) ButtonPadding(i *Insets) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.definedParams.ButtonPadding = i
+	}
+}
+
+func (o MenuBarOptions) ItemActivatedHandler(f MenuItemActivatedHandlerFunc) MenuBarOpt {
+	return func(b *MenuBar) {
+		b.ItemActivatedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*MenuItemActivatedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+func (b *MenuBar) Validate() {
+	b.init.Do()
+	b.populateComputedParams()
+
+	if b.computedParams.ButtonFace == nil {
+		panic("MenuBar: ButtonFace is required.")
+	}
+	if b.computedParams.ButtonTextColor == nil || b.computedParams.ButtonTextColor.Idle == nil {
+		panic("MenuBar: ButtonTextColor.Idle is required.")
+	}
+	if b.computedParams.ButtonImage == nil {
+		panic("MenuBar: ButtonImage is required.")
+	}
+
+	b.initWidget()
+}
+
+func (b *MenuBar) populateComputedParams() {
+	params := MenuBarParams{}
+
+	theme := b.GetWidget().GetTheme()
+
+	// Set theme values
+	if theme != nil {
+		params.ButtonFace = theme.DefaultFace
+		if theme.DefaultTextColor != nil {
+			params.ButtonTextColor = &ButtonTextColor{Idle: theme.DefaultTextColor}
+		}
+		if theme.MenuBarTheme != nil {
+			params.BackgroundImage = theme.MenuBarTheme.BackgroundImage
+			params.Padding = theme.MenuBarTheme.Padding
+			params.Spacing = theme.MenuBarTheme.Spacing
+			if theme.MenuBarTheme.ButtonFace != nil {
+				params.ButtonFace = theme.MenuBarTheme.ButtonFace
+			}
+			params.ButtonImage = theme.MenuBarTheme.ButtonImage
+			if theme.MenuBarTheme.ButtonTextColor != nil {
+				params.ButtonTextColor = theme.MenuBarTheme.ButtonTextColor
+			}
+			params.ButtonPadding = theme.MenuBarTheme.ButtonPadding
+		}
+	}
+
+	// Set definedParam values
+	if b.definedParams.BackgroundImage != nil {
+		params.BackgroundImage = b.definedParams.BackgroundImage
+	}
+	if b.definedParams.Padding != nil {
+		params.Padding = b.definedParams.Padding
+	}
+	if b.definedParams.Spacing != nil {
+		params.Spacing = b.definedParams.Spacing
+	}
+	if b.definedParams.ButtonFace != nil {
+		params.ButtonFace = b.definedParams.ButtonFace
+	}
+	if b.definedParams.ButtonImage != nil {
+		params.ButtonImage = b.definedParams.ButtonImage
+	}
+	if b.definedParams.ButtonTextColor != nil {
+		params.ButtonTextColor = b.definedParams.ButtonTextColor
+	}
+	if b.definedParams.ButtonPadding != nil {
+		params.ButtonPadding = b.definedParams.ButtonPadding
+	}
+
+	// Set defaults
+	if params.Padding == nil {
+		params.Padding = &Insets{}
+	}
+	if params.Spacing == nil {
+		params.Spacing = constantutil.ConstantToPointer(0)
+	}
+	if params.ButtonPadding == nil {
+		params.ButtonPadding = &Insets{}
+	}
+
+	b.computedParams = params
+}
+
+func (b *MenuBar) GetWidget() *Widget {
+	b.init.Do()
+	return b.container.GetWidget()
+}
+
+func (b *MenuBar) PreferredSize() (int, int) {
+	b.init.Do()
+	return b.container.PreferredSize()
+}
+
+func (b *MenuBar) SetLocation(rect img.Rectangle) {
+	b.init.Do()
+	b.container.SetLocation(rect)
+}
+
+func (b *MenuBar) RequestRelayout() {
+	b.init.Do()
+	b.container.RequestRelayout()
+}
+
+func (b *MenuBar) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
+	b.init.Do()
+	b.container.SetupInputLayer(def)
+}
+
+func (b *MenuBar) Render(screen *ebiten.Image) {
+	b.init.Do()
+	b.container.Render(screen)
+}
+
+func (b *MenuBar) Update(updObj *UpdateObject) {
+	b.init.Do()
+	b.container.Update(updObj)
+}
+
+// Menus returns the menus of the menu bar in the order of their titles.
+func (b *MenuBar) Menus() []*Menu {
+	return b.menus
+}
+
+// OpenMenu opens the menu at index, closing any other open menu of the menu bar.
+func (b *MenuBar) OpenMenu(index int) {
+	b.init.Do()
+	if index == b.openIndex {
+		return
+	}
+	b.CloseMenu()
+
+	m := b.menus[index]
+	button := b.buttons[index]
+	m.Close()
+	m.parent = nil
+	m.bar = b
+	b.openIndex = index
+	button.focused = true
+	m.open(b.GetWidget(), img.Pt(button.GetWidget().Rect.Min.X, button.GetWidget().Rect.Max.Y))
+}
+
+// CloseMenu closes the open menu of the menu bar, if there is one.
+func (b *MenuBar) CloseMenu() {
+	if b.openIndex >= 0 {
+		b.menus[b.openIndex].Close()
+	}
+}
+
+// OpenMenuIndex returns the index of the open menu, or -1 if no menu is open.
+func (b *MenuBar) OpenMenuIndex() int {
+	return b.openIndex
+}
+
+// openAdjacent opens the menu next to m in direction and highlights its first item.
+func (b *MenuBar) openAdjacent(m *Menu, direction int) {
+	n := len(b.menus)
+	for i, o := range b.menus {
+		if o == m {
+			b.OpenMenu((i + direction + n) % n)
+			b.menus[b.openIndex].moveHighlight(1)
+			return
+		}
+	}
+}
+
+func (b *MenuBar) menuClosed(m *Menu) {
+	if b.openIndex >= 0 && b.menus[b.openIndex] == m {
+		b.buttons[b.openIndex].focused = false
+		b.openIndex = -1
+	}
+}
+
+func (b *MenuBar) createWidget() {
+	b.container = NewContainer(
+		ContainerOpts.WidgetOpts(b.widgetOpts...),
+	)
+	b.widgetOpts = nil
+}
+
+func (b *MenuBar) initWidget() {
+	b.CloseMenu()
+	b.container.RemoveChildren()
+	b.container.SetBackgroundImage(b.computedParams.BackgroundImage)
+	b.container.layout = NewRowLayout(
+		RowLayoutOpts.Direction(DirectionHorizontal),
+		RowLayoutOpts.Padding(b.computedParams.Padding),
+		RowLayoutOpts.Spacing(*b.computedParams.Spacing),
+	)
+
+	b.buttons = make([]*Button, len(b.menus))
+	for i, title := range b.titles {
+		b.buttons[i] = NewButton(
+			ButtonOpts.WidgetOpts(
+				WidgetOpts.LayoutData(RowLayoutData{Stretch: true}),
+				WidgetOpts.CursorEnterHandler(func(_ *WidgetCursorEnterEventArgs) {
+					if b.openIndex >= 0 {
+						b.OpenMenu(i)
+					}
+				}),
+			),
+			ButtonOpts.Image(b.computedParams.ButtonImage),
+			ButtonOpts.Text(title, b.computedParams.ButtonFace, b.computedParams.ButtonTextColor),
+			ButtonOpts.TextPadding(b.computedParams.ButtonPadding),
+			ButtonOpts.DisableDefaultKeys(),
+			ButtonOpts.PressedHandler(func(_ *ButtonPressedEventArgs) {
+				if b.openIndex == i {
+					b.CloseMenu()
+				} else {
+					b.OpenMenu(i)
+				}
+			}),
+		)
+		b.container.AddChild(b.buttons[i])
+	}
+
+	b.container.Validate()
+}
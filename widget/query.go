@@ -0,0 +1,121 @@
+package widget
+
+// WalkFunc is called by Walk for every widget. Returning false stops the walk.
+type WalkFunc func(w HasWidget) bool
+
+// Walk calls f for root and all widgets below it, depth-first and in the order they were added.
+// It descends into Containers and other ChildrenProviders, ScrollContainers and the current page of FlipBooks.
+// For TabBooks, it visits all tabs, not only the current one. The internal widgets of other widgets,
+// such as the entries of a List, are not visited.
+//
+// Walk returns false if f stopped the walk.
+func Walk(root HasWidget, f WalkFunc) bool {
+	if !f(root) {
+		return false
+	}
+	for _, c := range walkChildren(root) {
+		if !Walk(c, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// Children returns the widgets directly below w that Walk descends into.
+func Children(w HasWidget) []HasWidget {
+	children := walkChildren(w)
+	result := make([]HasWidget, len(children))
+	for i, c := range children {
+		result[i] = c
+	}
+	return result
+}
+
+// FindByID returns the first widget below or at root whose ID is id, or nil if there is none.
+func FindByID(root HasWidget, id string) HasWidget {
+	var result HasWidget
+	Walk(root, func(w HasWidget) bool {
+		if w.GetWidget().ID == id {
+			result = w
+			return false
+		}
+		return true
+	})
+	return result
+}
+
+// FindAll returns all widgets below or at root for which pred returns true, in the order of Walk.
+func FindAll(root HasWidget, pred func(w HasWidget) bool) []HasWidget {
+	var result []HasWidget
+	Walk(root, func(w HasWidget) bool {
+		if pred(w) {
+			result = append(result, w)
+		}
+		return true
+	})
+	return result
+}
+
+// Walk calls f for the title bar and the contents of the window and all widgets below them. See Walk.
+func (w *Window) Walk(f WalkFunc) bool {
+	if w.TitleBar != nil && !Walk(w.TitleBar, f) {
+		return false
+	}
+	if w.Contents != nil && !Walk(w.Contents, f) {
+		return false
+	}
+	return true
+}
+
+// FindByID returns the first widget in the window whose ID is id, or nil if there is none.
+func (w *Window) FindByID(id string) HasWidget {
+	var result HasWidget
+	w.Walk(func(c HasWidget) bool {
+		if c.GetWidget().ID == id {
+			result = c
+			return false
+		}
+		return true
+	})
+	return result
+}
+
+// FindAll returns all widgets in the window for which pred returns true.
+func (w *Window) FindAll(pred func(w HasWidget) bool) []HasWidget {
+	var result []HasWidget
+	w.Walk(func(c HasWidget) bool {
+		if pred(c) {
+			result = append(result, c)
+		}
+		return true
+	})
+	return result
+}
+
+// ChildrenProvider is implemented by widgets that contain other widgets, such as Containers and types
+// that embed them. Walk descends into the children it returns.
+type ChildrenProvider interface {
+	Children() []PreferredSizeLocateableWidget
+}
+
+func walkChildren(w HasWidget) []PreferredSizeLocateableWidget {
+	switch v := w.(type) {
+	case *TabBook:
+		result := make([]PreferredSizeLocateableWidget, len(v.tabs))
+		for i, t := range v.tabs {
+			result[i] = t
+		}
+		return result
+	case *FlipBook:
+		v.init.Do()
+		return v.container.Children()
+	case *ScrollContainer:
+		if v.content == nil {
+			return nil
+		}
+		return []PreferredSizeLocateableWidget{v.content}
+	case ChildrenProvider:
+		return v.Children()
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestWalk(t *testing.T) {
+	is := is.New(t)
+
+	a := newSimpleWidget(10, 10, nil)
+	a.GetWidget().ID = "a"
+	b := newSimpleWidget(10, 10, nil)
+	b.GetWidget().ID = "b"
+	c := newSimpleWidget(10, 10, nil)
+	c.GetWidget().ID = "c"
+
+	inner := NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.ID("inner")))
+	inner.AddChild(b)
+	root := NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.ID("root")))
+	root.AddChild(a, inner, c)
+
+	var ids []string
+	completed := Walk(root, func(w HasWidget) bool {
+		ids = append(ids, w.GetWidget().ID)
+		return true
+	})
+	is.True(completed)
+	is.Equal(ids, []string{"root", "a", "inner", "b", "c"})
+
+	ids = nil
+	completed = Walk(root, func(w HasWidget) bool {
+		ids = append(ids, w.GetWidget().ID)
+		return w != inner
+	})
+	is.True(!completed)
+	is.Equal(ids, []string{"root", "a", "inner"})
+}
+
+func TestChildren(t *testing.T) {
+	is := is.New(t)
+
+	a := newSimpleWidget(10, 10, nil)
+	b := newSimpleWidget(10, 10, nil)
+	inner := NewContainer()
+	inner.AddChild(b)
+	root := NewContainer()
+	root.AddChild(a, inner)
+
+	is.Equal(Children(root), []HasWidget{a, inner})
+	is.Equal(Children(inner), []HasWidget{b})
+	is.Equal(len(Children(a)), 0)
+
+	panel := NewPanel()
+	panel.AddChild(a)
+	is.Equal(Children(panel), []HasWidget{a})
+
+	// Types that embed a Container are walked as well.
+	custom := &customContainer{Container: NewContainer()}
+	custom.AddChild(b)
+	is.Equal(Children(custom), []HasWidget{b})
+	is.Equal(FindAll(custom, func(w HasWidget) bool { return w == b }), []HasWidget{b})
+}
+
+type customContainer struct {
+	*Container
+}
+
+func TestFindByID(t *testing.T) {
+	is := is.New(t)
+
+	w := newSimpleWidget(10, 10, nil)
+	w.GetWidget().ID = "target"
+
+	content := NewContainer()
+	content.AddChild(w)
+	s := newScrollContainer(t, content)
+
+	tab := NewTabBookTab(TabBookTabOpts.Label("Tab"), TabBookTabOpts.ContainerOpts(newTabContainerOpts()...))
+	tab.AddChild(s)
+	tb := newTabBook(t,
+		TabBookOpts.Tabs(NewTabBookTab(TabBookTabOpts.Label("First"), TabBookTabOpts.ContainerOpts(newTabContainerOpts()...)), tab))
+
+	root := NewContainer()
+	root.AddChild(tb)
+
+	// The tab is not the current one, but it is searched anyway.
+	is.Equal(FindByID(root, "target"), w)
+	is.Equal(FindByID(root, "missing"), nil)
+}
+
+func TestFindAll(t *testing.T) {
+	is := is.New(t)
+
+	a := newSimpleWidget(10, 10, nil)
+	a.GetWidget().Classes = []string{"enemy", "boss"}
+	b := newSimpleWidget(10, 10, nil)
+	c := NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.Classes("enemy")))
+
+	root := NewContainer()
+	root.AddChild(a, b, c)
+
+	result := FindAll(root, func(w HasWidget) bool {
+		return w.GetWidget().HasClass("enemy")
+	})
+	is.Equal(result, []HasWidget{a, c})
+}
+
+func TestWidget_Ancestors(t *testing.T) {
+	is := is.New(t)
+
+	w := newSimpleWidget(10, 10, nil)
+	content := NewContainer()
+	content.AddChild(w)
+	s := newScrollContainer(t, content)
+	root := NewContainer()
+	root.AddChild(s)
+
+	is.Equal(w.GetWidget().Ancestors(), []HasWidget{content, s, root})
+	is.Equal(root.GetWidget().Ancestors(), nil)
+}
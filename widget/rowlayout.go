@@ -88,10 +88,21 @@ func (o RowLayoutOptions) Spacing(s int) RowLayoutOpt {
 	}
 }
 
+// GetPadding implements PaddedLayouter.
+func (r *RowLayout) GetPadding() *Insets {
+	return r.padding
+}
+
 // PreferredSize implements Layouter.
 func (r *RowLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int, int) {
+	return r.PreferredSizeFor(widgets, 0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedLayouter. Widgets are measured within the width of a vertical layout,
+// or within the height of a horizontal one.
+func (r *RowLayout) PreferredSizeFor(widgets []PreferredSizeLocateableWidget, width int, height int) (int, int) {
 	rect := image.Rectangle{}
-	r.layout(widgets, image.Rectangle{}, false, func(_ PreferredSizeLocateableWidget, wr image.Rectangle) {
+	r.layout(widgets, image.Rect(0, 0, max(width, 0), max(height, 0)), false, func(_ PreferredSizeLocateableWidget, wr image.Rectangle) {
 		rect = rect.Union(wr)
 	})
 	return rect.Dx() + r.padding.Dx(), rect.Dy() + r.padding.Dy()
@@ -109,6 +120,14 @@ func (r *RowLayout) layout(widgets []PreferredSizeLocateableWidget, rect image.R
 		return
 	}
 
+	// Widgets are measured within the space available to them in the direction that is not the primary direction.
+	maxWidth, maxHeight := r.padding.constrainedSize(rect.Dx(), rect.Dy())
+	if r.direction == DirectionHorizontal {
+		maxWidth = 0
+	} else {
+		maxHeight = 0
+	}
+
 	rect = r.padding.Apply(rect)
 	x, y := 0, 0
 
@@ -117,10 +136,15 @@ func (r *RowLayout) layout(widgets []PreferredSizeLocateableWidget, rect image.R
 			continue
 		}
 
+		ld := widget.GetWidget().LayoutData
+		mw, mh := maxWidth, maxHeight
+		if rld, ok := ld.(RowLayoutData); ok {
+			mw, mh = constrainMax(mw, rld.MaxWidth), constrainMax(mh, rld.MaxHeight)
+		}
+
 		wx, wy := x, y
-		ww, wh := widget.PreferredSize()
+		ww, wh := PreferredSizeFor(widget, mw, mh)
 
-		ld := widget.GetWidget().LayoutData
 		if rld, ok := ld.(RowLayoutData); ok {
 			wx, wy, ww, wh = r.applyLayoutData(rld, wx, wy, ww, wh, usePosition, rect, x, y)
 		}
@@ -130,6 +130,33 @@ func TestRowLayout_Layout(t *testing.T) {
 	}
 }
 
+func TestRowLayout_PreferredSizeFor(t *testing.T) {
+	is := is.New(t)
+
+	l := NewRowLayout(
+		RowLayoutOpts.Direction(DirectionVertical),
+		RowLayoutOpts.Padding(NewInsetsSimple(10)),
+		RowLayoutOpts.Spacing(5))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newWrappingWidget(100, 10, nil),
+		newSimpleWidget(40, 20, nil),
+	}
+
+	w, h := l.PreferredSizeFor(widgets, 0, 0)
+	is.Equal(w, 120)
+	is.Equal(h, 55)
+
+	// The wrapping widget gets taller as the row gets narrower.
+	w, h = l.PreferredSizeFor(widgets, 70, 0)
+	is.Equal(w, 70)
+	is.Equal(h, 65)
+
+	l.Layout(widgets, image.Rect(0, 0, 70, 100))
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(10, 10, 60, 30))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(10, 35, 50, 55))
+}
+
 func newRowLayout(t *testing.T, opts ...RowLayoutOpt) Layouter {
 	t.Helper()
 	l := NewRowLayout(opts...)
@@ -3,9 +3,13 @@ package widget
 import (
 	img "image"
 	"math"
+	"time"
 
+	"github.com/ebitenui/ebitenui/event"
 	"github.com/ebitenui/ebitenui/image"
 	"github.com/ebitenui/ebitenui/input"
+	internalinput "github.com/ebitenui/ebitenui/internal/input"
+	"github.com/ebitenui/ebitenui/utilities/clock"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -14,11 +18,31 @@ type ScrollContainer struct {
 	ScrollLeft float64
 	ScrollTop  float64
 
+	// ScrollChangedEvent is fired when the scroll container changes ScrollLeft or ScrollTop by itself,
+	// for example to bring a newly focused widget into view, while it animates towards a position
+	// or when its content is dragged.
+	ScrollChangedEvent *event.Event
+
 	widgetOpts          []WidgetOpt
 	image               *ScrollContainerImage
 	content             PreferredSizeLocateableWidget
 	padding             *Insets
 	stretchContentWidth bool
+	smoothScroll        time.Duration
+	dragToScroll        bool
+	overscroll          int
+
+	animation  *scrollAnimation
+	lastUpdate time.Time
+	// dragging is set while the content is dragged by touch or, once it has moved, by the mouse.
+	dragging    bool
+	mouseDown   bool
+	mouseStart  img.Point
+	mouseLast   img.Point
+	velocityX   float64
+	velocityY   float64
+	overscrollX float64
+	overscrollY float64
 
 	init      *MultiOnce
 	widget    *Widget
@@ -28,12 +52,40 @@ type ScrollContainer struct {
 
 type ScrollContainerOpt func(s *ScrollContainer)
 
+// scrollAnimation moves ScrollLeft and ScrollTop from one position to another.
+type scrollAnimation struct {
+	fromLeft float64
+	fromTop  float64
+	toLeft   float64
+	toTop    float64
+	elapsed  time.Duration
+	duration time.Duration
+	// left and top are the values last set by the animation. If ScrollLeft or ScrollTop differ from them,
+	// they have been changed from outside and the animation stops.
+	left float64
+	top  float64
+}
+
+// defaultSmoothScrollDuration is the duration of animated scrolling if ScrollContainerOpts.SmoothScroll is not used.
+const defaultSmoothScrollDuration = 200 * time.Millisecond
+
+// overscrollBounce is the rate at which content dragged past its edges moves back, per second.
+const overscrollBounce = 12.0
+
 type ScrollContainerImage struct {
 	Idle     *image.NineSlice
 	Disabled *image.NineSlice
 	Mask     *image.NineSlice
 }
 
+type ScrollContainerScrollChangedEventArgs struct {
+	ScrollContainer *ScrollContainer
+	ScrollLeft      float64
+	ScrollTop       float64
+}
+
+type ScrollContainerScrollChangedHandlerFunc func(args *ScrollContainerScrollChangedEventArgs)
+
 type ScrollContainerOptions struct {
 }
 
@@ -41,6 +93,8 @@ var ScrollContainerOpts ScrollContainerOptions
 
 func NewScrollContainer(opts ...ScrollContainerOpt) *ScrollContainer {
 	s := &ScrollContainer{
+		ScrollChangedEvent: &event.Event{},
+
 		init: &MultiOnce{},
 
 		renderBuf: image.NewMaskedRenderBuffer(),
@@ -82,6 +136,11 @@ func (s *ScrollContainer) Validate() {
 	})
 	s.content.GetWidget().FocusEvent.AddHandler(func(args interface{}) {
 		if a, ok := args.(*WidgetFocusEventArgs); ok {
+			// Widgets focused without the pointer, e.g. by keyboard or gamepad navigation,
+			// may be scrolled out of view.
+			if a.Focused && a.Widget != nil && a.Location == (img.Point{-1, -1}) {
+				s.scrollRectIntoView(a.Widget.GetWidget().Rect, s.smoothScroll > 0)
+			}
 			s.GetWidget().FireFocusEvent(a.Widget, a.Focused, a.Location)
 		}
 	})
@@ -95,6 +154,11 @@ func (s *ScrollContainer) Validate() {
 			s.GetWidget().FireDragAndDropEvent(a.Window, a.Show, a.DnD)
 		}
 	})
+	s.content.GetWidget().PopupEvent.AddHandler(func(args interface{}) {
+		if a, ok := args.(*WidgetPopupEventArgs); ok {
+			s.GetWidget().FirePopupEvent(a.Window, a.Show)
+		}
+	})
 
 	s.content.Validate()
 	s.validated = true
@@ -124,12 +188,47 @@ func (o ScrollContainerOptions) Padding(p *Insets) ScrollContainerOpt {
 	}
 }
 
+func (o ScrollContainerOptions) ScrollChangedHandler(f ScrollContainerScrollChangedHandlerFunc) ScrollContainerOpt {
+	return func(s *ScrollContainer) {
+		s.ScrollChangedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*ScrollContainerScrollChangedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
 func (o ScrollContainerOptions) StretchContentWidth() ScrollContainerOpt {
 	return func(s *ScrollContainer) {
 		s.stretchContentWidth = true
 	}
 }
 
+// SmoothScroll animates the content over d towards the positions it is scrolled to, instead of jumping there.
+// This applies to ScrollTo and ScrollToPosition, to widgets brought into view by keyboard or gamepad focus,
+// and to the mouse wheel in widgets like List.
+func (o ScrollContainerOptions) SmoothScroll(d time.Duration) ScrollContainerOpt {
+	return func(s *ScrollContainer) {
+		s.smoothScroll = d
+	}
+}
+
+// DragToScroll lets the content be dragged with the mouse, as it can be by touch. Once it has been
+// dragged, releasing the mouse button is not a click, and the content keeps moving for a while.
+func (o ScrollContainerOptions) DragToScroll() ScrollContainerOpt {
+	return func(s *ScrollContainer) {
+		s.dragToScroll = true
+	}
+}
+
+// Overscroll lets the content be dragged up to max pixels past its edges, from where it bounces back
+// when it is released.
+func (o ScrollContainerOptions) Overscroll(max int) ScrollContainerOpt {
+	return func(s *ScrollContainer) {
+		s.overscroll = max
+	}
+}
+
 func (s *ScrollContainer) GetWidget() *Widget {
 	s.init.Do()
 	return s.widget
@@ -141,6 +240,12 @@ func (s *ScrollContainer) SetLocation(rect img.Rectangle) {
 }
 
 func (s *ScrollContainer) PreferredSize() (int, int) {
+	return s.PreferredSizeFor(0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedPreferredSizer. The content is measured within width, but not
+// within height, since it can be scrolled vertically.
+func (s *ScrollContainer) PreferredSizeFor(width int, _ int) (int, int) {
 	s.init.Do()
 	if !s.validated {
 		s.Validate()
@@ -154,7 +259,8 @@ func (s *ScrollContainer) PreferredSize() (int, int) {
 		return 50, 50
 	}
 
-	w, h := p.PreferredSize()
+	maxWidth, _ := s.padding.constrainedSize(width, 0)
+	w, h := PreferredSizeFor(p, maxWidth, 0)
 	return w + s.padding.Dx(), h + s.padding.Dy()
 }
 
@@ -198,6 +304,8 @@ func (s *ScrollContainer) Update(updObj *UpdateObject) {
 		return
 	}
 
+	s.updateScrolling()
+
 	r, ok := s.content.(Updater)
 	if !ok {
 		return
@@ -290,7 +398,10 @@ func (s *ScrollContainer) renderContent(screen *ebiten.Image) {
 		rect = rect.Add(s.widget.Rect.Min)
 		rect = rect.Add(img.Point{s.padding.Left, s.padding.Top})
 
-		rect = rect.Sub(img.Point{int(math.Round(float64(cw-vrect.Dx()) * s.ScrollLeft)), int(math.Round(float64(ch-vrect.Dy()) * s.ScrollTop))})
+		rect = rect.Sub(img.Point{
+			int(math.Round(float64(cw-vrect.Dx())*s.ScrollLeft + s.overscrollX)),
+			int(math.Round(float64(ch-vrect.Dy())*s.ScrollTop + s.overscrollY)),
+		})
 
 		if rect != s.content.GetWidget().Rect {
 			l.SetLocation(rect)
@@ -303,7 +414,7 @@ func (s *ScrollContainer) renderContent(screen *ebiten.Image) {
 
 	s.renderBuf.Draw(screen,
 		func(buf *ebiten.Image) {
-			r.Render(buf)
+			renderWithTransform(buf, s.content.GetWidget(), r)
 		},
 		func(buf *ebiten.Image) {
 			s.image.Mask.Draw(buf, s.widget.Rect.Dx()-s.padding.Dx(), s.widget.Rect.Dy()-s.padding.Dy(), func(opts *ebiten.DrawImageOptions) {
@@ -322,6 +433,366 @@ func (s *ScrollContainer) ContentRect() img.Rectangle {
 	return s.content.GetWidget().Rect
 }
 
+// ScrollIntoView scrolls the content so that rect, given in screen coordinates of the current layout,
+// is visible in the view. If rect is larger than the view, its top left corner is brought into view.
+func (s *ScrollContainer) ScrollIntoView(rect img.Rectangle) {
+	s.init.Do()
+	s.scrollRectIntoView(rect, false)
+}
+
+// ScrollTo scrolls the content so that w, which must be part of the content, is visible in the view.
+// If animated is true, the content moves there over the duration set by ScrollContainerOpts.SmoothScroll,
+// or over 200ms if it is not set.
+func (s *ScrollContainer) ScrollTo(w HasWidget, animated bool) {
+	s.init.Do()
+	s.scrollRectIntoView(w.GetWidget().Rect, animated)
+}
+
+// ScrollToPosition scrolls to left and top, which are fractions of the scrollable size like ScrollLeft
+// and ScrollTop. If animated is true, the content moves there like with ScrollTo. Otherwise, it jumps there
+// and any animation or movement after a drag stops.
+func (s *ScrollContainer) ScrollToPosition(left float64, top float64, animated bool) {
+	s.init.Do()
+	left = math.Max(0, math.Min(1, left))
+	top = math.Max(0, math.Min(1, top))
+
+	if !animated {
+		s.stopScrolling()
+		s.setScroll(left, top)
+		return
+	}
+
+	if s.animation != nil && s.animation.toLeft == left && s.animation.toTop == top {
+		return
+	}
+	s.stopScrolling()
+	if left == s.ScrollLeft && top == s.ScrollTop {
+		return
+	}
+
+	d := s.smoothScroll
+	if d <= 0 {
+		d = defaultSmoothScrollDuration
+	}
+	s.animation = &scrollAnimation{
+		fromLeft: s.ScrollLeft,
+		fromTop:  s.ScrollTop,
+		toLeft:   left,
+		toTop:    top,
+		duration: d,
+		left:     s.ScrollLeft,
+		top:      s.ScrollTop,
+	}
+}
+
+// ScrollTarget returns the position the content is animating towards, or ScrollLeft and ScrollTop
+// if it is not animating.
+func (s *ScrollContainer) ScrollTarget() (float64, float64) {
+	if s.animation != nil {
+		return s.animation.toLeft, s.animation.toTop
+	}
+	return s.ScrollLeft, s.ScrollTop
+}
+
+// Scrolling returns whether the content is animating, being dragged, moving after it has been released,
+// or bouncing back from past its edges.
+func (s *ScrollContainer) Scrolling() bool {
+	return s.animation != nil || s.dragging || s.velocityX != 0 || s.velocityY != 0 ||
+		s.overscrollX != 0 || s.overscrollY != 0
+}
+
+// scrollRectIntoView scrolls to the position at which rect is visible, see ScrollIntoView.
+func (s *ScrollContainer) scrollRectIntoView(rect img.Rectangle, animated bool) {
+	vrect := s.ViewRect()
+	crect := s.ContentRect()
+	if vrect.Empty() || crect.Empty() {
+		return
+	}
+
+	scrollLeft := scrollIntoViewFraction(s.ScrollLeft, rect.Min.X, rect.Max.X, vrect.Min.X, vrect.Max.X, crect.Min.X, crect.Dx())
+	scrollTop := scrollIntoViewFraction(s.ScrollTop, rect.Min.Y, rect.Max.Y, vrect.Min.Y, vrect.Max.Y, crect.Min.Y, crect.Dy())
+	if scrollLeft == s.ScrollLeft && scrollTop == s.ScrollTop {
+		return
+	}
+	s.ScrollToPosition(scrollLeft, scrollTop, animated)
+}
+
+// scrollIntoViewFraction returns the scroll fraction along one axis that makes the span lo..hi
+// visible in the view viewMin..viewMax, for content starting at contentMin with the given size.
+func scrollIntoViewFraction(current float64, lo int, hi int, viewMin int, viewMax int, contentMin int, contentSize int) float64 {
+	scrollSize := contentSize - (viewMax - viewMin)
+	if scrollSize <= 0 {
+		return current
+	}
+
+	switch {
+	case lo < viewMin || hi-lo > viewMax-viewMin:
+		return float64(lo-contentMin) / float64(scrollSize)
+	case hi > viewMax:
+		return float64(hi-(viewMax-viewMin)-contentMin) / float64(scrollSize)
+	default:
+		return current
+	}
+}
+
+// setScroll changes ScrollLeft and ScrollTop and fires ScrollChangedEvent if they have changed.
+func (s *ScrollContainer) setScroll(left float64, top float64) {
+	if left == s.ScrollLeft && top == s.ScrollTop {
+		return
+	}
+
+	s.ScrollLeft = left
+	s.ScrollTop = top
+	s.clampScroll()
+	s.ScrollChangedEvent.Fire(&ScrollContainerScrollChangedEventArgs{
+		ScrollContainer: s,
+		ScrollLeft:      s.ScrollLeft,
+		ScrollTop:       s.ScrollTop,
+	})
+}
+
+// stopScrolling stops the animation and the movement of the content after a drag, and moves content
+// that has been dragged past its edges back immediately.
+func (s *ScrollContainer) stopScrolling() {
+	s.animation = nil
+	s.velocityX, s.velocityY = 0, 0
+	s.overscrollX, s.overscrollY = 0, 0
+}
+
+// updateScrolling drags the content, and advances its animation, its movement after a drag and its bounce
+// back from past its edges by the time since the last update.
+func (s *ScrollContainer) updateScrolling() {
+	now := clock.Now()
+	dt := 0.0
+	if !s.lastUpdate.IsZero() {
+		// Don't let the content leap after the container has not been updated for a while.
+		dt = math.Min(now.Sub(s.lastUpdate).Seconds(), 0.1)
+	}
+	s.lastUpdate = now
+
+	left, top := s.ScrollLeft, s.ScrollTop
+
+	layer := s.content.GetWidget().EffectiveInputLayer()
+	s.handleTouchDrag(layer)
+	if s.dragToScroll {
+		s.handleMouseDrag(layer, dt)
+	}
+
+	if s.dragging {
+		s.animation = nil
+	} else {
+		s.updateMomentum(dt)
+		s.updateOverscroll(dt)
+	}
+	s.updateAnimation(dt)
+
+	if left == s.ScrollLeft && top == s.ScrollTop {
+		return
+	}
+	s.clampScroll()
+	s.ScrollChangedEvent.Fire(&ScrollContainerScrollChangedEventArgs{
+		ScrollContainer: s,
+		ScrollLeft:      s.ScrollLeft,
+		ScrollTop:       s.ScrollTop,
+	})
+}
+
+// handleTouchDrag moves the content along with touch pans that start within the view.
+func (s *ScrollContainer) handleTouchDrag(layer *input.Layer) {
+	for _, g := range input.Gestures() {
+		if g.Type != input.GesturePan {
+			continue
+		}
+
+		switch g.Phase {
+		case input.GesturePhaseBegan:
+			if !img.Pt(g.StartX, g.StartY).In(s.ViewRect()) || !layer.ActiveFor(g.StartX, g.StartY, input.LayerEventTypeAny) ||
+				!s.widget.ownsPan(g.StartX, g.StartY) {
+				continue
+			}
+			s.stopDragging()
+			s.dragging = true
+			s.moveBy(g.DeltaX, g.DeltaY)
+		case input.GesturePhaseChanged:
+			if s.dragging {
+				s.moveBy(g.DeltaX, g.DeltaY)
+			}
+		case input.GesturePhaseEnded:
+			if s.dragging {
+				s.dragging = false
+				s.velocityX, s.velocityY = g.VelocityX, g.VelocityY
+			}
+		}
+		// The movement after the release is done by updateMomentum, which lets the content bounce at its edges,
+		// so pans in GesturePhaseInertia are ignored.
+	}
+}
+
+// handlesPan implements panHandler. A ScrollContainer only takes pans when its content can be moved.
+func (s *ScrollContainer) handlesPan() bool {
+	if s.content == nil {
+		return false
+	}
+	vrect := s.ViewRect()
+	crect := s.ContentRect()
+	return s.overscroll > 0 || crect.Dx() > vrect.Dx() || crect.Dy() > vrect.Dy()
+}
+
+// handleMouseDrag moves the content along with the mouse while the left mouse button is held down on it,
+// once the mouse has moved far enough for it not to be a click.
+func (s *ScrollContainer) handleMouseDrag(layer *input.Layer, dt float64) {
+	x, y := input.CursorPosition()
+	p := img.Pt(x, y)
+
+	if !s.mouseDown {
+		// The primary touch acts as the left mouse button, but touches are handled by handleTouchDrag.
+		if len(internalinput.InputHandler.TouchIDs) == 0 && p.In(s.ViewRect()) &&
+			input.MouseButtonJustPressedLayer(ebiten.MouseButtonLeft, layer) && s.widget.ownsPan(x, y) {
+			s.mouseDown = true
+			s.mouseStart = p
+			s.mouseLast = p
+		}
+		return
+	}
+
+	if !input.MouseButtonPressed(ebiten.MouseButtonLeft) {
+		s.mouseDown = false
+		if s.dragging {
+			s.dragging = false
+			// The release ends the drag, it is not a click.
+			internalinput.InputHandler.PointerConsumed = true
+		}
+		return
+	}
+
+	if !s.dragging {
+		if math.Hypot(float64(p.X-s.mouseStart.X), float64(p.Y-s.mouseStart.Y)) <= internalinput.TouchSlop {
+			return
+		}
+		s.stopDragging()
+		s.dragging = true
+		internalinput.InputHandler.PointerConsumed = true
+		s.mouseLast = s.mouseStart
+	}
+
+	dx, dy := float64(p.X-s.mouseLast.X), float64(p.Y-s.mouseLast.Y)
+	s.mouseLast = p
+	if dt > 0 {
+		// Smooth the velocity like for touches, so that a single uneven frame does not decide the speed.
+		s.velocityX = s.velocityX*0.3 + dx/dt*0.7
+		s.velocityY = s.velocityY*0.3 + dy/dt*0.7
+	}
+	s.moveBy(dx, dy)
+}
+
+// stopDragging prepares a new drag, which takes over from any animation or movement.
+func (s *ScrollContainer) stopDragging() {
+	s.animation = nil
+	s.velocityX, s.velocityY = 0, 0
+}
+
+// updateMomentum keeps the content moving after a drag, slower and slower, until it stops.
+func (s *ScrollContainer) updateMomentum(dt float64) {
+	if s.velocityX == 0 && s.velocityY == 0 || dt <= 0 {
+		return
+	}
+
+	decay := math.Pow(internalinput.InertiaDecay, dt)
+	s.velocityX *= decay
+	s.velocityY *= decay
+	// Content that has moved past its edges stops quickly before it bounces back.
+	if s.overscrollX != 0 {
+		s.velocityX *= math.Exp(-dt * overscrollBounce * 2)
+	}
+	if s.overscrollY != 0 {
+		s.velocityY *= math.Exp(-dt * overscrollBounce * 2)
+	}
+	if math.Hypot(s.velocityX, s.velocityY) < internalinput.InertiaMinVelocity {
+		s.velocityX, s.velocityY = 0, 0
+		return
+	}
+
+	s.moveBy(s.velocityX*dt, s.velocityY*dt)
+}
+
+// updateOverscroll moves content that is past its edges back.
+func (s *ScrollContainer) updateOverscroll(dt float64) {
+	f := math.Exp(-dt * overscrollBounce)
+	s.overscrollX = bounceBack(s.overscrollX, f)
+	s.overscrollY = bounceBack(s.overscrollY, f)
+}
+
+func bounceBack(overscroll float64, f float64) float64 {
+	overscroll *= f
+	if math.Abs(overscroll) < 0.5 {
+		return 0
+	}
+	return overscroll
+}
+
+// updateAnimation advances the animation started by ScrollToPosition.
+func (s *ScrollContainer) updateAnimation(dt float64) {
+	a := s.animation
+	if a == nil {
+		return
+	}
+	if s.ScrollLeft != a.left || s.ScrollTop != a.top {
+		s.animation = nil
+		return
+	}
+
+	a.elapsed += time.Duration(dt * float64(time.Second))
+	p := math.Min(1, float64(a.elapsed)/float64(a.duration))
+	// Ease out, so that the content starts moving right away and settles at the target.
+	e := 1 - math.Pow(1-p, 3)
+	a.left = a.fromLeft + (a.toLeft-a.fromLeft)*e
+	a.top = a.fromTop + (a.toTop-a.fromTop)*e
+	s.ScrollLeft, s.ScrollTop = a.left, a.top
+	if p >= 1 {
+		s.animation = nil
+	}
+}
+
+// moveBy moves the content by dx, dy pixels, as far as it can be scrolled and, with
+// ScrollContainerOpts.Overscroll, up to that many pixels past its edges.
+func (s *ScrollContainer) moveBy(dx float64, dy float64) {
+	vrect := s.ViewRect()
+	crect := s.ContentRect()
+	s.ScrollLeft, s.overscrollX = s.moveAxis(s.ScrollLeft, s.overscrollX, dx, crect.Dx()-vrect.Dx())
+	s.ScrollTop, s.overscrollY = s.moveAxis(s.ScrollTop, s.overscrollY, dy, crect.Dy()-vrect.Dy())
+}
+
+// moveAxis moves content by d pixels along an axis with the given scrollable size, and returns the new
+// scroll fraction and how many pixels the content is past its edges.
+func (s *ScrollContainer) moveAxis(scroll float64, overscroll float64, d float64, size int) (float64, float64) {
+	if size <= 0 && s.overscroll <= 0 {
+		return scroll, 0
+	}
+	size = max(size, 0)
+
+	// The position of the view on the content. Content that follows the pointer downwards moves the view up.
+	pos := scroll*float64(size) + overscroll
+	d = -d
+	if s.overscroll > 0 && (pos < 0 && d < 0 || pos > float64(size) && d > 0) {
+		// The further the content is past its edge, the harder it gets to drag it further.
+		d *= 0.5 * math.Max(0, 1-math.Abs(overscroll)/float64(s.overscroll))
+	}
+
+	limit := float64(s.overscroll)
+	pos = math.Max(-limit, math.Min(float64(size)+limit, pos+d))
+	switch {
+	case pos < 0:
+		return 0, pos
+	case pos > float64(size):
+		return 1, pos - float64(size)
+	case size == 0:
+		// The content doesn't scroll on this axis, so there is nothing to move.
+		return scroll, 0
+	default:
+		return pos / float64(size), 0
+	}
+}
+
 func (s *ScrollContainer) clampScroll() {
 	if s.ScrollTop < 0 {
 		s.ScrollTop = 0
@@ -0,0 +1,257 @@
+package widget
+
+import (
+	img "image"
+	"testing"
+	"time"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestScrollContainer_ScrollIntoView(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *ScrollContainerScrollChangedEventArgs
+	s := newScrollContainer(t, NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.MinSize(100, 300))),
+		ScrollContainerOpts.ScrollChangedHandler(func(args *ScrollContainerScrollChangedEventArgs) {
+			eventArgs = args
+		}))
+
+	s.ScrollIntoView(img.Rect(0, 200, 100, 250))
+	event.ExecuteDeferred()
+	is.Equal(s.ScrollTop, 0.75)
+	is.True(eventArgs != nil)
+	is.Equal(eventArgs.ScrollTop, 0.75)
+
+	render(s, t)
+	s.ScrollIntoView(img.Rect(0, 0, 100, 50)) // content moved up by 150 pixels
+	is.Equal(s.ScrollTop, 0.75)
+
+	s.ScrollIntoView(img.Rect(0, -100, 100, -50))
+	is.Equal(s.ScrollTop, 0.25)
+}
+
+func TestScrollContainer_ScrollIntoView_Visible(t *testing.T) {
+	is := is.New(t)
+
+	s := newScrollContainer(t, NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.MinSize(100, 300))),
+		ScrollContainerOpts.ScrollChangedHandler(func(_ *ScrollContainerScrollChangedEventArgs) {
+			is.Fail() // received event even though nothing was scrolled
+		}))
+
+	s.ScrollIntoView(img.Rect(0, 20, 100, 80))
+	event.ExecuteDeferred()
+	is.Equal(s.ScrollTop, 0.0)
+}
+
+func TestScrollContainer_TouchDrag(t *testing.T) {
+	is := is.New(t)
+
+	clicks := 0
+	button := newButton(t,
+		ButtonOpts.WidgetOpts(WidgetOpts.MinSize(100, 300)),
+		ButtonOpts.ClickedHandler(func(_ *ButtonClickedEventArgs) {
+			clicks++
+		}))
+	content := NewContainer(ContainerOpts.Layout(NewRowLayout()))
+	content.AddChild(button)
+	s := newScrollContainer(t, content)
+	render(s, t)
+	touch, frame := useTouch(t, s)
+
+	touch(50, 90)
+	for y := 80; y >= 40; y -= 10 {
+		touch(50, y)
+	}
+	is.Equal(s.ScrollTop, 0.25) // 50 of 200 pixels
+
+	// The drag is not a click on the button, and the content keeps moving after the release.
+	frame()
+	is.Equal(clicks, 0)
+	for i := 0; i < 10; i++ {
+		frame()
+	}
+	is.True(s.ScrollTop > 0.3)
+
+	// Tapping still clicks.
+	touch(50, 50)
+	frame()
+	is.Equal(clicks, 1)
+}
+
+func TestScrollContainer_TouchDrag_Slider(t *testing.T) {
+	is := is.New(t)
+
+	slider := newSlider(t,
+		SliderOpts.WidgetOpts(WidgetOpts.MinSize(100, 20)),
+		SliderOpts.MinMax(0, 100),
+		SliderOpts.FixedHandleSize(10),
+		SliderOpts.PageSizeFunc(func() int {
+			return 1
+		}))
+	content := NewContainer(ContainerOpts.Layout(NewRowLayout(RowLayoutOpts.Direction(DirectionVertical))))
+	content.AddChild(slider)
+	content.AddChild(NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.MinSize(100, 280))))
+	s := newScrollContainer(t, content)
+	render(s, t)
+	touch, release := useTouch(t, s)
+
+	// Dragging the slider moves its handle, but not the content.
+	touch(20, 15)
+	for i := 1; i <= 4; i++ {
+		touch(20+i*15, 15-i*10)
+	}
+	release()
+	is.True(slider.Current > 1)
+	is.Equal(s.ScrollTop, 0.0)
+
+	// Dragging outside of the slider moves the content.
+	touch(50, 90)
+	for y := 80; y >= 40; y -= 10 {
+		touch(50, y)
+	}
+	is.Equal(s.ScrollTop, 0.25)
+}
+
+func TestScrollContainer_ScrollTo_Animated(t *testing.T) {
+	is := is.New(t)
+
+	events := 0
+	content := NewContainer(ContainerOpts.Layout(NewRowLayout(RowLayoutOpts.Direction(DirectionVertical))))
+	top, bottom := newSimpleWidget(100, 200, nil), newSimpleWidget(100, 100, nil)
+	content.AddChild(top, bottom)
+	s := newScrollContainer(t, content,
+		ScrollContainerOpts.SmoothScroll(100*time.Millisecond),
+		ScrollContainerOpts.ScrollChangedHandler(func(_ *ScrollContainerScrollChangedEventArgs) {
+			events++
+		}))
+	render(s, t)
+	_, frame := useFakeInput(t, s)
+
+	s.ScrollTo(bottom, true)
+	is.Equal(s.ScrollTop, 0.0)
+	_, target := s.ScrollTarget()
+	is.Equal(target, 1.0)
+	is.True(s.Scrolling())
+
+	frame()
+	frame()
+	is.True(s.ScrollTop > 0 && s.ScrollTop < 1)
+	for i := 0; i < 10; i++ {
+		frame()
+	}
+	is.Equal(s.ScrollTop, 1.0)
+	is.True(!s.Scrolling())
+	is.True(events > 2)
+
+	// Without animation, the content jumps.
+	s.ScrollTo(top, false)
+	is.Equal(s.ScrollTop, 0.0)
+	is.True(!s.Scrolling())
+}
+
+func TestScrollContainer_ScrollToPosition_SetDirectly(t *testing.T) {
+	is := is.New(t)
+
+	s := newScrollContainer(t, NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.MinSize(100, 300))))
+	_, frame := useFakeInput(t, s)
+
+	s.ScrollToPosition(0, 1, true)
+	frame()
+	frame()
+
+	// Setting ScrollTop stops the animation.
+	s.ScrollTop = 0.1
+	frame()
+	is.Equal(s.ScrollTop, 0.1)
+	is.True(!s.Scrolling())
+}
+
+func TestScrollContainer_DragToScroll(t *testing.T) {
+	is := is.New(t)
+
+	clicks := 0
+	button := newButton(t,
+		ButtonOpts.WidgetOpts(WidgetOpts.MinSize(100, 300)),
+		ButtonOpts.ClickedHandler(func(_ *ButtonClickedEventArgs) {
+			clicks++
+		}))
+	content := NewContainer(ContainerOpts.Layout(NewRowLayout()))
+	content.AddChild(button)
+	s := newScrollContainer(t, content, ScrollContainerOpts.DragToScroll())
+	render(s, t)
+	source, frame := useFakeInput(t, s)
+
+	source.SetCursorPosition(50, 90)
+	source.PressMouseButton(ebiten.MouseButtonLeft)
+	frame()
+	for y := 85; y >= 40; y -= 5 {
+		source.SetCursorPosition(50, y)
+		frame()
+	}
+	is.Equal(s.ScrollTop, 0.25) // 50 of 200 pixels
+
+	// The drag is not a click on the button, and the content keeps moving after the release.
+	source.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	frame()
+	is.Equal(clicks, 0)
+	for i := 0; i < 10; i++ {
+		frame()
+	}
+	is.True(s.ScrollTop > 0.3)
+
+	// Clicking without moving still clicks, and stops the content.
+	source.PressMouseButton(ebiten.MouseButtonLeft)
+	frame()
+	source.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	frame()
+	is.Equal(clicks, 1)
+}
+
+func TestScrollContainer_Overscroll(t *testing.T) {
+	is := is.New(t)
+
+	s := newScrollContainer(t, NewContainer(ContainerOpts.WidgetOpts(WidgetOpts.MinSize(100, 300))),
+		ScrollContainerOpts.Overscroll(40))
+	touch, release := useTouch(t, s)
+
+	// Dragging the content down at the top pulls it past its edge, but not further than 40 pixels.
+	touch(50, 10)
+	for y := 20; y <= 90; y += 10 {
+		touch(50, y)
+	}
+	is.Equal(s.ScrollTop, 0.0)
+	is.True(s.overscrollY < 0 && s.overscrollY >= -40)
+	// The content doesn't scroll horizontally, so the horizontal scroll stays put.
+	is.Equal(s.ScrollLeft, 0.0)
+	is.Equal(s.overscrollX, 0.0)
+	render(s, t)
+	is.True(s.ContentRect().Min.Y > 0)
+
+	// After the release, it bounces back.
+	release()
+	for i := 0; i < 60; i++ {
+		release()
+	}
+	is.Equal(s.overscrollY, 0.0)
+	is.True(!s.Scrolling())
+}
+
+func newScrollContainer(t *testing.T, content PreferredSizeLocateableWidget, opts ...ScrollContainerOpt) *ScrollContainer {
+	t.Helper()
+
+	s := NewScrollContainer(append(opts, []ScrollContainerOpt{
+		ScrollContainerOpts.Content(content),
+		ScrollContainerOpts.Image(&ScrollContainerImage{
+			Idle: newNineSliceEmpty(t),
+			Mask: newNineSliceEmpty(t),
+		}),
+	}...)...)
+	s.Validate()
+	s.SetLocation(img.Rect(0, 0, 100, 100))
+	event.ExecuteDeferred()
+	render(s, t)
+	return s
+}
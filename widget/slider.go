@@ -49,6 +49,7 @@ type Slider struct {
 	handlePressedOffsetX         int
 	handlePressedOffsetY         int
 	handlePressedInternalCurrent float64
+	panRemainder                 float64
 
 	tabOrder  int
 	justMoved bool
@@ -312,6 +313,19 @@ func (s *Slider) AddFocus(direction FocusDirection, focus Focuser) {
 
 /** Focuser Interface - End **/
 
+// HandlesDirection reports that the arrow keys along the slider's orientation change its value,
+// unless the default keys have been disabled.
+func (s *Slider) HandlesDirection(direction FocusDirection) bool {
+	s.init.Do()
+	if s.disableDefaultKeys {
+		return false
+	}
+	if *s.computedParams.Orientation == DirectionHorizontal {
+		return direction == FOCUS_WEST || direction == FOCUS_EAST
+	}
+	return direction == FOCUS_NORTH || direction == FOCUS_SOUTH
+}
+
 func (s *Slider) GetWidget() *Widget {
 	s.init.Do()
 	return s.widget
@@ -416,11 +430,12 @@ func (s *Slider) drawTrack(screen *ebiten.Image) {
 
 func (s *Slider) handleOrientation() {
 	if !s.disableDefaultKeys {
+		keymap := s.widget.Keymap()
 		if *s.computedParams.Orientation == DirectionHorizontal {
-			if input.KeyPressed(ebiten.KeyLeft) || input.KeyPressed(ebiten.KeyRight) {
+			if keymap.KeyPressed(KeyActionLeft) || keymap.KeyPressed(KeyActionRight) {
 				if !s.justMoved && s.handle.focused {
 					changeDir := 1
-					if input.KeyPressed(ebiten.KeyLeft) {
+					if keymap.KeyPressed(KeyActionLeft) {
 						changeDir = -1
 					}
 					s.Current += (changeDir * s.computedParams.PageSizeFunc())
@@ -430,10 +445,10 @@ func (s *Slider) handleOrientation() {
 				s.justMoved = false
 			}
 		} else {
-			if input.KeyPressed(ebiten.KeyUp) || input.KeyPressed(ebiten.KeyDown) {
+			if keymap.KeyPressed(KeyActionUp) || keymap.KeyPressed(KeyActionDown) {
 				if !s.justMoved && s.handle.focused {
 					changeDir := 1
-					if input.KeyPressed(ebiten.KeyUp) {
+					if keymap.KeyPressed(KeyActionUp) {
 						changeDir = -1
 					}
 					s.Current += (changeDir * s.computedParams.PageSizeFunc())
@@ -587,6 +602,26 @@ func (s *Slider) createWidget() {
 			}
 		}),
 
+		// Dragging the slider by touch moves the handle along, unless the handle itself is dragged.
+		WidgetOpts.PanHandler(func(args *WidgetPanEventArgs) {
+			if s.widget.Disabled || s.dragging || args.Phase == input.GesturePhaseInertia {
+				return
+			}
+			delta := args.DeltaX
+			if *s.computedParams.Orientation == DirectionVertical {
+				delta = args.DeltaY
+			}
+			handleLength, trackLength := s.handleLengthAndTrackLength()
+			if trackLength <= handleLength {
+				return
+			}
+			s.panRemainder += delta / (trackLength - handleLength) * float64(s.Max-s.Min)
+			steps := int(s.panRemainder)
+			s.panRemainder -= float64(steps)
+			s.Current += steps
+			s.clampCurrentMinMax()
+		}),
+
 		// TODO: keeping the mouse button pressed should move the handle repeatedly (in PageSize steps) until it stops under the cursor
 		WidgetOpts.MouseButtonPressedHandler(func(args *WidgetMouseButtonPressedEventArgs) {
 			if !s.widget.Disabled && args.Button == ebiten.MouseButtonLeft {
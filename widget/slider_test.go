@@ -1,6 +1,7 @@
 package widget
 
 import (
+	img "image"
 	"testing"
 
 	"github.com/ebitenui/ebitenui/event"
@@ -21,6 +22,28 @@ func TestSlider_Current_Initial(t *testing.T) {
 	is.Equal(eventArgs.Current, 10)
 }
 
+func TestSlider_TouchDrag(t *testing.T) {
+	is := is.New(t)
+
+	s := newSlider(t,
+		SliderOpts.MinMax(0, 100),
+		SliderOpts.FixedHandleSize(10),
+		SliderOpts.PageSizeFunc(func() int {
+			return 1
+		}))
+	s.SetLocation(img.Rect(0, 0, 110, 20))
+	render(s, t)
+	touch, _ := useTouch(t, s)
+
+	current := s.Current
+	touch(50, 10)
+	is.Equal(s.Current, current+1) // pressing the track moves by a page
+	for x := 60; x <= 100; x += 10 {
+		touch(x, 10)
+	}
+	is.Equal(s.Current, current+51) // 50 of 100 pixels the handle can move
+}
+
 func newSlider(t *testing.T, opts ...SliderOpt) *Slider {
 	s := NewSlider(append(opts, SliderOpts.Images(&SliderTrackImage{
 		Idle: newNineSliceEmpty(t),
@@ -48,9 +48,20 @@ func (o StackedLayoutOptions) Padding(i *Insets) StackedLayoutOpt {
 	}
 }
 
+// GetPadding implements PaddedLayouter.
+func (a *StackedLayout) GetPadding() *Insets {
+	return a.padding
+}
+
 // PreferredSize implements Layouter.
 func (a *StackedLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int, int) {
+	return a.PreferredSizeFor(widgets, 0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedLayouter.
+func (a *StackedLayout) PreferredSizeFor(widgets []PreferredSizeLocateableWidget, width int, height int) (int, int) {
 	px, py := a.padding.Dx(), a.padding.Dy()
+	maxWidth, maxHeight := a.padding.constrainedSize(width, height)
 
 	if len(widgets) == 0 {
 		return px, py
@@ -61,7 +72,7 @@ func (a *StackedLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (
 			continue
 		}
 
-		w1, h1 := widgets[idx].PreferredSize()
+		w1, h1 := PreferredSizeFor(widgets[idx], maxWidth, maxHeight)
 		if w1 > w {
 			w = w1
 		}
@@ -1 +1,26 @@
 package widget
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestStackedLayout_PreferredSizeFor(t *testing.T) {
+	is := is.New(t)
+
+	l := NewStackedLayout(StackedLayoutOpts.Padding(NewInsetsSimple(10)))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newWrappingWidget(100, 10, nil),
+		newWrappingWidget(60, 10, nil),
+	}
+
+	w, h := l.PreferredSizeFor(widgets, 0, 0)
+	is.Equal(w, 120)
+	is.Equal(h, 30)
+
+	w, h = l.PreferredSizeFor(widgets, 70, 0)
+	is.Equal(w, 70)
+	is.Equal(h, 40)
+}
@@ -0,0 +1,993 @@
+package widget
+
+import (
+	img "image"
+	"slices"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/constantutil"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+type TableParams struct {
+	// List configures the list that shows the rows. Unset fields fall back to the theme's ListTheme.
+	List *ListParams
+
+	HeaderFace      *text.Face
+	HeaderImage     *ButtonImage
+	HeaderTextColor *ButtonTextColor
+	HeaderPadding   *Insets
+	CellPadding     *Insets
+
+	// ResizeHandleWidth is the width of the area at the right edge of each header that can be dragged
+	// to resize the column.
+	ResizeHandleWidth *int
+	MinColumnWidth    *int
+
+	// SortAscendingIndicator and SortDescendingIndicator are appended to the header of the column
+	// that the rows are sorted by.
+	SortAscendingIndicator  *string
+	SortDescendingIndicator *string
+}
+
+// TableColumn defines a column of a Table.
+type TableColumn struct {
+	// Header is the text of the column's header.
+	Header string
+
+	// Width is the width of the column. If Stretch is set, it is the minimum width instead.
+	Width int
+
+	// Stretch makes the column share the width that is not taken by the other columns.
+	// A stretched column that is resized by the user keeps its new width.
+	Stretch bool
+
+	// CellLabelFunc returns the text of the cell in this column for entry e.
+	// It is only used if CellWidgetFunc is nil.
+	CellLabelFunc TableCellLabelFunc
+
+	// CellWidgetFunc returns the widget of the cell in this column for entry e.
+	CellWidgetFunc TableCellWidgetFunc
+
+	// SortFunc compares two entries by this column. If it is nil, the column cannot be sorted.
+	SortFunc TableSortFunc
+}
+
+type TableCellLabelFunc func(e any) string
+type TableCellWidgetFunc func(e any) PreferredSizeLocateableWidget
+type TableSortFunc func(a, b any) int
+
+type TableSortDirection int
+
+const (
+	TableSortNone TableSortDirection = iota
+	TableSortAscending
+	TableSortDescending
+)
+
+type Table struct {
+	definedParams  TableParams
+	computedParams TableParams
+
+	EntrySelectedEvent    *event.Event
+	SelectionChangedEvent *event.Event
+	SortChangedEvent      *event.Event
+	ColumnResizedEvent    *event.Event
+
+	widgetOpts  []WidgetOpt
+	listOpts    []ListOpt
+	columns     []*TableColumn
+	entries     []any
+	multiSelect bool
+	rowHeight   int
+	selected    []any
+	lead        any
+
+	init          *MultiOnce
+	container     *Container
+	header        *Container
+	headerButtons []*Button
+	list          *List
+
+	widths        []int
+	stretch       []bool
+	columnWidths  []int
+	sortColumn    int
+	sortDirection TableSortDirection
+
+	resizeColumn int
+	resizeStartX int
+	resizeWidth  int
+
+	tabOrder int
+	focusMap map[FocusDirection]Focuser
+}
+
+type TableOpt func(t *Table)
+
+type TableEntrySelectedEventArgs struct {
+	Table         *Table
+	Entry         any
+	PreviousEntry any
+}
+
+type TableEntrySelectedHandlerFunc func(args *TableEntrySelectedEventArgs)
+
+type TableSelectionChangedEventArgs struct {
+	Table   *Table
+	Added   []any
+	Removed []any
+}
+
+type TableSelectionChangedHandlerFunc func(args *TableSelectionChangedEventArgs)
+
+type TableSortChangedEventArgs struct {
+	Table     *Table
+	Column    int
+	Direction TableSortDirection
+}
+
+type TableSortChangedHandlerFunc func(args *TableSortChangedEventArgs)
+
+type TableColumnResizedEventArgs struct {
+	Table  *Table
+	Column int
+	Width  int
+}
+
+type TableColumnResizedHandlerFunc func(args *TableColumnResizedEventArgs)
+
+type TableOptions struct {
+}
+
+var TableOpts TableOptions
+
+// NewTable constructs a new Table. A Table shows entries in rows, one column per TableColumn,
+// below a header that sorts the rows when clicked and resizes the columns when its edges are dragged.
+// Rows are selected, focused and scrolled like the entries of a List.
+func NewTable(opts ...TableOpt) *Table {
+	t := &Table{
+		EntrySelectedEvent:    &event.Event{},
+		SelectionChangedEvent: &event.Event{},
+		SortChangedEvent:      &event.Event{},
+		ColumnResizedEvent:    &event.Event{},
+
+		init:         &MultiOnce{},
+		sortColumn:   -1,
+		resizeColumn: -1,
+		focusMap:     make(map[FocusDirection]Focuser),
+	}
+
+	t.init.Append(t.createWidget)
+
+	for _, o := range opts {
+		o(t)
+	}
+
+	return t
+}
+
+func (t *Table) Validate() {
+	t.init.Do()
+	t.populateComputedParams()
+
+	if len(t.columns) == 0 {
+		panic("Table: Columns are required.")
+	}
+	for _, c := range t.columns {
+		if c.CellLabelFunc == nil && c.CellWidgetFunc == nil {
+			panic("Table: CellLabelFunc or CellWidgetFunc is required for every column.")
+		}
+	}
+	if t.computedParams.HeaderImage == nil {
+		panic("Table: HeaderImage is required.")
+	}
+	if t.computedParams.HeaderFace == nil {
+		panic("Table: HeaderFace is required.")
+	}
+	if t.computedParams.HeaderTextColor == nil {
+		panic("Table: HeaderTextColor is required.")
+	}
+
+	t.initWidget()
+}
+
+func (t *Table) populateComputedParams() {
+	params := TableParams{}
+
+	theme := t.GetWidget().GetTheme()
+
+	// Set theme values
+	if theme != nil {
+		params.HeaderFace = theme.DefaultFace
+		if theme.TableTheme != nil {
+			params.List = theme.TableTheme.List
+			if theme.TableTheme.HeaderFace != nil {
+				params.HeaderFace = theme.TableTheme.HeaderFace
+			}
+			params.HeaderImage = theme.TableTheme.HeaderImage
+			params.HeaderTextColor = theme.TableTheme.HeaderTextColor
+			params.HeaderPadding = theme.TableTheme.HeaderPadding
+			params.CellPadding = theme.TableTheme.CellPadding
+			params.ResizeHandleWidth = theme.TableTheme.ResizeHandleWidth
+			params.MinColumnWidth = theme.TableTheme.MinColumnWidth
+			params.SortAscendingIndicator = theme.TableTheme.SortAscendingIndicator
+			params.SortDescendingIndicator = theme.TableTheme.SortDescendingIndicator
+		}
+	}
+
+	// Set definedParam values
+	if t.definedParams.List != nil {
+		params.List = t.definedParams.List
+	}
+	if t.definedParams.HeaderFace != nil {
+		params.HeaderFace = t.definedParams.HeaderFace
+	}
+	if t.definedParams.HeaderImage != nil {
+		params.HeaderImage = t.definedParams.HeaderImage
+	}
+	if t.definedParams.HeaderTextColor != nil {
+		params.HeaderTextColor = t.definedParams.HeaderTextColor
+	}
+	if t.definedParams.HeaderPadding != nil {
+		params.HeaderPadding = t.definedParams.HeaderPadding
+	}
+	if t.definedParams.CellPadding != nil {
+		params.CellPadding = t.definedParams.CellPadding
+	}
+	if t.definedParams.ResizeHandleWidth != nil {
+		params.ResizeHandleWidth = t.definedParams.ResizeHandleWidth
+	}
+	if t.definedParams.MinColumnWidth != nil {
+		params.MinColumnWidth = t.definedParams.MinColumnWidth
+	}
+	if t.definedParams.SortAscendingIndicator != nil {
+		params.SortAscendingIndicator = t.definedParams.SortAscendingIndicator
+	}
+	if t.definedParams.SortDescendingIndicator != nil {
+		params.SortDescendingIndicator = t.definedParams.SortDescendingIndicator
+	}
+
+	// Set defaults
+	if params.List == nil {
+		params.List = &ListParams{}
+	}
+	if params.HeaderPadding == nil {
+		params.HeaderPadding = &Insets{}
+	}
+	if params.CellPadding == nil {
+		params.CellPadding = &Insets{}
+	}
+	if params.ResizeHandleWidth == nil {
+		params.ResizeHandleWidth = constantutil.ConstantToPointer(6)
+	}
+	if params.MinColumnWidth == nil {
+		params.MinColumnWidth = constantutil.ConstantToPointer(20)
+	}
+	if params.SortAscendingIndicator == nil {
+		params.SortAscendingIndicator = constantutil.ConstantToPointer(" ^")
+	}
+	if params.SortDescendingIndicator == nil {
+		params.SortDescendingIndicator = constantutil.ConstantToPointer(" v")
+	}
+
+	t.computedParams = params
+}
+
+func (o TableOptions) WidgetOpts(opts ...WidgetOpt) TableOpt {
+	return func(t *Table) {
+		t.widgetOpts = append(t.widgetOpts, opts...)
+	}
+}
+
+// ListOpts adds options to the list that shows the rows, for example ListOpts.SliderParams.
+func (o TableOptions) ListOpts(opts ...ListOpt) TableOpt {
+	return func(t *Table) {
+		t.listOpts = append(t.listOpts, opts...)
+	}
+}
+
+// ListParams sets the parameters of the list that shows the rows.
+func (o TableOptions) ListParams(p *ListParams) TableOpt {
+	return func(t *Table) {
+		t.definedParams.List = p
+	}
+}
+
+func (o TableOptions) Columns(columns ...*TableColumn) TableOpt {
+	return func(t *Table) {
+		t.columns = append(t.columns, columns...)
+	}
+}
+
+func (o TableOptions) Entries(e []any) TableOpt {
+	return func(t *Table) {
+		t.entries = e
+	}
+}
+
+// MultiSelect allows selecting more than one row, like ListOpts.MultiSelect.
+func (o TableOptions) MultiSelect() TableOpt {
+	return func(t *Table) {
+		t.multiSelect = true
+	}
+}
+
+// Virtualized only creates widgets for the visible rows, like ListOpts.Virtualized.
+func (o TableOptions) Virtualized(rowHeight int) TableOpt {
+	return func(t *Table) {
+		t.rowHeight = rowHeight
+	}
+}
+
+func (o TableOptions) HeaderFace(face *text.Face) TableOpt {
+	return func(t *Table) {
+		t.definedParams.HeaderFace = face
+	}
+}
+
+func (o TableOptions) HeaderImage(image *ButtonImage) TableOpt {
+	return func(t *Table) {
+		t.definedParams.HeaderImage = image
+	}
+}
+
+func (o TableOptions) HeaderTextColor(color *ButtonTextColor) TableOpt {
+	return func(t *Table) {
+		t.definedParams.HeaderTextColor = color
+	}
+}
+
+func (o TableOptions) HeaderPadding(p *Insets) TableOpt {
+	return func(t *Table) {
+		t.definedParams.HeaderPadding = p
+	}
+}
+
+func (o TableOptions) CellPadding(p *Insets) TableOpt {
+	return func(t *Table) {
+		t.definedParams.CellPadding = p
+	}
+}
+
+func (o TableOptions) ResizeHandleWidth(w int) TableOpt {
+	return func(t *Table) {
+		t.definedParams.ResizeHandleWidth = &w
+	}
+}
+
+func (o TableOptions) MinColumnWidth(w int) TableOpt {
+	return func(t *Table) {
+		t.definedParams.MinColumnWidth = &w
+	}
+}
+
+// SortIndicators sets the texts that are appended to the header of the column the rows are sorted by.
+// The defaults are " ^" and " v".
+func (o TableOptions) SortIndicators(ascending string, descending string) TableOpt {
+	return func(t *Table) {
+		t.definedParams.SortAscendingIndicator = &ascending
+		t.definedParams.SortDescendingIndicator = &descending
+	}
+}
+
+// SortBy sorts the rows by column in direction when the table is created.
+func (o TableOptions) SortBy(column int, direction TableSortDirection) TableOpt {
+	return func(t *Table) {
+		t.sortColumn = column
+		t.sortDirection = direction
+	}
+}
+
+func (o TableOptions) TabOrder(tabOrder int) TableOpt {
+	return func(t *Table) {
+		t.tabOrder = tabOrder
+	}
+}
+
+func (o TableOptions) EntrySelectedHandler(f TableEntrySelectedHandlerFunc) TableOpt {
+	return func(t *Table) {
+		t.EntrySelectedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TableEntrySelectedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+func (o TableOptions) SelectionChangedHandler(f TableSelectionChangedHandlerFunc) TableOpt {
+	return func(t *Table) {
+		t.SelectionChangedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TableSelectionChangedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+func (o TableOptions) SortChangedHandler(f TableSortChangedHandlerFunc) TableOpt {
+	return func(t *Table) {
+		t.SortChangedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TableSortChangedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+func (o TableOptions) ColumnResizedHandler(f TableColumnResizedHandlerFunc) TableOpt {
+	return func(t *Table) {
+		t.ColumnResizedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TableColumnResizedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+/** Focuser Interface - Start **/
+
+func (t *Table) Focus(focused bool) {
+	t.init.Do()
+	t.GetWidget().FireFocusEvent(t, focused, img.Point{-1, -1})
+	if t.list != nil {
+		t.list.Focus(focused)
+	}
+}
+
+func (t *Table) IsFocused() bool {
+	return t.list != nil && t.list.IsFocused()
+}
+
+func (t *Table) TabOrder() int {
+	return t.tabOrder
+}
+
+func (t *Table) GetFocus(direction FocusDirection) Focuser {
+	return t.focusMap[direction]
+}
+
+func (t *Table) AddFocus(direction FocusDirection, focus Focuser) {
+	t.focusMap[direction] = focus
+}
+
+/** Focuser Interface - End **/
+
+// HandlesDirection reports that the up and down arrow keys move between rows,
+// unless the default keys have been disabled.
+func (t *Table) HandlesDirection(direction FocusDirection) bool {
+	return t.list != nil && t.list.HandlesDirection(direction)
+}
+
+// Activate selects the focused row.
+func (t *Table) Activate() {
+	if t.list != nil {
+		t.list.Activate()
+	}
+}
+
+func (t *Table) FocusNext() {
+	if t.list != nil {
+		t.list.FocusNext()
+	}
+}
+
+func (t *Table) FocusPrevious() {
+	if t.list != nil {
+		t.list.FocusPrevious()
+	}
+}
+
+func (t *Table) SelectFocused() {
+	if t.list != nil {
+		t.list.SelectFocused()
+	}
+}
+
+func (t *Table) GetWidget() *Widget {
+	t.init.Do()
+	return t.container.GetWidget()
+}
+
+func (t *Table) PreferredSize() (int, int) {
+	t.init.Do()
+	return t.container.PreferredSize()
+}
+
+func (t *Table) SetLocation(rect img.Rectangle) {
+	t.init.Do()
+	t.container.SetLocation(rect)
+}
+
+func (t *Table) RequestRelayout() {
+	t.init.Do()
+	t.container.RequestRelayout()
+}
+
+func (t *Table) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
+	t.init.Do()
+	t.container.SetupInputLayer(def)
+}
+
+func (t *Table) Render(screen *ebiten.Image) {
+	t.init.Do()
+	t.container.Render(screen)
+}
+
+func (t *Table) Update(updObj *UpdateObject) {
+	t.init.Do()
+
+	if t.resizeColumn >= 0 {
+		if input.MouseButtonPressed(ebiten.MouseButtonLeft) {
+			x, _ := input.CursorPosition()
+			t.resize(t.resizeColumn, t.resizeWidth+x-t.resizeStartX)
+		} else {
+			t.ColumnResizedEvent.Fire(&TableColumnResizedEventArgs{
+				Table:  t,
+				Column: t.resizeColumn,
+				Width:  t.widths[t.resizeColumn],
+			})
+			t.resizeColumn = -1
+		}
+	}
+
+	t.container.Update(updObj)
+}
+
+func (t *Table) createWidget() {
+	t.container = NewContainer(
+		ContainerOpts.WidgetOpts(t.widgetOpts...),
+		ContainerOpts.Layout(NewGridLayout(
+			GridLayoutOpts.Columns(1),
+			GridLayoutOpts.Stretch([]bool{true}, []bool{false, true}),
+		)),
+		ContainerOpts.AutoDisableChildren(),
+	)
+	t.widgetOpts = nil
+
+	t.widths = make([]int, len(t.columns))
+	t.stretch = make([]bool, len(t.columns))
+	for i, c := range t.columns {
+		t.widths[i] = c.Width
+		t.stretch[i] = c.Stretch
+	}
+}
+
+func (t *Table) initWidget() {
+	t.container.RemoveChildren()
+
+	if t.list != nil {
+		t.entries = t.list.Entries()
+		t.lead = t.list.SelectedEntry()
+		t.selected = t.list.SelectedEntries()
+	}
+
+	t.header = NewContainer(ContainerOpts.Layout(&tableRowLayout{table: t, header: true}))
+	t.headerButtons = make([]*Button, len(t.columns))
+	for i, c := range t.columns {
+		i := i
+		but := NewButton(
+			ButtonOpts.Image(t.computedParams.HeaderImage),
+			ButtonOpts.Text(c.Header, t.computedParams.HeaderFace, t.computedParams.HeaderTextColor),
+			ButtonOpts.TextPadding(t.computedParams.HeaderPadding),
+			ButtonOpts.TextPosition(TextPositionStart, TextPositionCenter),
+			ButtonOpts.ClickedHandler(func(_ *ButtonClickedEventArgs) {
+				t.headerClicked(i)
+			}),
+		)
+		t.headerButtons[i] = but
+		t.header.AddChild(but)
+
+		t.header.AddChild(NewContainer(ContainerOpts.WidgetOpts(
+			WidgetOpts.CursorHovered(input.CURSOR_EWRESIZE),
+			WidgetOpts.CursorPressed(input.CURSOR_EWRESIZE),
+			WidgetOpts.MouseButtonPressedHandler(func(args *WidgetMouseButtonPressedEventArgs) {
+				if args.Button == ebiten.MouseButtonLeft && !t.GetWidget().Disabled {
+					t.resizeColumn = i
+					t.resizeStartX, _ = input.CursorPosition()
+					t.resizeWidth = t.columnWidths[i]
+				}
+			}),
+		)))
+	}
+	t.updateHeaderLabels()
+	t.container.AddChild(t.header)
+
+	listOpts := []ListOpt{
+		ListOpts.HideHorizontalSlider(),
+		ListOpts.Entries(t.entries),
+		ListOpts.EntryWidgetFunc(t.createRow),
+		ListOpts.EntrySortFunc(t.sortFunc()),
+		ListOpts.EntrySelectedHandler(func(args *ListEntrySelectedEventArgs) {
+			t.EntrySelectedEvent.Fire(&TableEntrySelectedEventArgs{
+				Table:         t,
+				Entry:         args.Entry,
+				PreviousEntry: args.PreviousEntry,
+			})
+		}),
+		ListOpts.SelectionChangedHandler(func(args *ListSelectionChangedEventArgs) {
+			t.SelectionChangedEvent.Fire(&TableSelectionChangedEventArgs{
+				Table:   t,
+				Added:   args.Added,
+				Removed: args.Removed,
+			})
+		}),
+	}
+	if t.multiSelect {
+		listOpts = append(listOpts, ListOpts.MultiSelect())
+	}
+	if t.rowHeight > 0 {
+		listOpts = append(listOpts, ListOpts.Virtualized(t.rowHeight))
+	}
+	t.list = NewList(listOpts...)
+	t.list.definedParams = *t.computedParams.List
+	t.list.entryFaceRequired = slices.ContainsFunc(t.columns, func(c *TableColumn) bool {
+		return c.CellLabelFunc != nil
+	})
+	if t.list.definedParams.EntryTextHorizontalPosition == nil {
+		t.list.definedParams.EntryTextHorizontalPosition = constantutil.ConstantToPointer(TextPositionStart)
+	}
+	for _, o := range t.listOpts {
+		o(t.list)
+	}
+
+	// The list restores its selection when it is validated.
+	t.list.selectedEntry = t.lead
+	t.list.selected = make(map[any]bool, len(t.selected))
+	for _, e := range t.selected {
+		t.list.selected[e] = true
+	}
+	t.container.AddChild(t.list)
+	t.container.Validate()
+}
+
+// createRow creates the widget of the row for entry e.
+func (t *Table) createRow(e any) PreferredSizeLocateableWidget {
+	row := NewContainer(ContainerOpts.Layout(&tableRowLayout{table: t}))
+	for _, c := range t.columns {
+		if c.CellWidgetFunc != nil {
+			row.AddChild(c.CellWidgetFunc(e))
+			continue
+		}
+		cell := NewText(
+			TextOpts.Text(c.CellLabelFunc(e), t.list.computedParams.EntryFace, t.list.computedParams.EntryColor.Unselected),
+			TextOpts.Position(*t.list.computedParams.EntryTextHorizontalPosition, *t.list.computedParams.EntryTextVerticalPosition),
+		)
+		cell.listEntryColor = true
+		row.AddChild(cell)
+	}
+	return row
+}
+
+func (t *Table) headerClicked(column int) {
+	if t.columns[column].SortFunc == nil {
+		return
+	}
+	direction := TableSortAscending
+	if t.sortColumn == column && t.sortDirection == TableSortAscending {
+		direction = TableSortDescending
+	}
+	t.SortBy(column, direction)
+}
+
+// SortBy sorts the rows by column in direction. The rows go back to the order they were added in
+// if direction is TableSortNone.
+func (t *Table) SortBy(column int, direction TableSortDirection) {
+	t.init.Do()
+	if column < 0 || column >= len(t.columns) || t.columns[column].SortFunc == nil {
+		column, direction = -1, TableSortNone
+	}
+	if direction == TableSortNone {
+		column = -1
+	}
+	if column == t.sortColumn && direction == t.sortDirection {
+		return
+	}
+	t.sortColumn, t.sortDirection = column, direction
+
+	if t.list != nil {
+		t.list.entrySortFunc = t.sortFunc()
+		t.list.replaceEntries(t.entries)
+		t.updateHeaderLabels()
+	}
+
+	t.SortChangedEvent.Fire(&TableSortChangedEventArgs{
+		Table:     t,
+		Column:    column,
+		Direction: direction,
+	})
+}
+
+// SortColumn returns the column the rows are sorted by and the direction, or -1 and TableSortNone
+// if they are not sorted.
+func (t *Table) SortColumn() (int, TableSortDirection) {
+	return t.sortColumn, t.sortDirection
+}
+
+func (t *Table) sortFunc() ListEntrySortFunc {
+	if t.sortColumn < 0 || t.sortColumn >= len(t.columns) {
+		return nil
+	}
+	f := t.columns[t.sortColumn].SortFunc
+	if f == nil {
+		return nil
+	}
+	if t.sortDirection == TableSortDescending {
+		return func(a, b any) int {
+			return f(b, a)
+		}
+	}
+	return ListEntrySortFunc(f)
+}
+
+func (t *Table) updateHeaderLabels() {
+	for i, c := range t.columns {
+		label := c.Header
+		if i == t.sortColumn {
+			switch t.sortDirection {
+			case TableSortAscending:
+				label += *t.computedParams.SortAscendingIndicator
+			case TableSortDescending:
+				label += *t.computedParams.SortDescendingIndicator
+			}
+		}
+		t.headerButtons[i].SetText(label)
+	}
+}
+
+// ColumnWidth returns the current width of column, or 0 if there is no such column.
+func (t *Table) ColumnWidth(column int) int {
+	t.init.Do()
+	if column < 0 || column >= len(t.columns) {
+		return 0
+	}
+	if column < len(t.columnWidths) {
+		return t.columnWidths[column]
+	}
+	return t.widths[column]
+}
+
+// SetColumnWidth sets the width of column. A stretched column stops stretching.
+// Nothing happens if there is no such column.
+func (t *Table) SetColumnWidth(column int, width int) {
+	t.init.Do()
+	t.resize(column, width)
+}
+
+func (t *Table) resize(column int, width int) {
+	if column < 0 || column >= len(t.columns) {
+		return
+	}
+	width = max(width, *t.computedParams.MinColumnWidth)
+	if !t.stretch[column] && t.widths[column] == width {
+		return
+	}
+	t.widths[column] = width
+	t.stretch[column] = false
+	if t.header != nil {
+		t.header.RequestRelayout()
+	}
+}
+
+// updateColumnWidths computes the widths of the columns for a table that has total width available for them.
+func (t *Table) updateColumnWidths(total int) {
+	minWidth := *t.computedParams.MinColumnWidth
+	widths := make([]int, len(t.columns))
+	stretched := 0
+	rest := total
+	for i := range t.columns {
+		if t.stretch[i] {
+			stretched++
+			continue
+		}
+		widths[i] = max(t.widths[i], minWidth)
+		rest -= widths[i]
+	}
+	for i := range t.columns {
+		if !t.stretch[i] {
+			continue
+		}
+		w := rest / stretched
+		rest -= w
+		stretched--
+		widths[i] = max(w, t.widths[i], minWidth)
+	}
+
+	changed := len(widths) != len(t.columnWidths)
+	for i := range widths {
+		changed = changed || widths[i] != t.columnWidths[i]
+	}
+	t.columnWidths = widths
+	if changed && t.list != nil && t.list.listContent != nil {
+		t.list.listContent.RequestRelayout()
+	}
+}
+
+// rowInset returns how far the rows are inset from the left and right edges of the table.
+func (t *Table) rowInset() (int, int) {
+	if t.list == nil {
+		return 0, 0
+	}
+	p := t.list.computedParams.ScrollContainerPadding
+	left, right := 0, 0
+	if p != nil {
+		left, right = p.Left, p.Right
+	}
+	if e := t.list.computedParams.EntryTextPadding; e != nil {
+		left += e.Left
+		right += e.Right
+	}
+	if t.list.vSlider != nil {
+		if !t.list.container.IsValidated() {
+			t.list.container.Validate()
+		}
+		w, _ := t.list.vSlider.PreferredSize()
+		right += w + *t.list.computedParams.ControlWidgetSpacing
+	}
+	return left, right
+}
+
+// List returns the list that shows the rows. It is recreated when the table is validated.
+func (t *Table) List() *List {
+	t.init.Do()
+	return t.list
+}
+
+// Entries returns the entries in the order they are shown.
+func (t *Table) Entries() []any {
+	t.init.Do()
+	if t.list == nil {
+		return t.entries
+	}
+	return t.list.Entries()
+}
+
+func (t *Table) SetEntries(entries []any) {
+	t.init.Do()
+	t.entries = entries
+	t.lead, t.selected = nil, nil
+	if t.list != nil {
+		t.list.SetEntries(entries)
+	}
+}
+
+func (t *Table) AddEntry(entry any) {
+	t.init.Do()
+	t.entries = append(t.entries, entry)
+	if t.list != nil {
+		t.list.AddEntry(entry)
+	}
+}
+
+func (t *Table) RemoveEntry(entry any) {
+	t.init.Do()
+	for i, e := range t.entries {
+		if e == entry {
+			t.entries = append(t.entries[:i:i], t.entries[i+1:]...)
+			break
+		}
+	}
+	if t.list != nil {
+		t.list.RemoveEntry(entry)
+	}
+}
+
+// UpdateEntry recreates the row of entry. The rows are not sorted again.
+func (t *Table) UpdateEntry(entry any) {
+	t.init.Do()
+	if t.list != nil {
+		t.list.UpdateEntry(entry)
+	}
+}
+
+func (t *Table) SelectedEntry() any {
+	t.init.Do()
+	if t.list == nil {
+		return t.lead
+	}
+	return t.list.SelectedEntry()
+}
+
+func (t *Table) SetSelectedEntry(entry any) {
+	t.init.Do()
+	t.lead, t.selected = entry, []any{entry}
+	if t.list != nil {
+		t.list.SetSelectedEntry(entry)
+	}
+}
+
+func (t *Table) SelectedEntries() []any {
+	t.init.Do()
+	if t.list == nil {
+		return t.selected
+	}
+	return t.list.SelectedEntries()
+}
+
+func (t *Table) SetSelectedEntries(entries []any) {
+	t.init.Do()
+	t.selected = entries
+	t.lead = nil
+	if len(entries) > 0 {
+		t.lead = entries[len(entries)-1]
+	}
+	if t.list != nil {
+		t.list.SetSelectedEntries(entries)
+	}
+}
+
+// tableRowLayout lays out the header or a row of a table, one widget per column.
+// The header also has a resize handle after each header button.
+type tableRowLayout struct {
+	table  *Table
+	header bool
+}
+
+func (r *tableRowLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int, int) {
+	t := r.table
+	padding := t.computedParams.CellPadding
+	if r.header {
+		padding = &Insets{}
+	}
+
+	w, h := 0, 0
+	for i := range t.columns {
+		w += max(t.widths[i], *t.computedParams.MinColumnWidth)
+		cell := r.cell(widgets, i)
+		if cell == nil {
+			continue
+		}
+		_, ch := cell.PreferredSize()
+		h = max(h, ch+padding.Top+padding.Bottom)
+	}
+	if r.header {
+		left, right := t.rowInset()
+		w += left + right
+	}
+	return w, h
+}
+
+func (r *tableRowLayout) Layout(widgets []PreferredSizeLocateableWidget, rect img.Rectangle) {
+	t := r.table
+	padding := t.computedParams.CellPadding
+	x := rect.Min.X
+	if r.header {
+		padding = &Insets{}
+		left, right := t.rowInset()
+		x += left
+		t.updateColumnWidths(rect.Dx() - left - right)
+	} else if len(t.columnWidths) != len(t.columns) {
+		t.updateColumnWidths(rect.Dx())
+	}
+
+	for i := range t.columns {
+		w := t.columnWidths[i]
+		cellRect := img.Rect(x, rect.Min.Y, x+w, rect.Max.Y)
+		if r.header {
+			handle := min(*t.computedParams.ResizeHandleWidth, w)
+			widgets[i*2+1].SetLocation(img.Rect(cellRect.Max.X-handle, cellRect.Min.Y, cellRect.Max.X, cellRect.Max.Y))
+			cellRect.Max.X -= handle
+			if i == 0 {
+				// The first header also covers the inset of the rows, so that the header starts at the edge of the table.
+				cellRect.Min.X = rect.Min.X
+			}
+		}
+		if cell := r.cell(widgets, i); cell != nil {
+			cell.SetLocation(padding.Apply(cellRect))
+		}
+		x += w
+	}
+}
+
+func (r *tableRowLayout) cell(widgets []PreferredSizeLocateableWidget, column int) PreferredSizeLocateableWidget {
+	if r.header {
+		column *= 2
+	}
+	if column < len(widgets) {
+		return widgets[column]
+	}
+	return nil
+}
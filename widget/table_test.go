@@ -0,0 +1,253 @@
+package widget
+
+import (
+	"cmp"
+	img "image"
+	"image/color"
+	"strconv"
+	"testing"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+type tableTestScore struct {
+	name   string
+	points int
+}
+
+func TestTable_Columns(t *testing.T) {
+	is := is.New(t)
+
+	table := newTable(t)
+
+	is.Equal(table.ColumnWidth(0), 20)
+	is.Equal(table.ColumnWidth(1), 180-table.list.vSlider.GetWidget().Rect.Dx())
+
+	row := table.list.buttons[0].content.(*Container)
+	is.Equal(row.Children()[0].(*Text).Label, "carol")
+	is.Equal(row.Children()[1].(*Text).Label, "30")
+	is.Equal(row.Children()[1].GetWidget().Rect.Min.X, 20)
+	is.Equal(table.headerButtons[1].GetWidget().Rect.Min.X, 20)
+}
+
+func TestTable_Sort(t *testing.T) {
+	is := is.New(t)
+
+	var sorts []*TableSortChangedEventArgs
+	table := newTable(t, TableOpts.SortChangedHandler(func(args *TableSortChangedEventArgs) {
+		sorts = append(sorts, args)
+	}))
+	table.SetSelectedEntry(scores[1])
+	event.ExecuteDeferred()
+
+	leftMouseButtonClick(table.headerButtons[1], t)
+	is.Equal(table.Entries(), []any{scores[1], scores[2], scores[0]})
+	is.Equal(table.headerButtons[1].Text().Label, "points ^")
+	is.Equal(table.SelectedEntry(), scores[1])
+	is.Equal(table.list.focusIndex, 0)
+
+	leftMouseButtonClick(table.headerButtons[1], t)
+	is.Equal(table.Entries(), []any{scores[0], scores[2], scores[1]})
+	is.Equal(table.headerButtons[1].Text().Label, "points v")
+	is.Equal(sorts[1].Direction, TableSortDescending)
+
+	// The name column has no SortFunc.
+	leftMouseButtonClick(table.headerButtons[0], t)
+	is.Equal(len(sorts), 2)
+
+	table.SortBy(-1, TableSortNone)
+	is.Equal(table.Entries(), []any{scores[0], scores[1], scores[2]})
+	is.Equal(table.headerButtons[1].Text().Label, "points")
+}
+
+func TestTable_Resize(t *testing.T) {
+	is := is.New(t)
+
+	source := input.NewFakeSource()
+	input.SetSource(source)
+	t.Cleanup(func() { input.SetSource(nil) })
+
+	var resized *TableColumnResizedEventArgs
+	table := newTable(t, TableOpts.ColumnResizedHandler(func(args *TableColumnResizedEventArgs) {
+		resized = args
+	}))
+	stretched := table.ColumnWidth(1)
+
+	handle := table.header.Children()[1]
+	is.Equal(handle.GetWidget().Rect, img.Rect(14, 0, 20, table.header.GetWidget().Rect.Dy()))
+
+	source.SetCursorPosition(17, 5)
+	source.PressMouseButton(ebiten.MouseButtonLeft)
+	input.Update()
+	leftMouseButtonPress(handle, t)
+
+	source.SetCursorPosition(47, 5)
+	input.Update()
+	table.Update(&UpdateObject{})
+	render(table, t)
+	is.Equal(table.ColumnWidth(0), 50)
+	is.Equal(table.ColumnWidth(1), stretched-30)
+
+	// Columns that don't exist are ignored.
+	table.SetColumnWidth(-1, 50)
+	table.SetColumnWidth(5, 50)
+	is.Equal(table.ColumnWidth(-1), 0)
+	is.Equal(table.ColumnWidth(5), 0)
+
+	row := table.list.buttons[0].content.(*Container)
+	is.Equal(row.Children()[1].GetWidget().Rect.Min.X, 50)
+
+	source.ReleaseMouseButton(ebiten.MouseButtonLeft)
+	input.Update()
+	table.Update(&UpdateObject{})
+	event.ExecuteDeferred()
+	is.Equal(resized.Column, 0)
+	is.Equal(resized.Width, 50)
+}
+
+func TestTable_MultiSelect(t *testing.T) {
+	is := is.New(t)
+
+	var changes []*TableSelectionChangedEventArgs
+	table := newTable(t,
+		TableOpts.MultiSelect(),
+		TableOpts.SelectionChangedHandler(func(args *TableSelectionChangedEventArgs) {
+			changes = append(changes, args)
+		}),
+	)
+
+	table.SetSelectedEntries([]any{scores[0], scores[2]})
+	event.ExecuteDeferred()
+	is.Equal(table.SelectedEntries(), []any{scores[0], scores[2]})
+	is.Equal(len(changes), 1)
+
+	// Validating again keeps the selection.
+	render(table, t)
+	is.Equal(table.SelectedEntries(), []any{scores[0], scores[2]})
+}
+
+var scores = []any{
+	&tableTestScore{name: "carol", points: 30},
+	&tableTestScore{name: "alice", points: 10},
+	&tableTestScore{name: "bob", points: 20},
+}
+
+func newTable(t *testing.T, opts ...TableOpt) *Table {
+	t.Helper()
+
+	table := NewTable(append([]TableOpt{
+		TableOpts.Columns(
+			&TableColumn{
+				Header: "name",
+				Width:  20,
+				CellLabelFunc: func(e any) string {
+					return e.(*tableTestScore).name
+				},
+			},
+			&TableColumn{
+				Header:  "points",
+				Stretch: true,
+				CellLabelFunc: func(e any) string {
+					return strconv.Itoa(e.(*tableTestScore).points)
+				},
+				SortFunc: func(a, b any) int {
+					return cmp.Compare(a.(*tableTestScore).points, b.(*tableTestScore).points)
+				},
+			},
+		),
+		TableOpts.Entries(scores),
+		TableOpts.HeaderImage(&ButtonImage{
+			Idle:    newNineSliceEmpty(t),
+			Pressed: newNineSliceEmpty(t),
+		}),
+		TableOpts.HeaderFace(loadFont(t)),
+		TableOpts.HeaderTextColor(&ButtonTextColor{Idle: color.White}),
+		TableOpts.ListParams(&ListParams{
+			EntryFace: loadFont(t),
+			EntryColor: &ListEntryColor{
+				Unselected:         color.White,
+				Selected:           color.Black,
+				DisabledUnselected: color.Gray{Y: 0x80},
+				DisabledSelected:   color.Gray{Y: 0x40},
+				SelectedBackground: color.Transparent,
+			},
+			ScrollContainerImage: &ScrollContainerImage{
+				Idle:     newNineSliceEmpty(t),
+				Disabled: newNineSliceEmpty(t),
+				Mask:     newNineSliceEmpty(t),
+			},
+			Slider: &SliderParams{
+				TrackImage: &SliderTrackImage{},
+				HandleImage: &ButtonImage{
+					Idle:    newNineSliceEmpty(t),
+					Pressed: newNineSliceEmpty(t),
+				},
+			},
+		}),
+	}, opts...)...)
+	event.ExecuteDeferred()
+
+	table.SetLocation(img.Rect(0, 0, 200, 200))
+	render(table, t)
+	render(table, t)
+	return table
+}
+
+func TestTable_CellColors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []TableOpt
+	}{
+		{"buttons", nil},
+		{"virtualized", []TableOpt{TableOpts.Virtualized(20)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			is := is.New(t)
+
+			table := newTable(t, tc.opts...)
+			renderList(table.list)
+			cell := func(row int) color.Color {
+				b := table.list.buttons
+				if table.list.virtualized {
+					b = table.list.rows
+				}
+				return FindAll(b[row].content, func(w HasWidget) bool {
+					_, ok := w.(*Text)
+					return ok
+				})[0].(*Text).computedParams.Color
+			}
+
+			table.SetSelectedEntry(scores[1])
+			renderList(table.list)
+			is.Equal(cell(0), color.White)
+			is.Equal(cell(1), color.Black)
+
+			table.GetWidget().Disabled = true
+			render(table, t)
+			renderList(table.list)
+			is.Equal(cell(0), color.Gray{Y: 0x80})
+			is.Equal(cell(1), color.Gray{Y: 0x40})
+		})
+	}
+}
+
+func TestTable_Virtualized(t *testing.T) {
+	is := is.New(t)
+
+	table := newTable(t, TableOpts.Virtualized(20))
+	renderList(table.list)
+
+	row := table.list.rowContents[0].Children()[0].(*Container)
+	is.Equal(row.Children()[0].(*Text).Label, "carol")
+
+	table.SetColumnWidth(0, 40)
+	render(table, t)
+	renderList(table.list)
+	row = table.list.rowContents[0].Children()[0].(*Container)
+	is.Equal(row.Children()[1].GetWidget().Rect.Min.X, 40)
+}
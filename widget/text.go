@@ -47,6 +47,8 @@ type Text struct {
 	linkStack    *datastructures.Stack[linkData]
 	currentLink  *bbCodeText
 	previousLink *bbCodeText
+	// listEntryColor is set for the labels of List entries, which take the text color of their entry.
+	listEntryColor bool
 
 	LinkClickedEvent       *event.Event
 	LinkCursorEnteredEvent *event.Event
@@ -358,8 +360,14 @@ func (t *Text) SetLocation(rect image.Rectangle) {
 }
 
 func (t *Text) PreferredSize() (int, int) {
+	return t.PreferredSizeFor(0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedPreferredSizer. If MaxWidth is set and width is narrower,
+// the text is wrapped at width instead, so it grows taller rather than wider than the space given.
+func (t *Text) PreferredSizeFor(width int, _ int) (int, int) {
 	t.init.Do()
-	t.measure()
+	t.measure(t.wrapWidth(width))
 	w := int(math.Ceil(t.measurements.boundingBoxWidth)) + t.computedParams.Padding.Left + t.computedParams.Padding.Right
 	h := int(math.Ceil(t.measurements.boundingBoxHeight)) + t.computedParams.Padding.Top + t.computedParams.Padding.Bottom
 
@@ -484,9 +492,9 @@ func (t *Text) handleLinkEvents() {
 }
 
 func (t *Text) draw(screen *ebiten.Image) {
-	t.measure()
-
 	r := t.widget.Rect
+	t.measure(t.wrapWidth(r.Dx()))
+
 	w := r.Dx()
 	p := r.Min
 
@@ -670,8 +678,31 @@ func (t *Text) processTree(node *bbcode.BBCodeNode, newColor color.Color, linkVa
 	return result, newColor, linkVal
 }
 
-func (t *Text) measure() {
-	if t.Label == t.measurements.label && t.computedParams.Face == t.measurements.face && t.MaxWidth == t.measurements.maxWidth && t.ProcessBBCode == t.measurements.ProcessBBCode {
+// wrapWidth returns the width to wrap the text at when it is given width pixels. The text is only wrapped
+// narrower than MaxWidth if it does not fit into width otherwise.
+func (t *Text) wrapWidth(width int) float64 {
+	if t.MaxWidth <= 0 || width <= 0 || float64(width) >= t.MaxWidth {
+		return t.MaxWidth
+	}
+	if t.measured(float64(width)) {
+		return float64(width)
+	}
+
+	t.measure(t.MaxWidth)
+	if int(math.Ceil(t.measurements.boundingBoxWidth)) <= width {
+		return t.MaxWidth
+	}
+	return float64(width)
+}
+
+// measured returns whether the current measurements are for the text wrapped at maxWidth.
+func (t *Text) measured(maxWidth float64) bool {
+	return t.Label == t.measurements.label && t.computedParams.Face == t.measurements.face && maxWidth == t.measurements.maxWidth && t.ProcessBBCode == t.measurements.ProcessBBCode
+}
+
+// measure lays out the text into lines no wider than maxWidth, or not wrapped at all if maxWidth is 0.
+func (t *Text) measure(maxWidth float64) {
+	if t.measured(maxWidth) {
 		return
 	}
 	m := (*t.computedParams.Face).Metrics()
@@ -680,7 +711,7 @@ func (t *Text) measure() {
 		face:          t.computedParams.Face,
 		ProcessBBCode: t.ProcessBBCode,
 		ascent:        m.HAscent,
-		maxWidth:      t.MaxWidth,
+		maxWidth:      maxWidth,
 	}
 
 	sWidth, sHeight := text.Measure(" ", *t.measurements.face, 0)
@@ -691,7 +722,7 @@ func (t *Text) measure() {
 
 	s := bufio.NewScanner(strings.NewReader(t.Label))
 	for s.Scan() {
-		if t.MaxWidth > 0 || t.ProcessBBCode {
+		if maxWidth > 0 || t.ProcessBBCode {
 			var newLine []*bbCodeText
 			newLineWidth := float64(t.computedParams.Padding.Left + t.computedParams.Padding.Right)
 
@@ -709,7 +740,7 @@ func (t *Text) measure() {
 					}
 
 					// If the new word doesn't push this past the max width continue adding to the current line
-					if t.MaxWidth == 0 || newLineWidth+wordWidth < t.MaxWidth {
+					if maxWidth == 0 || newLineWidth+wordWidth < maxWidth {
 						wordBlock := bbCodeText{text: word, color: blocks[idx].color, linkValue: blocks[idx].linkValue}
 						if i != len(words)-1 {
 							wordBlock.text += " "
@@ -383,8 +383,22 @@ func (l *TextArea) GetWidget() *Widget {
 }
 
 func (l *TextArea) PreferredSize() (int, int) {
+	return l.PreferredSizeFor(0, 0)
+}
+
+// PreferredSizeFor implements ConstrainedPreferredSizer. If width is limited, the text is wrapped at width,
+// as it will be once the text area is rendered at that width.
+func (l *TextArea) PreferredSizeFor(width int, height int) (int, int) {
 	l.init.Do()
-	w, h := l.container.PreferredSize()
+	if width > 0 {
+		// Only measure at width, the text keeps wrapping at the width of the text area it is rendered at.
+		maxWidth := l.text.MaxWidth
+		l.text.MaxWidth = float64(width)
+		defer func() {
+			l.text.MaxWidth = maxWidth
+		}()
+	}
+	w, h := l.container.PreferredSizeFor(width, height)
 
 	if l.container.widget != nil && h < l.container.widget.MinHeight {
 		h = l.container.widget.MinHeight
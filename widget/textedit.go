@@ -0,0 +1,511 @@
+package widget
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/clock"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type textInputState func() (textInputState, bool)
+
+type textInputControlCommand int
+
+type textInputCommandFunc func()
+
+// textEdit is the editing core shared by TextInput and TextEditor. It holds the text, the caret
+// position, the selection and the undo history, validates edits and runs the commands bound to
+// keys. The widgets add rendering, mouse handling and caret movement between lines on top of it.
+type textEdit struct {
+	text     string
+	lastText string
+	cursor   int
+	// anchor is the rune index the selection extends from to the cursor, or -1 if nothing is selected.
+	anchor         int
+	validationFunc TextInputValidationFunc
+	history        textHistory
+	clipboard      Clipboard
+	commandToFunc  map[textInputControlCommand]textInputCommandFunc
+	state          textInputState
+
+	// moved is called after the caret was moved, whether by an edit or by a caret movement.
+	moved func()
+}
+
+func newTextEdit(moved func()) *textEdit {
+	return &textEdit{
+		anchor:        -1,
+		history:       textHistory{depth: 100},
+		commandToFunc: map[textInputControlCommand]textInputCommandFunc{},
+		moved:         moved,
+	}
+}
+
+// update runs the key handling states until one of them does not ask to be rerun.
+func (e *textEdit) update() {
+	for {
+		newState, rerun := e.state()
+		if newState != nil {
+			e.state = newState
+		}
+		if !rerun {
+			break
+		}
+	}
+}
+
+func (e *textEdit) executeCommand(cmd textInputControlCommand) {
+	if f, ok := e.commandToFunc[cmd]; ok {
+		f()
+	}
+}
+
+// validate returns s, or its replacement, if the validation function accepts it.
+func (e *textEdit) validate(s string) (string, bool) {
+	if e.validationFunc == nil {
+		return s, true
+	}
+
+	result, replacement := e.validationFunc(s)
+	switch {
+	case result:
+		return s, true
+	case replacement != nil:
+		return *replacement, true
+	}
+	return s, false
+}
+
+// revalidate validates the text if it was changed since the last update, restoring the last text
+// if it is rejected.
+func (e *textEdit) revalidate() {
+	if e.text == e.lastText {
+		return
+	}
+	if s, ok := e.validate(e.text); ok {
+		e.text = s
+	} else {
+		e.text = e.lastText
+	}
+}
+
+// setText replaces the text, keeping the last text if text is rejected, and records the change as
+// an edit of the given kind.
+func (e *textEdit) setText(text string, kind textHistoryKind) {
+	before := e.historyState()
+	e.anchor = -1
+	if s, ok := e.validate(text); ok {
+		e.text = s
+	} else {
+		e.text = e.lastText
+	}
+	e.recordHistory(before, kind)
+}
+
+// insert replaces the selection with c, or inserts c at the caret if nothing is selected.
+func (e *textEdit) insert(c string) {
+	before := e.historyState()
+	e.deleteSelection()
+
+	// Typing a space after a word starts a new undo step, so that words are undone one by one.
+	if r := []rune(c); len(r) > 0 && unicode.IsSpace(r[0]) && e.cursor > 0 && !unicode.IsSpace([]rune(e.text)[e.cursor-1]) {
+		e.history.breakCoalescing()
+	}
+
+	s, ok := e.validate(string(insertChars([]rune(e.text), []rune(c), e.cursor)))
+	if !ok {
+		e.recordHistory(before, textHistoryOther)
+		return
+	}
+	e.text = s
+	e.recordHistory(before, textHistoryTyping)
+
+	e.cursor = min(e.cursor+len([]rune(c)), len([]rune(e.text)))
+	e.moved()
+}
+
+// backspace removes the selection, or the character left of the caret if nothing is selected.
+func (e *textEdit) backspace() {
+	before := e.historyState()
+	if e.anchor != -1 {
+		e.deleteSelection()
+		e.recordHistory(before, textHistoryOther)
+	} else if e.cursor > 0 {
+		e.text = string(removeChar([]rune(e.text), e.cursor-1))
+		e.cursor--
+		e.recordHistory(before, textHistoryDeleting)
+	}
+}
+
+// delete removes the selection, or the character right of the caret if nothing is selected.
+func (e *textEdit) delete() {
+	before := e.historyState()
+	if e.anchor != -1 {
+		e.deleteSelection()
+		e.recordHistory(before, textHistoryOther)
+	} else if e.cursor < len([]rune(e.text)) {
+		e.text = string(removeChar([]rune(e.text), e.cursor))
+		e.recordHistory(before, textHistoryDeleting)
+	}
+}
+
+// deleteSelectedText removes the selection as an edit of its own.
+func (e *textEdit) deleteSelectedText() {
+	before := e.historyState()
+	e.deleteSelection()
+	e.recordHistory(before, textHistoryOther)
+}
+
+// deleteSelection removes the selection without recording it in the history.
+func (e *textEdit) deleteSelection() {
+	if e.anchor == -1 {
+		return
+	}
+
+	start, end := e.selectionRange()
+	r := []rune(e.text)
+	e.text = string(append(r[:start:start], r[end:]...))
+	e.cursor = start
+	e.anchor = -1
+	e.moved()
+}
+
+// selectionRange returns the start and end of the selection, which are both the caret position if
+// nothing is selected.
+func (e *textEdit) selectionRange() (int, int) {
+	if e.anchor == -1 {
+		return e.cursor, e.cursor
+	}
+	return min(e.anchor, e.cursor), max(e.anchor, e.cursor)
+}
+
+func (e *textEdit) selectedText() string {
+	start, end := e.selectionRange()
+	return string([]rune(e.text)[start:end])
+}
+
+func (e *textEdit) selectAll() {
+	if len(e.text) > 0 {
+		e.anchor = 0
+		e.cursor = len([]rune(e.text))
+		e.moved()
+	}
+}
+
+// moveCursor moves the caret to rune index pos. If extend is set the selection is extended
+// to pos, otherwise it is removed.
+func (e *textEdit) moveCursor(pos int, extend bool) {
+	pos = max(0, min(pos, len([]rune(e.text))))
+	if extend {
+		if e.anchor == -1 {
+			e.anchor = e.cursor
+		}
+		if e.anchor == pos {
+			e.anchor = -1
+		}
+	} else {
+		e.anchor = -1
+	}
+	e.cursor = pos
+	e.history.breakCoalescing()
+	e.moved()
+}
+
+// moveLeft moves the caret one character to the left, or to the start of the selection.
+func (e *textEdit) moveLeft() {
+	if e.anchor != -1 {
+		e.moveCursor(min(e.anchor, e.cursor), false)
+		return
+	}
+	e.moveCursor(e.cursor-1, false)
+}
+
+// moveRight moves the caret one character to the right, or to the end of the selection.
+func (e *textEdit) moveRight() {
+	if e.anchor != -1 {
+		e.moveCursor(max(e.anchor, e.cursor), false)
+		return
+	}
+	e.moveCursor(e.cursor+1, false)
+}
+
+func (e *textEdit) getClipboard() Clipboard {
+	if e.clipboard != nil {
+		return e.clipboard
+	}
+	return DefaultClipboard
+}
+
+// copy puts the selected text on the clipboard.
+func (e *textEdit) copy() {
+	if s := e.selectedText(); len(s) > 0 {
+		_ = e.getClipboard().WriteText(s)
+	}
+}
+
+// cut puts the selected text on the clipboard and removes it.
+func (e *textEdit) cut() {
+	if s := e.selectedText(); len(s) > 0 {
+		if err := e.getClipboard().WriteText(s); err == nil {
+			e.deleteSelectedText()
+		}
+	}
+}
+
+// paste inserts the text on the clipboard as an edit of its own, replacing it by replacer first
+// unless replacer is nil.
+func (e *textEdit) paste(replacer *strings.Replacer) {
+	s, err := e.getClipboard().ReadText()
+	if err != nil || len(s) == 0 {
+		return
+	}
+	if replacer != nil {
+		s = replacer.Replace(s)
+	}
+
+	e.history.breakCoalescing()
+	e.insert(s)
+	e.history.breakCoalescing()
+}
+
+func (e *textEdit) undo() {
+	if s, ok := e.history.stepBack(e.historyState()); ok {
+		e.restoreHistoryState(s)
+	}
+}
+
+func (e *textEdit) redo() {
+	if s, ok := e.history.stepForward(e.historyState()); ok {
+		e.restoreHistoryState(s)
+	}
+}
+
+func (e *textEdit) historyState() textHistoryState {
+	return textHistoryState{text: e.text, cursor: e.cursor}
+}
+
+// recordHistory adds before to the undo history if the text was changed since.
+func (e *textEdit) recordHistory(before textHistoryState, kind textHistoryKind) {
+	if e.text != before.text {
+		e.history.record(before, kind)
+	}
+}
+
+func (e *textEdit) restoreHistoryState(s textHistoryState) {
+	e.text = s.text
+	e.cursor = min(s.cursor, len([]rune(s.text)))
+	e.anchor = -1
+	e.moved()
+}
+
+const (
+	textInputGoLeft = textInputControlCommand(iota + 1)
+	textInputGoRight
+	textInputGoStart
+	textInputGoEnd
+	textInputBackspace
+	textInputDelete
+	textInputEnter
+	textInputEscape
+	textInputGoUp
+	textInputGoDown
+	textInputPageUp
+	textInputPageDown
+	textInputUndo
+	textInputRedo
+	textInputCopy
+	textInputCut
+	textInputPaste
+	textInputSelectAll
+	textInputSelectLeft
+	textInputSelectRight
+	textInputSelectStart
+	textInputSelectEnd
+	textInputWordLeft
+	textInputWordRight
+	textInputSelectWordLeft
+	textInputSelectWordRight
+)
+
+// textInputControlKeyToCommand maps keys pressed together with Control (or Meta) to commands.
+var textInputControlKeyToCommand = map[ebiten.Key]textInputControlCommand{
+	ebiten.KeyZ:     textInputUndo,
+	ebiten.KeyY:     textInputRedo,
+	ebiten.KeyC:     textInputCopy,
+	ebiten.KeyX:     textInputCut,
+	ebiten.KeyV:     textInputPaste,
+	ebiten.KeyA:     textInputSelectAll,
+	ebiten.KeyLeft:  textInputWordLeft,
+	ebiten.KeyRight: textInputWordRight,
+}
+
+// textInputControlShiftKeyToCommand maps keys pressed together with Control (or Meta) and Shift
+// to commands.
+var textInputControlShiftKeyToCommand = map[ebiten.Key]textInputControlCommand{
+	ebiten.KeyZ:     textInputRedo,
+	ebiten.KeyLeft:  textInputSelectWordLeft,
+	ebiten.KeyRight: textInputSelectWordRight,
+}
+
+// textInputShiftKeyToCommand maps keys pressed together with Shift to commands.
+var textInputShiftKeyToCommand = map[ebiten.Key]textInputControlCommand{
+	ebiten.KeyLeft:  textInputSelectLeft,
+	ebiten.KeyRight: textInputSelectRight,
+	ebiten.KeyHome:  textInputSelectStart,
+	ebiten.KeyEnd:   textInputSelectEnd,
+}
+
+// textInputCommander is implemented by the text editing widgets that share the
+// textInputState key repeat machinery.
+type textInputCommander interface {
+	idleState(newKeyOrCommand bool) textInputState
+	executeCommand(cmd textInputControlCommand)
+	repeatDelays() (time.Duration, time.Duration)
+}
+
+func textInputCheckForCommand(t textInputCommander, keyToCommand map[ebiten.Key]textInputControlCommand, newKeyOrCommand bool) textInputState {
+	for key, cmd := range keyToCommand {
+		if !input.KeyPressed(key) {
+			continue
+		}
+
+		delay, interval := t.repeatDelays()
+		if !newKeyOrCommand {
+			delay = interval
+		}
+
+		return textInputCommandState(t, cmd, key, delay, nil, nil)
+	}
+
+	return nil
+}
+
+// textInputCheckForCommands returns the state running the command bound to a pressed key. Keys
+// pressed together with Control and Shift are looked up before keys pressed together with either,
+// which are looked up before keyToCommand.
+func textInputCheckForCommands(t textInputCommander, keyToCommand map[ebiten.Key]textInputControlCommand, newKeyOrCommand bool) textInputState {
+	shift := input.KeyPressed(ebiten.KeyShift)
+	if textInputControlPressed() {
+		if shift {
+			if st := textInputCheckForCommand(t, textInputControlShiftKeyToCommand, newKeyOrCommand); st != nil {
+				return st
+			}
+		}
+		if st := textInputCheckForCommand(t, textInputControlKeyToCommand, newKeyOrCommand); st != nil {
+			return st
+		}
+	}
+	if shift {
+		if st := textInputCheckForCommand(t, textInputShiftKeyToCommand, newKeyOrCommand); st != nil {
+			return st
+		}
+	}
+
+	return textInputCheckForCommand(t, keyToCommand, newKeyOrCommand)
+}
+
+// textInputControlPressed reports whether Control, or Meta for macOS users, is pressed.
+func textInputControlPressed() bool {
+	return input.KeyPressed(ebiten.KeyControl) || input.KeyPressed(ebiten.KeyMeta)
+}
+
+func textInputCommandState(t textInputCommander, cmd textInputControlCommand, key ebiten.Key, delay time.Duration, timer clock.Timer, expired *atomic.Value) textInputState {
+	return func() (textInputState, bool) {
+		if !input.KeyPressed(key) {
+			return t.idleState(true), true
+		}
+
+		if timer != nil {
+			if isExpired, _ := expired.Load().(bool); isExpired {
+				return t.idleState(false), true
+			}
+		}
+
+		if timer == nil {
+			t.executeCommand(cmd)
+
+			expired = &atomic.Value{}
+			expired.Store(false)
+
+			timer = clock.AfterFunc(delay, func() {
+				expired.Store(true)
+			})
+
+			return textInputCommandState(t, cmd, key, delay, timer, expired), false
+		}
+
+		return nil, false
+	}
+}
+
+func insertChars(r []rune, c []rune, pos int) []rune {
+	res := make([]rune, len(r)+len(c))
+	copy(res, r[:pos])
+	copy(res[pos:], c)
+	copy(res[pos+len(c):], r[pos:])
+	return res
+}
+
+// textIsWordRune reports whether c is part of a word for word jumps and word selection.
+func textIsWordRune(c rune) bool {
+	return c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+// textWordLeft returns the start of the word left of rune index pos.
+func textWordLeft(r []rune, pos int) int {
+	pos = min(pos, len(r))
+	for pos > 0 && !textIsWordRune(r[pos-1]) {
+		pos--
+	}
+	for pos > 0 && textIsWordRune(r[pos-1]) {
+		pos--
+	}
+	return pos
+}
+
+// textWordRight returns the end of the word right of rune index pos.
+func textWordRight(r []rune, pos int) int {
+	pos = max(pos, 0)
+	for pos < len(r) && !textIsWordRune(r[pos]) {
+		pos++
+	}
+	for pos < len(r) && textIsWordRune(r[pos]) {
+		pos++
+	}
+	return pos
+}
+
+// textWordAt returns the bounds of the word touching rune index pos. If there is none, the
+// bounds of the run of other characters at pos are returned instead.
+func textWordAt(r []rune, pos int) (int, int) {
+	if len(r) == 0 {
+		return 0, 0
+	}
+	pos = max(0, min(pos, len(r)))
+
+	i := pos
+	if i == len(r) || (i > 0 && !textIsWordRune(r[i]) && textIsWordRune(r[i-1])) {
+		i--
+	}
+	word := textIsWordRune(r[i])
+
+	start, end := i, i+1
+	for start > 0 && textIsWordRune(r[start-1]) == word {
+		start--
+	}
+	for end < len(r) && textIsWordRune(r[end]) == word {
+		end++
+	}
+	return start, end
+}
+
+func removeChar(r []rune, pos int) []rune {
+	res := make([]rune, len(r)-1)
+	copy(res, r[:pos])
+	copy(res[pos:], r[pos+1:])
+	return res
+}
@@ -0,0 +1,1265 @@
+package widget
+
+import (
+	img "image"
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/constantutil"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+type TextEditorParams struct {
+	Face                   *text.Face
+	Color                  *TextInputColor
+	Highlight              *image.NineSlice
+	TextPadding            *Insets
+	RepeatDelay            *time.Duration
+	RepeatInterval         *time.Duration
+	CaretWidth             *int
+	ControlWidgetSpacing   *int
+	Slider                 *SliderParams
+	ScrollContainerImage   *ScrollContainerImage
+	ScrollContainerPadding *Insets
+}
+
+// TextEditor is an editable multi-line text widget. It shares the editing, selection, undo history,
+// clipboard, validation and key handling of TextInput, wraps lines to the available width and keeps
+// the caret scrolled into view inside a ScrollContainer.
+type TextEditor struct {
+	definedParams  TextEditorParams
+	computedParams TextEditorParams
+
+	ChangedEvent *event.Event
+
+	containerOpts   []ContainerOpt
+	edit            *textEdit
+	placeholderText string
+
+	init            *MultiOnce
+	container       *Container
+	layout          *GridLayout
+	scrollContainer *ScrollContainer
+	vSlider         *Slider
+	view            *textEditorView
+	caret           *Caret
+	mouseSelecting  bool
+	caretColumnX    int
+	scrollToCaret   bool
+
+	lines      []textEditorLine
+	linesText  string
+	linesWidth int
+	linesFace  *text.Face
+	lineHeight int
+
+	tabOrder int
+	focused  bool
+	focusMap map[FocusDirection]Focuser
+}
+
+// textEditorLine is a single visual line of a TextEditor. start and end are rune indices into
+// the input text, end being exclusive. hard is set if the line is terminated by a line break
+// (or the end of the text) instead of being wrapped.
+type textEditorLine struct {
+	start int
+	end   int
+	hard  bool
+}
+
+// textEditorView is the scrollable content of a TextEditor. It renders the visible lines,
+// the selection and the caret.
+type textEditorView struct {
+	editor *TextEditor
+	widget *Widget
+}
+
+type TextEditorOpt func(t *TextEditor)
+
+type TextEditorOptions struct {
+}
+
+type TextEditorChangedEventArgs struct {
+	TextEditor *TextEditor
+	InputText  string
+}
+
+type TextEditorChangedHandlerFunc func(args *TextEditorChangedEventArgs)
+
+var TextEditorOpts TextEditorOptions
+
+var textEditorKeyToCommand = map[ebiten.Key]textInputControlCommand{
+	ebiten.KeyLeft:        textInputGoLeft,
+	ebiten.KeyRight:       textInputGoRight,
+	ebiten.KeyUp:          textInputGoUp,
+	ebiten.KeyDown:        textInputGoDown,
+	ebiten.KeyHome:        textInputGoStart,
+	ebiten.KeyEnd:         textInputGoEnd,
+	ebiten.KeyPageUp:      textInputPageUp,
+	ebiten.KeyPageDown:    textInputPageDown,
+	ebiten.KeyBackspace:   textInputBackspace,
+	ebiten.KeyDelete:      textInputDelete,
+	ebiten.KeyEnter:       textInputEnter,
+	ebiten.KeyNumpadEnter: textInputEnter,
+	ebiten.KeyEscape:      textInputEscape,
+}
+
+func NewTextEditor(opts ...TextEditorOpt) *TextEditor {
+	t := &TextEditor{
+		ChangedEvent: &event.Event{},
+
+		init: &MultiOnce{},
+
+		caretColumnX: -1,
+		focusMap:     make(map[FocusDirection]Focuser),
+	}
+	t.edit = newTextEdit(t.caretMoved)
+	t.edit.state = t.idleState(true)
+
+	t.edit.commandToFunc[textInputGoLeft] = t.CursorMoveLeft
+	t.edit.commandToFunc[textInputGoRight] = t.CursorMoveRight
+	t.edit.commandToFunc[textInputGoUp] = t.CursorMoveUp
+	t.edit.commandToFunc[textInputGoDown] = t.CursorMoveDown
+	t.edit.commandToFunc[textInputGoStart] = t.CursorMoveLineStart
+	t.edit.commandToFunc[textInputGoEnd] = t.CursorMoveLineEnd
+	t.edit.commandToFunc[textInputPageUp] = t.CursorPageUp
+	t.edit.commandToFunc[textInputPageDown] = t.CursorPageDown
+	t.edit.commandToFunc[textInputBackspace] = t.Backspace
+	t.edit.commandToFunc[textInputDelete] = t.Delete
+	t.edit.commandToFunc[textInputEnter] = t.InsertNewLine
+	t.edit.commandToFunc[textInputEscape] = t.DeselectText
+	t.edit.commandToFunc[textInputUndo] = t.Undo
+	t.edit.commandToFunc[textInputRedo] = t.Redo
+	t.edit.commandToFunc[textInputCopy] = t.Copy
+	t.edit.commandToFunc[textInputCut] = t.Cut
+	t.edit.commandToFunc[textInputPaste] = t.Paste
+	t.edit.commandToFunc[textInputSelectAll] = t.SelectAll
+	t.edit.commandToFunc[textInputSelectLeft] = t.CursorSelectLeft
+	t.edit.commandToFunc[textInputSelectRight] = t.CursorSelectRight
+	t.edit.commandToFunc[textInputSelectStart] = t.CursorSelectLineStart
+	t.edit.commandToFunc[textInputSelectEnd] = t.CursorSelectLineEnd
+	t.edit.commandToFunc[textInputWordLeft] = t.CursorMoveWordLeft
+	t.edit.commandToFunc[textInputWordRight] = t.CursorMoveWordRight
+	t.edit.commandToFunc[textInputSelectWordLeft] = t.CursorSelectWordLeft
+	t.edit.commandToFunc[textInputSelectWordRight] = t.CursorSelectWordRight
+
+	t.init.Append(t.createWidget)
+
+	for _, o := range opts {
+		o(t)
+	}
+
+	return t
+}
+
+func (t *TextEditor) Validate() {
+	t.init.Do()
+	t.populateComputedParams()
+
+	if t.computedParams.Face == nil {
+		panic("TextEditor: Font Face is required.")
+	}
+	if t.computedParams.Color == nil {
+		panic("TextEditor: Color is required.")
+	}
+	if t.computedParams.Color.Idle == nil {
+		panic("TextEditor: Color.Idle is required.")
+	}
+	if t.computedParams.ScrollContainerImage == nil {
+		panic("TextEditor: ScrollContainerImage is required.")
+	}
+
+	t.initWidget()
+}
+
+func (t *TextEditor) populateComputedParams() {
+	params := TextEditorParams{Color: &TextInputColor{}}
+
+	theme := t.GetWidget().GetTheme()
+
+	// Set theme values
+	if theme != nil {
+		if theme.TextEditorTheme != nil {
+			if theme.TextEditorTheme.Face != nil {
+				params.Face = theme.TextEditorTheme.Face
+			} else {
+				params.Face = theme.DefaultFace
+			}
+			if theme.TextEditorTheme.Color != nil {
+				params.Color.Idle = theme.TextEditorTheme.Color.Idle
+				params.Color.Disabled = theme.TextEditorTheme.Color.Disabled
+				params.Color.Caret = theme.TextEditorTheme.Color.Caret
+				params.Color.DisabledCaret = theme.TextEditorTheme.Color.DisabledCaret
+				params.Color.Highlight = theme.TextEditorTheme.Color.Highlight
+			}
+			if params.Color.Idle == nil {
+				params.Color.Idle = theme.DefaultTextColor
+			}
+			params.Highlight = theme.TextEditorTheme.Highlight
+			params.TextPadding = theme.TextEditorTheme.TextPadding
+			params.RepeatDelay = theme.TextEditorTheme.RepeatDelay
+			params.RepeatInterval = theme.TextEditorTheme.RepeatInterval
+			params.CaretWidth = theme.TextEditorTheme.CaretWidth
+			params.ControlWidgetSpacing = theme.TextEditorTheme.ControlWidgetSpacing
+			params.Slider = theme.TextEditorTheme.Slider
+			params.ScrollContainerImage = theme.TextEditorTheme.ScrollContainerImage
+			params.ScrollContainerPadding = theme.TextEditorTheme.ScrollContainerPadding
+		}
+	}
+
+	// Set definedParam values
+	if t.definedParams.Face != nil {
+		params.Face = t.definedParams.Face
+	}
+	if t.definedParams.Color != nil {
+		params.Color.Idle = t.definedParams.Color.Idle
+		params.Color.Disabled = t.definedParams.Color.Disabled
+		params.Color.Caret = t.definedParams.Color.Caret
+		params.Color.DisabledCaret = t.definedParams.Color.DisabledCaret
+		params.Color.Highlight = t.definedParams.Color.Highlight
+	}
+	if t.definedParams.Highlight != nil {
+		params.Highlight = t.definedParams.Highlight
+	}
+	if t.definedParams.TextPadding != nil {
+		params.TextPadding = t.definedParams.TextPadding
+	}
+	if t.definedParams.RepeatDelay != nil {
+		params.RepeatDelay = t.definedParams.RepeatDelay
+	}
+	if t.definedParams.RepeatInterval != nil {
+		params.RepeatInterval = t.definedParams.RepeatInterval
+	}
+	if t.definedParams.CaretWidth != nil {
+		params.CaretWidth = t.definedParams.CaretWidth
+	}
+	if t.definedParams.ControlWidgetSpacing != nil {
+		params.ControlWidgetSpacing = t.definedParams.ControlWidgetSpacing
+	}
+	if t.definedParams.ScrollContainerImage != nil {
+		params.ScrollContainerImage = t.definedParams.ScrollContainerImage
+	}
+	if t.definedParams.ScrollContainerPadding != nil {
+		params.ScrollContainerPadding = t.definedParams.ScrollContainerPadding
+	}
+	if t.definedParams.Slider != nil {
+		if params.Slider == nil {
+			params.Slider = &SliderParams{}
+		}
+		if t.definedParams.Slider.FixedHandleSize != nil {
+			params.Slider.FixedHandleSize = t.definedParams.Slider.FixedHandleSize
+		}
+		if t.definedParams.Slider.HandleImage != nil {
+			params.Slider.HandleImage = t.definedParams.Slider.HandleImage
+		}
+		if t.definedParams.Slider.MinHandleSize != nil {
+			params.Slider.MinHandleSize = t.definedParams.Slider.MinHandleSize
+		}
+		if t.definedParams.Slider.TrackImage != nil {
+			params.Slider.TrackImage = t.definedParams.Slider.TrackImage
+		}
+		if t.definedParams.Slider.TrackOffset != nil {
+			params.Slider.TrackOffset = t.definedParams.Slider.TrackOffset
+		}
+		if t.definedParams.Slider.TrackPadding != nil {
+			params.Slider.TrackPadding = t.definedParams.Slider.TrackPadding
+		}
+	}
+
+	// Set defaults
+	if params.Highlight == nil {
+		if params.Color.Highlight != nil {
+			params.Highlight = image.NewNineSliceColor(params.Color.Highlight)
+		} else {
+			params.Highlight = image.NewNineSliceColor(color.NRGBA{6, 67, 161, 100})
+		}
+	}
+	if params.TextPadding == nil {
+		params.TextPadding = &Insets{}
+	}
+	if params.RepeatDelay == nil {
+		delay := 300 * time.Millisecond
+		params.RepeatDelay = &delay
+	}
+	if params.RepeatInterval == nil {
+		interval := 35 * time.Millisecond
+		params.RepeatInterval = &interval
+	}
+	if params.CaretWidth == nil {
+		params.CaretWidth = constantutil.ConstantToPointer(2)
+	}
+	if params.ControlWidgetSpacing == nil {
+		params.ControlWidgetSpacing = constantutil.ConstantToPointer(0)
+	}
+	if params.ScrollContainerPadding == nil {
+		params.ScrollContainerPadding = &Insets{}
+	}
+	if params.Color.Caret == nil {
+		params.Color.Caret = params.Color.Idle
+	}
+
+	t.computedParams = params
+}
+
+// Specify the Container options for the text editor.
+func (o TextEditorOptions) ContainerOpts(opts ...ContainerOpt) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.containerOpts = append(t.containerOpts, opts...)
+	}
+}
+
+func (o TextEditorOptions) ChangedHandler(f TextEditorChangedHandlerFunc) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.ChangedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TextEditorChangedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// Set the font face for this text editor.
+func (o TextEditorOptions) Face(f *text.Face) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.Face = f
+	}
+}
+
+// Set the text and caret colors for this text editor.
+func (o TextEditorOptions) Color(c *TextInputColor) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.Color = c
+	}
+}
+
+// Set the image drawn behind selected text.
+//
+// Default: a nine-slice of TextInputColor.Highlight, or of color.NRGBA{6, 67, 161, 100}
+// if that is not set either.
+func (o TextEditorOptions) Highlight(i *image.NineSlice) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.Highlight = i
+	}
+}
+
+// Set how far from the edges of the scroll area the text should be drawn.
+func (o TextEditorOptions) TextPadding(i *Insets) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.TextPadding = i
+	}
+}
+
+func (o TextEditorOptions) RepeatDelay(d time.Duration) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.RepeatDelay = &d
+	}
+}
+
+func (o TextEditorOptions) RepeatInterval(i time.Duration) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.RepeatInterval = &i
+	}
+}
+
+func (o TextEditorOptions) CaretWidth(caretWidth int) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.CaretWidth = &caretWidth
+	}
+}
+
+// Specify spacing between the text container and scrollbar.
+func (o TextEditorOptions) ControlWidgetSpacing(s int) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.ControlWidgetSpacing = &s
+	}
+}
+
+// Specify the options for the scroll bar.
+func (o TextEditorOptions) SliderParams(sliderParams *SliderParams) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.Slider = sliderParams
+	}
+}
+
+// Specify the images for the scroll container.
+func (o TextEditorOptions) ScrollContainerImage(image *ScrollContainerImage) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.ScrollContainerImage = image
+	}
+}
+
+// Specify the padding for the scroll container.
+func (o TextEditorOptions) ScrollContainerPadding(padding *Insets) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.definedParams.ScrollContainerPadding = padding
+	}
+}
+
+func (o TextEditorOptions) Validation(f TextInputValidationFunc) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.edit.validationFunc = f
+	}
+}
+
+func (o TextEditorOptions) Placeholder(s string) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.placeholderText = s
+	}
+}
+
+// Set the initial text for the text editor.
+func (o TextEditorOptions) Text(s string) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.edit.text = s
+		t.edit.lastText = s
+	}
+}
+
+// Sets the clipboard used for cut, copy and paste.
+//
+// Default: DefaultClipboard.
+func (o TextEditorOptions) Clipboard(c Clipboard) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.edit.clipboard = c
+	}
+}
+
+// Sets how many edits can be undone. A depth of 0 or less disables undo and redo.
+//
+// Default: 100.
+func (o TextEditorOptions) HistoryDepth(depth int) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.edit.history.depth = depth
+	}
+}
+
+func (o TextEditorOptions) TabOrder(to int) TextEditorOpt {
+	return func(t *TextEditor) {
+		t.tabOrder = to
+	}
+}
+
+/*********** End of Configuration *****************/
+
+func (t *TextEditor) GetWidget() *Widget {
+	t.init.Do()
+	return t.container.GetWidget()
+}
+
+func (t *TextEditor) PreferredSize() (int, int) {
+	t.init.Do()
+	if t.scrollContainer == nil {
+		return t.container.PreferredSize()
+	}
+
+	w := 50 + *t.computedParams.ControlWidgetSpacing
+	if t.vSlider != nil {
+		sw, _ := t.vSlider.PreferredSize()
+		w += sw
+	}
+	h := t.lineHeight*3 + t.computedParams.TextPadding.Dy() + t.computedParams.ScrollContainerPadding.Dy()
+
+	if h < t.container.widget.MinHeight {
+		h = t.container.widget.MinHeight
+	}
+	if w < t.container.widget.MinWidth {
+		w = t.container.widget.MinWidth
+	}
+	return w, h
+}
+
+func (t *TextEditor) SetLocation(rect img.Rectangle) {
+	t.init.Do()
+	t.container.SetLocation(rect)
+}
+
+func (t *TextEditor) RequestRelayout() {
+	t.init.Do()
+	t.container.RequestRelayout()
+}
+
+func (t *TextEditor) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
+	t.init.Do()
+	t.container.SetupInputLayer(def)
+}
+
+func (t *TextEditor) Render(screen *ebiten.Image) {
+	t.init.Do()
+
+	d := t.container.GetWidget().Disabled
+	if t.vSlider != nil {
+		t.vSlider.DrawTrackDisabled = d
+	}
+	t.scrollContainer.GetWidget().Disabled = d
+	t.view.widget.Disabled = d
+
+	t.updateLines()
+	if t.scrollToCaret {
+		t.scrollCaretVisible()
+		t.scrollToCaret = false
+	}
+
+	t.container.Render(screen)
+}
+
+func (t *TextEditor) Update(updObj *UpdateObject) {
+	t.init.Do()
+	t.edit.revalidate()
+
+	t.updateLines()
+	t.edit.update()
+
+	defer func() {
+		t.edit.lastText = t.edit.text
+	}()
+
+	if t.edit.text != t.edit.lastText {
+		t.ChangedEvent.Fire(&TextEditorChangedEventArgs{
+			TextEditor: t,
+			InputText:  t.edit.text,
+		})
+	}
+
+	t.container.Update(updObj)
+	t.caret.Update(updObj)
+}
+
+func (t *TextEditor) idleState(newKeyOrCommand bool) textInputState {
+	return func() (textInputState, bool) {
+		t.handleMouse()
+
+		if !t.focused {
+			return t.idleState(true), false
+		}
+
+		chars := input.InputChars()
+		if len(chars) > 0 {
+			if !input.KeyPressed(ebiten.KeyControl) {
+				return t.charsInputState(string(chars)), true
+			}
+			t.DeselectText()
+			return t.idleState(true), false
+		}
+
+		st := textInputCheckForCommands(t, textEditorKeyToCommand, newKeyOrCommand)
+		if st != nil {
+			return st, true
+		}
+
+		return t.idleState(true), false
+	}
+}
+
+func (t *TextEditor) charsInputState(c string) textInputState {
+	return func() (textInputState, bool) {
+		if !t.container.GetWidget().Disabled {
+			t.Insert(c)
+		}
+
+		t.caret.ResetBlinking()
+
+		return t.idleState(true), false
+	}
+}
+
+func (t *TextEditor) executeCommand(cmd textInputControlCommand) {
+	t.edit.executeCommand(cmd)
+}
+
+func (t *TextEditor) repeatDelays() (time.Duration, time.Duration) {
+	return *t.computedParams.RepeatDelay, *t.computedParams.RepeatInterval
+}
+
+func (t *TextEditor) handleMouse() {
+	if t.scrollContainer == nil {
+		return
+	}
+
+	x, y := input.CursorPosition()
+	layer := t.view.widget.EffectiveInputLayer()
+
+	if input.MouseButtonJustPressedLayer(ebiten.MouseButtonLeft, layer) && t.scrollContainer.ViewRect().Overlaps(img.Rect(x, y, x+1, y+1)) {
+		idx := t.indexAt(x, y)
+		t.edit.anchor = idx
+		t.edit.cursor = idx
+		t.edit.history.breakCoalescing()
+		t.caretColumnX = -1
+		t.mouseSelecting = true
+		t.scrollToCaret = true
+	} else if t.mouseSelecting && input.MouseButtonPressed(ebiten.MouseButtonLeft) {
+		t.edit.cursor = t.indexAt(x, y)
+		t.scrollToCaret = true
+	}
+
+	if t.mouseSelecting && !input.MouseButtonPressed(ebiten.MouseButtonLeft) {
+		t.mouseSelecting = false
+		if t.edit.anchor == t.edit.cursor {
+			t.edit.anchor = -1
+		}
+		t.caret.ResetBlinking()
+	}
+}
+
+func (t *TextEditor) Insert(c string) {
+	t.init.Do()
+	t.edit.insert(c)
+}
+
+// InsertNewLine inserts a line break at the caret position.
+func (t *TextEditor) InsertNewLine() {
+	t.init.Do()
+	if !t.container.GetWidget().Disabled {
+		t.Insert("\n")
+	}
+}
+
+func (t *TextEditor) Backspace() {
+	t.init.Do()
+	if !t.container.GetWidget().Disabled {
+		t.edit.backspace()
+	}
+	t.DeselectText()
+	t.caretMoved()
+}
+
+func (t *TextEditor) Delete() {
+	t.init.Do()
+	if !t.container.GetWidget().Disabled {
+		t.edit.delete()
+	}
+	t.DeselectText()
+	t.caretMoved()
+}
+
+// CursorMoveLeft moves the caret one character to the left. If text is selected, the caret
+// is moved to the start of the selection instead and the selection is removed.
+func (t *TextEditor) CursorMoveLeft() {
+	t.init.Do()
+	t.edit.moveLeft()
+}
+
+// CursorMoveRight moves the caret one character to the right. If text is selected, the caret
+// is moved to the end of the selection instead and the selection is removed.
+func (t *TextEditor) CursorMoveRight() {
+	t.init.Do()
+	t.edit.moveRight()
+}
+
+// CursorMoveWordLeft moves the caret to the start of the previous word.
+func (t *TextEditor) CursorMoveWordLeft() {
+	t.init.Do()
+	t.edit.moveCursor(textWordLeft([]rune(t.edit.text), t.edit.cursor), false)
+}
+
+// CursorMoveWordRight moves the caret to the end of the next word.
+func (t *TextEditor) CursorMoveWordRight() {
+	t.init.Do()
+	t.edit.moveCursor(textWordRight([]rune(t.edit.text), t.edit.cursor), false)
+}
+
+// CursorSelectLeft extends the selection by one character to the left.
+func (t *TextEditor) CursorSelectLeft() {
+	t.init.Do()
+	t.edit.moveCursor(t.edit.cursor-1, true)
+}
+
+// CursorSelectRight extends the selection by one character to the right.
+func (t *TextEditor) CursorSelectRight() {
+	t.init.Do()
+	t.edit.moveCursor(t.edit.cursor+1, true)
+}
+
+// CursorSelectWordLeft extends the selection to the start of the previous word.
+func (t *TextEditor) CursorSelectWordLeft() {
+	t.init.Do()
+	t.edit.moveCursor(textWordLeft([]rune(t.edit.text), t.edit.cursor), true)
+}
+
+// CursorSelectWordRight extends the selection to the end of the next word.
+func (t *TextEditor) CursorSelectWordRight() {
+	t.init.Do()
+	t.edit.moveCursor(textWordRight([]rune(t.edit.text), t.edit.cursor), true)
+}
+
+// CursorMoveUp moves the caret to the previous visual line, keeping its horizontal position.
+func (t *TextEditor) CursorMoveUp() {
+	t.moveVertical(-1)
+}
+
+// CursorMoveDown moves the caret to the next visual line, keeping its horizontal position.
+func (t *TextEditor) CursorMoveDown() {
+	t.moveVertical(1)
+}
+
+// CursorPageUp moves the caret up by the number of lines visible in the editor.
+func (t *TextEditor) CursorPageUp() {
+	t.moveVertical(-t.pageLines())
+}
+
+// CursorPageDown moves the caret down by the number of lines visible in the editor.
+func (t *TextEditor) CursorPageDown() {
+	t.moveVertical(t.pageLines())
+}
+
+// CursorMoveLineStart moves the caret to the start of the current visual line.
+func (t *TextEditor) CursorMoveLineStart() {
+	t.init.Do()
+	t.updateLines()
+	t.edit.moveCursor(t.lines[t.lineForIndex(t.edit.cursor)].start, false)
+}
+
+// CursorMoveLineEnd moves the caret to the end of the current visual line.
+func (t *TextEditor) CursorMoveLineEnd() {
+	t.init.Do()
+	t.updateLines()
+	k := t.lineForIndex(t.edit.cursor)
+	t.edit.moveCursor(t.clampToLine(k, t.lines[k].end-t.lines[k].start), false)
+}
+
+// CursorSelectLineStart extends the selection to the start of the current visual line.
+func (t *TextEditor) CursorSelectLineStart() {
+	t.init.Do()
+	t.updateLines()
+	t.edit.moveCursor(t.lines[t.lineForIndex(t.edit.cursor)].start, true)
+}
+
+// CursorSelectLineEnd extends the selection to the end of the current visual line.
+func (t *TextEditor) CursorSelectLineEnd() {
+	t.init.Do()
+	t.updateLines()
+	k := t.lineForIndex(t.edit.cursor)
+	t.edit.moveCursor(t.clampToLine(k, t.lines[k].end-t.lines[k].start), true)
+}
+
+// CursorMoveStart moves the caret to the start of the text.
+func (t *TextEditor) CursorMoveStart() {
+	t.init.Do()
+	t.edit.moveCursor(0, false)
+}
+
+// CursorMoveEnd moves the caret to the end of the text.
+func (t *TextEditor) CursorMoveEnd() {
+	t.init.Do()
+	t.edit.moveCursor(len([]rune(t.edit.text)), false)
+}
+
+func (t *TextEditor) moveVertical(delta int) {
+	t.init.Do()
+	t.DeselectText()
+	t.edit.history.breakCoalescing()
+	t.updateLines()
+
+	k := t.lineForIndex(t.edit.cursor)
+	if t.caretColumnX < 0 {
+		t.caretColumnX = fontAdvance(string([]rune(t.edit.text)[t.lines[k].start:t.edit.cursor]), t.computedParams.Face)
+	}
+
+	target := k + delta
+	switch {
+	case target < 0:
+		t.edit.cursor = 0
+	case target >= len(t.lines):
+		t.edit.cursor = len([]rune(t.edit.text))
+	default:
+		l := t.lines[target]
+		col := fontStringIndex([]rune(t.edit.text)[l.start:l.end], t.computedParams.Face, t.caretColumnX)
+		t.edit.cursor = t.clampToLine(target, col)
+	}
+
+	t.caret.ResetBlinking()
+	t.scrollToCaret = true
+}
+
+func (t *TextEditor) pageLines() int {
+	t.init.Do()
+	if t.scrollContainer == nil || t.lineHeight <= 0 {
+		return 1
+	}
+	return max(1, t.scrollContainer.ViewRect().Dy()/t.lineHeight)
+}
+
+func (t *TextEditor) caretMoved() {
+	t.caretColumnX = -1
+	t.scrollToCaret = true
+	t.caret.ResetBlinking()
+}
+
+// SelectedText returns the currently selected text.
+func (t *TextEditor) SelectedText() string {
+	t.init.Do()
+	return t.edit.selectedText()
+}
+
+func (t *TextEditor) DeselectText() {
+	t.edit.anchor = -1
+}
+
+func (t *TextEditor) SelectAll() {
+	t.init.Do()
+	t.edit.selectAll()
+}
+
+// DeleteSelectedText removes the currently selected text.
+func (t *TextEditor) DeleteSelectedText() {
+	t.init.Do()
+	if !t.container.GetWidget().Disabled {
+		t.edit.deleteSelectedText()
+	}
+}
+
+// Copy puts the selected text on the clipboard.
+func (t *TextEditor) Copy() {
+	t.init.Do()
+	t.edit.copy()
+}
+
+// Cut puts the selected text on the clipboard and removes it from the editor.
+func (t *TextEditor) Cut() {
+	t.init.Do()
+	if t.container.GetWidget().Disabled {
+		return
+	}
+	t.edit.cut()
+}
+
+// Paste replaces the selected text with the text on the clipboard, or inserts it at the
+// caret if there is no selection.
+func (t *TextEditor) Paste() {
+	t.init.Do()
+	if t.container.GetWidget().Disabled {
+		return
+	}
+	t.edit.paste(nil)
+}
+
+// Undo reverts the last edit. Consecutively typed characters are reverted together, up to the
+// start of the next word or a pause in typing.
+func (t *TextEditor) Undo() {
+	t.init.Do()
+	if t.container.GetWidget().Disabled {
+		return
+	}
+	t.edit.undo()
+}
+
+// Redo reapplies the last edit reverted by Undo.
+func (t *TextEditor) Redo() {
+	t.init.Do()
+	if t.container.GetWidget().Disabled {
+		return
+	}
+	t.edit.redo()
+}
+
+// CanUndo reports whether there is an edit that can be reverted by Undo.
+func (t *TextEditor) CanUndo() bool {
+	return t.edit.history.canUndo()
+}
+
+// CanRedo reports whether there is an edit that can be reapplied by Redo.
+func (t *TextEditor) CanRedo() bool {
+	return t.edit.history.canRedo()
+}
+
+func (t *TextEditor) GetText() string {
+	return t.edit.text
+}
+
+func (t *TextEditor) SetText(text string) {
+	t.init.Do()
+	t.edit.setText(text, textHistoryOther)
+	if t.edit.text != t.edit.lastText {
+		t.ChangedEvent.Fire(&TextEditorChangedEventArgs{
+			TextEditor: t,
+			InputText:  t.edit.text,
+		})
+		t.edit.lastText = t.edit.text
+		t.CursorMoveEnd()
+	}
+}
+
+// CursorPosition returns the caret position as a rune index into the text.
+func (t *TextEditor) CursorPosition() int {
+	return t.edit.cursor
+}
+
+// SetCursorPosition moves the caret to rune index pos, clamped to the text.
+func (t *TextEditor) SetCursorPosition(pos int) {
+	t.init.Do()
+	t.edit.moveCursor(pos, false)
+}
+
+/** Focuser Interface - Start **/
+
+func (t *TextEditor) Focus(focused bool) {
+	t.init.Do()
+	t.GetWidget().FireFocusEvent(t, focused, img.Point{-1, -1})
+	t.caret.resetBlinking()
+	t.focused = focused
+	t.edit.history.breakCoalescing()
+	if focused {
+		t.scrollToCaret = true
+	} else {
+		t.edit.anchor = -1
+	}
+}
+
+func (t *TextEditor) IsFocused() bool {
+	return t.focused
+}
+
+func (t *TextEditor) TabOrder() int {
+	return t.tabOrder
+}
+
+func (t *TextEditor) GetFocus(direction FocusDirection) Focuser {
+	return t.focusMap[direction]
+}
+
+func (t *TextEditor) AddFocus(direction FocusDirection, focus Focuser) {
+	t.focusMap[direction] = focus
+}
+
+/** Focuser Interface - End **/
+
+// HandlesDirection reports that all four arrow keys move the caret.
+func (t *TextEditor) HandlesDirection(direction FocusDirection) bool {
+	switch direction {
+	case FOCUS_NORTH, FOCUS_EAST, FOCUS_SOUTH, FOCUS_WEST:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReceivesText reports that the text editor consumes typed characters.
+func (t *TextEditor) ReceivesText() bool {
+	return true
+}
+
+func (t *TextEditor) createWidget() {
+	t.layout = NewGridLayout(
+		GridLayoutOpts.Columns(2),
+		GridLayoutOpts.Stretch([]bool{true, false}, []bool{true}))
+
+	t.container = NewContainer(
+		append([]ContainerOpt{
+			ContainerOpts.WidgetOpts(WidgetOpts.TrackHover(true)),
+			ContainerOpts.Layout(t.layout),
+		}, t.containerOpts...,
+		)...)
+
+	t.caret = NewCaret()
+
+	t.view = &textEditorView{
+		editor: t,
+		widget: NewWidget(WidgetOpts.TrackHover(true)),
+	}
+	t.view.widget.focusable = t
+}
+
+func (t *TextEditor) initWidget() {
+	t.container.RemoveChildren()
+	t.layout.columnSpacing = *t.computedParams.ControlWidgetSpacing
+
+	_, height := text.Measure(" ", *t.computedParams.Face, 0)
+	t.lineHeight = int(math.Round(height))
+	t.linesFace = nil
+
+	t.caret.Color = t.computedParams.Color.Caret
+	t.caret.Height = t.lineHeight
+	t.caret.Width = *t.computedParams.CaretWidth
+	t.caret.Validate()
+
+	t.scrollContainer = NewScrollContainer(
+		ScrollContainerOpts.Content(t.view),
+		ScrollContainerOpts.StretchContentWidth(),
+		ScrollContainerOpts.Image(t.computedParams.ScrollContainerImage),
+		ScrollContainerOpts.Padding(t.computedParams.ScrollContainerPadding),
+	)
+	t.container.AddChild(t.scrollContainer)
+
+	var sliderOpts []SliderOpt
+	if t.computedParams.Slider != nil {
+		if t.computedParams.Slider.FixedHandleSize != nil {
+			sliderOpts = append(sliderOpts, SliderOpts.FixedHandleSize(*t.computedParams.Slider.FixedHandleSize))
+		} else {
+			sliderOpts = append(sliderOpts, SliderOpts.FixedHandleSize(0))
+		}
+		if t.computedParams.Slider.HandleImage != nil {
+			sliderOpts = append(sliderOpts, SliderOpts.HandleImage(t.computedParams.Slider.HandleImage))
+		}
+		if t.computedParams.Slider.TrackImage != nil {
+			sliderOpts = append(sliderOpts, SliderOpts.TrackImage(t.computedParams.Slider.TrackImage))
+		}
+		if t.computedParams.Slider.MinHandleSize != nil {
+			sliderOpts = append(sliderOpts, SliderOpts.MinHandleSize(*t.computedParams.Slider.MinHandleSize))
+		}
+		if t.computedParams.Slider.TrackOffset != nil {
+			sliderOpts = append(sliderOpts, SliderOpts.TrackOffset(*t.computedParams.Slider.TrackOffset))
+		}
+		if t.computedParams.Slider.TrackPadding != nil {
+			sliderOpts = append(sliderOpts, SliderOpts.TrackPadding(t.computedParams.Slider.TrackPadding))
+		}
+	}
+
+	pageSizeFunc := func() int {
+		return int(math.Round(float64(t.scrollContainer.ViewRect().Dy()) / float64(t.view.widget.Rect.Dy()) * 1000))
+	}
+
+	t.vSlider = NewSlider(append(sliderOpts,
+		SliderOpts.Orientation(DirectionVertical),
+		SliderOpts.MinMax(0, 1000),
+		SliderOpts.PageSizeFunc(pageSizeFunc),
+		SliderOpts.TabOrder(-1),
+		SliderOpts.ChangedHandler(func(args *SliderChangedEventArgs) {
+			current := args.Slider.Current
+			if pageSizeFunc() >= 1000 {
+				current = 0
+			}
+			t.scrollContainer.ScrollTop = float64(current) / 1000
+		}),
+	)...)
+	t.container.AddChild(t.vSlider)
+
+	t.scrollContainer.widget.ScrolledEvent.AddHandler(func(args interface{}) {
+		if a, ok := args.(*WidgetScrolledEventArgs); ok {
+			p := pageSizeFunc() / 3
+			if p < 1 {
+				p = 1
+			}
+			t.vSlider.Current -= int(math.Round(a.Y * float64(p)))
+		}
+	})
+}
+
+// updateLines rewraps the text into visual lines if the text, the font face or the
+// available width changed since the last call.
+func (t *TextEditor) updateLines() {
+	if t.computedParams.Face == nil {
+		return
+	}
+
+	width := 0
+	if t.scrollContainer != nil {
+		if r := t.scrollContainer.ViewRect(); !r.Empty() {
+			width = r.Dx() - t.computedParams.TextPadding.Dx()
+		}
+	}
+
+	if t.lines != nil && t.linesText == t.edit.text && t.linesWidth == width && t.linesFace == t.computedParams.Face {
+		return
+	}
+
+	t.lines = textEditorWrapLines([]rune(t.edit.text), t.computedParams.Face, width)
+	t.linesText = t.edit.text
+	t.linesWidth = width
+	t.linesFace = t.computedParams.Face
+}
+
+// textEditorWrapLines splits r into visual lines at line breaks and, if maxWidth is positive,
+// wraps lines that are wider than maxWidth at the last space that fits, or at the last
+// rune that fits if there is none.
+func textEditorWrapLines(r []rune, face *text.Face, maxWidth int) []textEditorLine {
+	var lines []textEditorLine
+
+	start := 0
+	for start <= len(r) {
+		end := start
+		for end < len(r) && r[end] != '\n' {
+			end++
+		}
+
+		lineStart := start
+		for maxWidth > 0 && lineStart < end && fontAdvance(string(r[lineStart:end]), face) > maxWidth {
+			brk := lineStart + 1
+			lastSpace := -1
+			for i := lineStart + 1; i <= end; i++ {
+				// Trailing spaces may hang past the edge.
+				if r[i-1] != ' ' && fontAdvance(string(r[lineStart:i]), face) > maxWidth {
+					break
+				}
+				brk = i
+				if r[i-1] == ' ' {
+					lastSpace = i
+				}
+			}
+			if lastSpace > lineStart && brk < end && r[brk] != ' ' {
+				brk = lastSpace
+			}
+			lines = append(lines, textEditorLine{start: lineStart, end: brk})
+			lineStart = brk
+		}
+		lines = append(lines, textEditorLine{start: lineStart, end: end, hard: true})
+
+		start = end + 1
+	}
+
+	return lines
+}
+
+// lineForIndex returns the index of the visual line the caret is on when placed at rune index i.
+// An index at a wrap position belongs to the following line.
+func (t *TextEditor) lineForIndex(i int) int {
+	for k, l := range t.lines {
+		if i < l.start {
+			continue
+		}
+		if i < l.end || (i == l.end && l.hard) {
+			return k
+		}
+	}
+	return len(t.lines) - 1
+}
+
+// clampToLine returns the rune index of column col on visual line k, keeping the caret on
+// that line for wrapped lines.
+func (t *TextEditor) clampToLine(k int, col int) int {
+	l := t.lines[k]
+	if !l.hard && col >= l.end-l.start && col > 0 {
+		col = l.end - l.start - 1
+	}
+	return l.start + col
+}
+
+// indexAt returns the rune index closest to screen position x, y.
+func (t *TextEditor) indexAt(x int, y int) int {
+	t.updateLines()
+	if t.lineHeight <= 0 {
+		return 0
+	}
+
+	tr := t.computedParams.TextPadding.Apply(t.view.widget.Rect)
+	k := int(math.Floor(float64(y-tr.Min.Y) / float64(t.lineHeight)))
+	if k < 0 {
+		return 0
+	}
+	if k >= len(t.lines) {
+		return len([]rune(t.edit.text))
+	}
+
+	l := t.lines[k]
+	col := fontStringIndex([]rune(t.edit.text)[l.start:l.end], t.computedParams.Face, x-tr.Min.X)
+	return t.clampToLine(k, col)
+}
+
+func (t *TextEditor) contentHeight() int {
+	return len(t.lines)*t.lineHeight + t.computedParams.TextPadding.Dy()
+}
+
+// scrollCaretVisible adjusts the vertical scroll position so that the caret line is inside
+// the visible area.
+func (t *TextEditor) scrollCaretVisible() {
+	viewHeight := t.scrollContainer.ViewRect().Dy()
+	scrollHeight := t.contentHeight() - viewHeight
+	if viewHeight <= 0 || scrollHeight <= 0 {
+		return
+	}
+
+	caretTop := t.lineForIndex(t.edit.cursor)*t.lineHeight + t.computedParams.TextPadding.Top
+	scrollPos := int(math.Round(t.scrollContainer.ScrollTop * float64(scrollHeight)))
+	if caretTop < scrollPos {
+		scrollPos = caretTop
+	} else if caretTop+t.lineHeight > scrollPos+viewHeight {
+		scrollPos = caretTop + t.lineHeight - viewHeight
+	}
+
+	scrollTop := math.Max(0, math.Min(1, float64(scrollPos)/float64(scrollHeight)))
+	t.scrollContainer.ScrollTop = scrollTop
+	t.vSlider.Current = int(math.Round(scrollTop * 1000))
+}
+
+func (t *TextEditor) drawTextAndCaret(screen *ebiten.Image) {
+	tr := t.computedParams.TextPadding.Apply(t.view.widget.Rect)
+	clip := t.scrollContainer.ViewRect()
+	runes := []rune(t.edit.text)
+	selStart, selEnd := t.edit.selectionRange()
+	disabled := t.container.GetWidget().Disabled
+
+	textColor := t.computedParams.Color.Idle
+	if disabled && t.computedParams.Color.Disabled != nil {
+		textColor = t.computedParams.Color.Disabled
+	}
+
+	if len(runes) == 0 && len(t.placeholderText) > 0 {
+		placeholderColor := t.computedParams.Color.Disabled
+		if placeholderColor == nil {
+			placeholderColor = t.computedParams.Color.Idle
+		}
+		op := &text.DrawOptions{}
+		op.GeoM.Translate(float64(tr.Min.X), float64(tr.Min.Y))
+		op.ColorScale.ScaleWithColor(placeholderColor)
+		text.Draw(screen, t.placeholderText, *t.computedParams.Face, op)
+	}
+
+	for k, l := range t.lines {
+		ly := tr.Min.Y + k*t.lineHeight
+		if ly+t.lineHeight < clip.Min.Y {
+			continue
+		}
+		if ly > clip.Max.Y {
+			break
+		}
+
+		if t.focused && selStart != selEnd && selStart <= l.end && selEnd >= l.start {
+			x0 := fontAdvance(string(runes[l.start:max(selStart, l.start)]), t.computedParams.Face)
+			x1 := fontAdvance(string(runes[l.start:min(selEnd, l.end)]), t.computedParams.Face)
+			if x1 > x0 {
+				t.computedParams.Highlight.Draw(screen, x1-x0, t.lineHeight, func(opts *ebiten.DrawImageOptions) {
+					opts.GeoM.Translate(float64(tr.Min.X+x0), float64(ly))
+				})
+			}
+		}
+
+		if l.end > l.start {
+			op := &text.DrawOptions{}
+			op.GeoM.Translate(float64(tr.Min.X), float64(ly))
+			op.ColorScale.ScaleWithColor(textColor)
+			text.Draw(screen, string(runes[l.start:l.end]), *t.computedParams.Face, op)
+		}
+	}
+
+	if t.focused && len(t.lines) > 0 {
+		if disabled && t.computedParams.Color.DisabledCaret != nil {
+			t.caret.Color = t.computedParams.Color.DisabledCaret
+		} else {
+			t.caret.Color = t.computedParams.Color.Caret
+		}
+
+		k := t.lineForIndex(t.edit.cursor)
+		cx := fontAdvance(string(runes[t.lines[k].start:t.edit.cursor]), t.computedParams.Face)
+		t.caret.SetLocation(img.Rect(0, 0, t.caret.Width, t.caret.Height).Add(img.Point{tr.Min.X + cx, tr.Min.Y + k*t.lineHeight}))
+		t.caret.Render(screen)
+	}
+}
+
+func (v *textEditorView) GetWidget() *Widget {
+	return v.widget
+}
+
+func (v *textEditorView) SetLocation(rect img.Rectangle) {
+	v.widget.Rect = rect
+}
+
+func (v *textEditorView) PreferredSize() (int, int) {
+	v.editor.updateLines()
+	return v.editor.computedParams.TextPadding.Dx(), v.editor.contentHeight()
+}
+
+func (v *textEditorView) Validate() {
+}
+
+func (v *textEditorView) Render(screen *ebiten.Image) {
+	v.widget.Render(screen)
+	v.editor.drawTextAndCaret(screen)
+}
+
+func (v *textEditorView) Update(updObj *UpdateObject) {
+	v.widget.Update(updObj)
+}
@@ -0,0 +1,238 @@
+package widget
+
+import (
+	img "image"
+	"image/color"
+	"testing"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/matryer/is"
+)
+
+func TestTextEditor_ChangedEvent(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *TextEditorChangedEventArgs
+	te := newTextEditor(t, TextEditorOpts.ChangedHandler(func(args *TextEditorChangedEventArgs) {
+		eventArgs = args
+	}))
+
+	te.SetText("foo\nbar")
+	render(te, t)
+
+	is.Equal(eventArgs.InputText, "foo\nbar")
+}
+
+func TestTextEditor_InsertNewLine(t *testing.T) {
+	is := is.New(t)
+
+	te := newTextEditor(t)
+	te.SetText("foobar")
+	te.SetCursorPosition(3)
+	te.InsertNewLine()
+
+	is.Equal(te.GetText(), "foo\nbar")
+	is.Equal(te.CursorPosition(), 4)
+}
+
+func TestTextEditor_InsertNewLine_Disabled(t *testing.T) {
+	is := is.New(t)
+
+	te := newTextEditor(t)
+	te.GetWidget().Disabled = true
+	te.SetText("foobar")
+	te.SetCursorPosition(3)
+	te.InsertNewLine()
+
+	is.Equal(te.GetText(), "foobar")
+}
+
+func TestTextEditor_Validation(t *testing.T) {
+	is := is.New(t)
+
+	te := newTextEditor(t, TextEditorOpts.Validation(func(newInputText string) (bool, *string) {
+		return len(newInputText) <= 5, nil
+	}))
+	te.SetText("foo")
+	te.Insert("\nbar")
+
+	is.Equal(te.GetText(), "foo")
+}
+
+func TestTextEditor_CursorMoveUpDown(t *testing.T) {
+	is := is.New(t)
+
+	te := newTextEditor(t)
+	te.SetText("foo\nb\nbarbaz")
+	te.SetCursorPosition(2)
+
+	te.CursorMoveDown()
+	is.Equal(te.CursorPosition(), 5) // end of "b"
+
+	te.CursorMoveDown()
+	is.Equal(te.CursorPosition(), 8) // remembers the original column
+
+	te.CursorMoveUp()
+	te.CursorMoveUp()
+	is.Equal(te.CursorPosition(), 2)
+
+	te.CursorMoveUp()
+	is.Equal(te.CursorPosition(), 0)
+}
+
+func TestTextEditor_CursorMoveLineStartEnd(t *testing.T) {
+	is := is.New(t)
+
+	te := newTextEditor(t)
+	te.SetText("foo\nbarbaz\nqux")
+	te.SetCursorPosition(6)
+
+	te.CursorMoveLineStart()
+	is.Equal(te.CursorPosition(), 4)
+
+	te.CursorMoveLineEnd()
+	is.Equal(te.CursorPosition(), 10)
+}
+
+func TestTextEditor_SelectedText(t *testing.T) {
+	is := is.New(t)
+
+	te := newTextEditor(t)
+	te.SetText("héllo\nwörld")
+	te.edit.anchor = 3
+	te.edit.cursor = 8
+
+	is.Equal(te.SelectedText(), "lo\nwö")
+
+	te.DeleteSelectedText()
+	is.Equal(te.GetText(), "hélrld")
+	is.Equal(te.CursorPosition(), 3)
+}
+
+func TestTextEditor_Undo(t *testing.T) {
+	is := is.New(t)
+
+	te := newTextEditor(t)
+	te.Insert("f")
+	te.Insert("o")
+	te.InsertNewLine()
+	te.Backspace()
+	te.Backspace()
+
+	is.Equal(te.GetText(), "f")
+
+	te.Undo()
+	is.Equal(te.GetText(), "fo\n")
+	is.Equal(te.CursorPosition(), 3)
+
+	te.Undo()
+	is.Equal(te.GetText(), "fo") // a line break starts a new undo step, like a space
+
+	te.Undo()
+	is.Equal(te.GetText(), "")
+	is.True(!te.CanUndo())
+
+	te.Redo()
+	is.Equal(te.GetText(), "fo")
+}
+
+func TestTextEditor_CutPaste(t *testing.T) {
+	is := is.New(t)
+
+	clipboard := NewMemoryClipboard()
+	te := newTextEditor(t, TextEditorOpts.Clipboard(clipboard))
+	te.SetText("foo\nbar")
+	te.SetCursorPosition(2)
+	te.CursorSelectRight()
+	te.CursorSelectRight()
+	te.Cut()
+
+	is.Equal(te.GetText(), "fobar")
+
+	te.CursorMoveEnd()
+	te.Paste()
+	is.Equal(te.GetText(), "fobaro\n") // line breaks are kept
+
+	te.Undo()
+	is.Equal(te.GetText(), "fobar")
+}
+
+func TestTextEditor_WrapLines(t *testing.T) {
+	is := is.New(t)
+
+	face := loadFont(t)
+	width := fontAdvance("foo bar ", face)
+
+	lines := textEditorWrapLines([]rune("foo bar baz\nqux"), face, width)
+	is.Equal(lines, []textEditorLine{
+		{start: 0, end: 8},
+		{start: 8, end: 11, hard: true},
+		{start: 12, end: 15, hard: true},
+	})
+
+	lines = textEditorWrapLines([]rune("foofoofoo"), face, fontAdvance("foo", face))
+	is.Equal(lines, []textEditorLine{
+		{start: 0, end: 3},
+		{start: 3, end: 6},
+		{start: 6, end: 9, hard: true},
+	})
+
+	lines = textEditorWrapLines([]rune("foo bar baz"), face, 0)
+	is.Equal(lines, []textEditorLine{{start: 0, end: 11, hard: true}})
+}
+
+func TestTextEditor_CursorMoveDown_Wrapped(t *testing.T) {
+	is := is.New(t)
+
+	face := loadFont(t)
+	te := newTextEditor(t)
+	te.SetText("foo bar baz")
+	te.SetLocation(img.Rect(0, 0, 200, 200))
+	render(te, t)
+
+	// Resize so that only "foo bar " fits into the view.
+	chrome := 200 - te.scrollContainer.ViewRect().Dx()
+	te.SetLocation(img.Rect(0, 0, fontAdvance("foo bar ", face)+chrome, 200))
+	render(te, t)
+
+	is.Equal(len(te.lines), 2)
+
+	te.SetCursorPosition(1)
+	te.CursorMoveDown()
+	is.Equal(te.CursorPosition(), 9)
+
+	te.CursorMoveLineStart()
+	is.Equal(te.CursorPosition(), 8)
+
+	te.CursorMoveUp()
+	te.CursorMoveLineEnd()
+	is.Equal(te.CursorPosition(), 7) // caret stays on the wrapped line
+}
+
+func newTextEditor(t *testing.T, opts ...TextEditorOpt) *TextEditor {
+	t.Helper()
+
+	te := NewTextEditor(append(opts, []TextEditorOpt{
+		TextEditorOpts.Face(loadFont(t)),
+		TextEditorOpts.Color(&TextInputColor{
+			Idle:     color.White,
+			Disabled: color.White,
+			Caret:    color.White,
+		}),
+		TextEditorOpts.ScrollContainerImage(&ScrollContainerImage{
+			Idle:     newNineSliceEmpty(t),
+			Disabled: newNineSliceEmpty(t),
+			Mask:     newNineSliceEmpty(t),
+		}),
+		TextEditorOpts.SliderParams(&SliderParams{
+			TrackImage: &SliderTrackImage{},
+			HandleImage: &ButtonImage{
+				Idle:    newNineSliceEmpty(t),
+				Pressed: newNineSliceEmpty(t),
+			}}),
+	}...)...)
+
+	event.ExecuteDeferred()
+	render(te, t)
+	return te
+}
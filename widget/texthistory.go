@@ -0,0 +1,98 @@
+package widget
+
+import (
+	"time"
+
+	"github.com/ebitenui/ebitenui/utilities/clock"
+)
+
+// textHistoryKind describes the kind of edit recorded in a textHistory. Consecutive edits of
+// the same kind, other than textHistoryOther, are merged into a single undo step unless they
+// are more than textHistoryPause apart.
+type textHistoryKind int
+
+const (
+	textHistoryOther textHistoryKind = iota
+	textHistoryTyping
+	textHistoryDeleting
+)
+
+// textHistoryPause is how long typing or deleting may pause before the next edit starts a new
+// undo step.
+const textHistoryPause = time.Second
+
+// textHistoryState is a snapshot of the text and the caret position of a text widget.
+type textHistoryState struct {
+	text   string
+	cursor int
+}
+
+// textHistory is a bounded undo/redo stack of text snapshots.
+type textHistory struct {
+	depth    int
+	undo     []textHistoryState
+	redo     []textHistoryState
+	lastKind textHistoryKind
+	lastEdit time.Time
+}
+
+// record pushes before, the state prior to an edit of the given kind, onto the undo stack
+// and clears the redo stack.
+func (h *textHistory) record(before textHistoryState, kind textHistoryKind) {
+	if h.depth <= 0 {
+		return
+	}
+
+	h.redo = nil
+	now := clock.Now()
+	paused := now.Sub(h.lastEdit) > textHistoryPause
+	h.lastEdit = now
+	if kind != textHistoryOther && kind == h.lastKind && len(h.undo) > 0 && !paused {
+		return
+	}
+	h.lastKind = kind
+
+	h.undo = append(h.undo, before)
+	if over := len(h.undo) - h.depth; over > 0 {
+		h.undo = h.undo[:copy(h.undo, h.undo[over:])]
+	}
+}
+
+// breakCoalescing makes sure the next edit starts a new undo step.
+func (h *textHistory) breakCoalescing() {
+	h.lastKind = textHistoryOther
+}
+
+// stepBack returns the state to restore when undoing and remembers current for redo.
+func (h *textHistory) stepBack(current textHistoryState) (textHistoryState, bool) {
+	if len(h.undo) == 0 {
+		return textHistoryState{}, false
+	}
+
+	s := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, current)
+	h.lastKind = textHistoryOther
+	return s, true
+}
+
+// stepForward returns the state to restore when redoing and remembers current for undo.
+func (h *textHistory) stepForward(current textHistoryState) (textHistoryState, bool) {
+	if len(h.redo) == 0 {
+		return textHistoryState{}, false
+	}
+
+	s := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, current)
+	h.lastKind = textHistoryOther
+	return s, true
+}
+
+func (h *textHistory) canUndo() bool {
+	return len(h.undo) > 0
+}
+
+func (h *textHistory) canRedo() bool {
+	return len(h.redo) > 0
+}
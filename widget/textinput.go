@@ -6,13 +6,13 @@ import (
 	"math"
 	"runtime"
 	"strings"
-	"sync/atomic"
 	"time"
 
 	"github.com/ebitenui/ebitenui/event"
 	"github.com/ebitenui/ebitenui/image"
 	"github.com/ebitenui/ebitenui/input"
 	"github.com/ebitenui/ebitenui/internal/jsUtil"
+	"github.com/ebitenui/ebitenui/utilities/clock"
 	"github.com/ebitenui/ebitenui/utilities/mobile"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
@@ -41,25 +41,24 @@ type TextInput struct {
 	ChangedEvent *event.Event
 	SubmitEvent  *event.Event
 
-	inputText       string
-	validationFunc  TextInputValidationFunc
+	edit            *textEdit
 	placeholderText string
 	mobileInputMode mobile.InputMode
 
 	widgetOpts            []WidgetOpt
 	init                  *MultiOnce
-	commandToFunc         map[textInputControlCommand]textInputCommandFunc
 	widget                *Widget
 	caret                 *Caret
 	text                  *Text
 	renderBuf             *image.MaskedRenderBuffer
 	mask                  *image.NineSlice
-	cursorPosition        int
-	state                 textInputState
 	scrollOffset          int
-	lastInputText         string
 	previousSubmittedText *string
-	dragStartIndex        int
+	mouseSelecting        bool
+	mouseAnchorIndex      int
+	lastClickTime         time.Time
+	lastClickIndex        int
+	clickCount            int
 
 	tabOrder int
 	focused  bool
@@ -81,7 +80,8 @@ type TextInputChangedHandlerFunc func(args *TextInputChangedEventArgs)
 type TextInputImage struct {
 	Idle     *image.NineSlice
 	Disabled *image.NineSlice
-	// Highlight defaults to image.NewNineSliceColor(color.NRGBA{6, 67, 161, 100}).
+	// Highlight defaults to a nine-slice of TextInputColor.Highlight, or of
+	// color.NRGBA{6, 67, 161, 100} if that is not set either.
 	Highlight *image.NineSlice
 }
 
@@ -90,28 +90,17 @@ type TextInputColor struct {
 	Disabled      color.Color
 	Caret         color.Color
 	DisabledCaret color.Color
+	// Highlight is the color drawn behind selected text if no highlight image is set.
+	Highlight color.Color
 }
 
 type TextInputValidationFunc func(newInputText string) (bool, *string)
 
-type textInputState func() (textInputState, bool)
-
-type textInputControlCommand int
-
-type textInputCommandFunc func()
-
 var TextInputOpts TextInputOptions
 
-const (
-	textInputGoLeft = textInputControlCommand(iota + 1)
-	textInputGoRight
-	textInputGoStart
-	textInputGoEnd
-	textInputBackspace
-	textInputDelete
-	textInputEnter
-	textInputEscape
-)
+// textInputMultiClickInterval is the maximum time between clicks that count as a double
+// or triple click.
+const textInputMultiClickInterval = 400 * time.Millisecond
 
 var textInputKeyToCommand = map[ebiten.Key]textInputControlCommand{
 	ebiten.KeyLeft:        textInputGoLeft,
@@ -125,29 +114,47 @@ var textInputKeyToCommand = map[ebiten.Key]textInputControlCommand{
 	ebiten.KeyEscape:      textInputEscape,
 }
 
+// textInputLineBreakReplacer replaces line breaks in pasted text.
+var textInputLineBreakReplacer = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ")
+
 func NewTextInput(opts ...TextInputOpt) *TextInput {
 	t := &TextInput{
 		ChangedEvent: &event.Event{},
 		SubmitEvent:  &event.Event{},
 
-		init:          &MultiOnce{},
-		commandToFunc: map[textInputControlCommand]textInputCommandFunc{},
-		renderBuf:     image.NewMaskedRenderBuffer(),
+		init:      &MultiOnce{},
+		renderBuf: image.NewMaskedRenderBuffer(),
 
 		mobileInputMode: mobile.TEXT,
 		focusMap:        make(map[FocusDirection]Focuser),
-		dragStartIndex:  -1,
 	}
-	t.state = t.idleState(true)
-
-	t.commandToFunc[textInputGoLeft] = t.CursorMoveLeft
-	t.commandToFunc[textInputGoRight] = t.CursorMoveRight
-	t.commandToFunc[textInputGoStart] = t.CursorMoveStart
-	t.commandToFunc[textInputGoEnd] = t.CursorMoveEnd
-	t.commandToFunc[textInputBackspace] = t.Backspace
-	t.commandToFunc[textInputDelete] = t.Delete
-	t.commandToFunc[textInputEnter] = t.submitWithEnter
-	t.commandToFunc[textInputEscape] = t.DeselectText
+	t.edit = newTextEdit(func() {
+		t.caret.ResetBlinking()
+	})
+	t.edit.state = t.idleState(true)
+
+	t.edit.commandToFunc[textInputGoLeft] = t.CursorMoveLeft
+	t.edit.commandToFunc[textInputGoRight] = t.CursorMoveRight
+	t.edit.commandToFunc[textInputGoStart] = t.CursorMoveStart
+	t.edit.commandToFunc[textInputGoEnd] = t.CursorMoveEnd
+	t.edit.commandToFunc[textInputBackspace] = t.Backspace
+	t.edit.commandToFunc[textInputDelete] = t.Delete
+	t.edit.commandToFunc[textInputEnter] = t.submitWithEnter
+	t.edit.commandToFunc[textInputEscape] = t.DeselectText
+	t.edit.commandToFunc[textInputUndo] = t.Undo
+	t.edit.commandToFunc[textInputRedo] = t.Redo
+	t.edit.commandToFunc[textInputCopy] = t.Copy
+	t.edit.commandToFunc[textInputCut] = t.Cut
+	t.edit.commandToFunc[textInputPaste] = t.Paste
+	t.edit.commandToFunc[textInputSelectAll] = t.SelectAll
+	t.edit.commandToFunc[textInputSelectLeft] = t.CursorSelectLeft
+	t.edit.commandToFunc[textInputSelectRight] = t.CursorSelectRight
+	t.edit.commandToFunc[textInputSelectStart] = t.CursorSelectStart
+	t.edit.commandToFunc[textInputSelectEnd] = t.CursorSelectEnd
+	t.edit.commandToFunc[textInputWordLeft] = t.CursorMoveWordLeft
+	t.edit.commandToFunc[textInputWordRight] = t.CursorMoveWordRight
+	t.edit.commandToFunc[textInputSelectWordLeft] = t.CursorSelectWordLeft
+	t.edit.commandToFunc[textInputSelectWordRight] = t.CursorSelectWordRight
 
 	t.init.Append(t.createWidget)
 
@@ -192,6 +199,7 @@ func (t *TextInput) populateComputedParams() {
 				params.Color.Disabled = theme.TextInputTheme.Color.Disabled
 				params.Color.Caret = theme.TextInputTheme.Color.Caret
 				params.Color.DisabledCaret = theme.TextInputTheme.Color.DisabledCaret
+				params.Color.Highlight = theme.TextInputTheme.Color.Highlight
 			}
 			if theme.TextInputTheme.Face != nil {
 				params.Face = theme.TextInputTheme.Face
@@ -226,6 +234,7 @@ func (t *TextInput) populateComputedParams() {
 		params.Color.Disabled = t.definedParams.Color.Disabled
 		params.Color.Caret = t.definedParams.Color.Caret
 		params.Color.DisabledCaret = t.definedParams.Color.DisabledCaret
+		params.Color.Highlight = t.definedParams.Color.Highlight
 	}
 	if t.definedParams.Face != nil {
 		params.Face = t.definedParams.Face
@@ -265,7 +274,11 @@ func (t *TextInput) populateComputedParams() {
 		params.Image = &TextInputImage{}
 	}
 	if params.Image.Highlight == nil {
-		params.Image.Highlight = image.NewNineSliceColor(color.NRGBA{6, 67, 161, 100})
+		if params.Color.Highlight != nil {
+			params.Image.Highlight = image.NewNineSliceColor(params.Color.Highlight)
+		} else {
+			params.Image.Highlight = image.NewNineSliceColor(color.NRGBA{6, 67, 161, 100})
+		}
 	}
 	if params.RepeatDelay == nil {
 		delay := 300 * time.Millisecond
@@ -384,7 +397,7 @@ func (o TextInputOptions) RepeatInterval(i time.Duration) TextInputOpt {
 
 func (o TextInputOptions) Validation(f TextInputValidationFunc) TextInputOpt {
 	return func(t *TextInput) {
-		t.validationFunc = f
+		t.edit.validationFunc = f
 	}
 }
 
@@ -428,6 +441,24 @@ func (o TextInputOptions) MobileInputMode(mobileInputMode mobile.InputMode) Text
 	}
 }
 
+// Sets the clipboard used for cut, copy and paste.
+//
+// Default: DefaultClipboard.
+func (o TextInputOptions) Clipboard(c Clipboard) TextInputOpt {
+	return func(t *TextInput) {
+		t.edit.clipboard = c
+	}
+}
+
+// Sets how many edits can be undone. A depth of 0 or less disables undo and redo.
+//
+// Default: 100.
+func (o TextInputOptions) HistoryDepth(depth int) TextInputOpt {
+	return func(t *TextInput) {
+		t.edit.history.depth = depth
+	}
+}
+
 // Sets how many pixels from the edge the cursor must be dragged prior to it scrolling in that direction.
 //
 // Default: 15.
@@ -477,37 +508,17 @@ func (t *TextInput) Render(screen *ebiten.Image) {
 func (t *TextInput) Update(updObj *UpdateObject) {
 	t.init.Do()
 	t.text.GetWidget().Disabled = t.widget.Disabled
-	if t.lastInputText != t.inputText {
-		if t.validationFunc != nil {
-			result, replacement := t.validationFunc(t.inputText)
-			if !result {
-				if replacement != nil {
-					t.inputText = *replacement
-				} else {
-					t.inputText = t.lastInputText
-				}
-			}
-		}
-	}
-
-	for {
-		newState, rerun := t.state()
-		if newState != nil {
-			t.state = newState
-		}
-		if !rerun {
-			break
-		}
-	}
+	t.edit.revalidate()
+	t.edit.update()
 
 	defer func() {
-		t.lastInputText = t.inputText
+		t.edit.lastText = t.edit.text
 	}()
 
-	if t.inputText != t.lastInputText {
+	if t.edit.text != t.edit.lastText {
 		t.ChangedEvent.Fire(&TextInputChangedEventArgs{
 			TextInput: t,
-			InputText: t.inputText,
+			InputText: t.edit.text,
 		})
 	}
 
@@ -522,73 +533,32 @@ func (t *TextInput) Update(updObj *UpdateObject) {
 
 func (t *TextInput) idleState(newKeyOrCommand bool) textInputState {
 	return func() (textInputState, bool) {
+		t.handleMouseSelection()
+
 		if !t.focused {
-			t.dragStartIndex = -1
+			if !t.mouseSelecting {
+				t.edit.anchor = -1
+			}
 			return t.idleState(true), false
 		}
 
 		chars := input.InputChars()
 		if len(chars) > 0 {
-			if !ebiten.IsKeyPressed(ebiten.KeyControl) && !ebiten.IsKeyPressed(ebiten.KeyControlLeft) && !ebiten.IsKeyPressed(ebiten.KeyControlRight) {
-				t.DeleteSelectedText()
+			if !input.KeyPressed(ebiten.KeyControl) {
 				return t.charsInputState(string(chars)), true
 			}
 			t.DeselectText()
 			return t.idleState(true), false
 		}
 
-		st := textInputCheckForCommand(t, newKeyOrCommand)
+		st := textInputCheckForCommands(t, textInputKeyToCommand, newKeyOrCommand)
 		if st != nil {
 			return st, true
 		}
 
-		x, y := input.CursorPosition()
-		p := img.Point{x, y}
-		curIdx := 0
-		tr := t.computedParams.Padding.Apply(t.widget.Rect)
-		if x < tr.Min.X {
-			x = tr.Min.X
-		}
-		if x > tr.Max.X {
-			x = tr.Max.X
-		}
-		if p.In(t.widget.Rect) {
-			curIdx = fontStringIndex([]rune(t.inputText), t.computedParams.Face, x-t.scrollOffset-tr.Min.X)
-		} else {
-			if y < tr.Min.Y {
-				curIdx = 0
-			} else {
-				curIdx = len([]rune(t.inputText))
-			}
-		}
-		textSize := tr.Dx() - fontAdvance(t.inputText, t.computedParams.Face)
-
-		if input.MouseButtonJustPressedLayer(ebiten.MouseButtonLeft, t.widget.EffectiveInputLayer()) {
-			t.dragStartIndex = curIdx
-			t.cursorPosition = curIdx
-		} else if input.MouseButtonPressed(ebiten.MouseButtonLeft) {
-			if t.dragStartIndex == -1 {
-				t.dragStartIndex = curIdx
-			}
-			t.cursorPosition = curIdx
-			if t.scrollOffset < 0 && x < t.widget.Rect.Min.X+*t.computedParams.ScrollSensitivity {
-				t.scrollOffset = min(0, t.scrollOffset+1)
-			} else if t.scrollOffset > textSize && x > t.widget.Rect.Max.X-*t.computedParams.ScrollSensitivity {
-				t.scrollOffset = max(t.scrollOffset-1, textSize)
-			}
-		}
-
-		if input.MouseButtonJustReleasedLayer(ebiten.MouseButtonLeft, t.widget.EffectiveInputLayer()) {
-			t.cursorPosition = curIdx
-			t.caret.ResetBlinking()
-
-			if t.dragStartIndex == curIdx {
-				t.dragStartIndex = -1
-			}
-		}
 		if runtime.GOOS == jsUtil.JS && runtime.GOARCH == jsUtil.WASM {
-			dragStartDraw := min(t.cursorPosition, t.dragStartIndex)
-			dragEndDraw := max(t.cursorPosition, t.dragStartIndex)
+			dragStartDraw := min(t.edit.cursor, t.edit.anchor)
+			dragEndDraw := max(t.edit.cursor, t.edit.anchor)
 			jsUtil.SetCursorPosition(dragStartDraw, dragEndDraw)
 		}
 
@@ -596,23 +566,91 @@ func (t *TextInput) idleState(newKeyOrCommand bool) textInputState {
 	}
 }
 
-func textInputCheckForCommand(t *TextInput, newKeyOrCommand bool) textInputState {
-	for key, cmd := range textInputKeyToCommand {
-		if !input.KeyPressed(key) {
-			continue
+// handleMouseSelection places the caret and selects text using the left mouse button.
+// Dragging selects a range, a double click selects a word and a triple click selects
+// all text.
+func (t *TextInput) handleMouseSelection() {
+	x, y := input.CursorPosition()
+	p := img.Point{x, y}
+	curIdx := 0
+	tr := t.computedParams.Padding.Apply(t.widget.Rect)
+	if x < tr.Min.X {
+		x = tr.Min.X
+	}
+	if x > tr.Max.X {
+		x = tr.Max.X
+	}
+	if p.In(t.widget.Rect) {
+		curIdx = fontStringIndex([]rune(t.edit.text), t.computedParams.Face, x-t.scrollOffset-tr.Min.X)
+	} else {
+		if y < tr.Min.Y {
+			curIdx = 0
+		} else {
+			curIdx = len([]rune(t.edit.text))
 		}
+	}
+	textSize := tr.Dx() - fontAdvance(t.edit.text, t.computedParams.Face)
 
-		var delay time.Duration
-		if newKeyOrCommand {
-			delay = *t.computedParams.RepeatDelay
+	if input.MouseButtonJustPressedLayer(ebiten.MouseButtonLeft, t.widget.EffectiveInputLayer()) && p.In(t.widget.Rect) {
+		now := clock.Now()
+		if now.Sub(t.lastClickTime) <= textInputMultiClickInterval && curIdx == t.lastClickIndex {
+			t.clickCount = min(t.clickCount+1, 3)
 		} else {
-			delay = *t.computedParams.RepeatInterval
+			t.clickCount = 1
 		}
+		t.lastClickTime = now
+		t.lastClickIndex = curIdx
 
-		return t.commandState(cmd, key, delay, nil, nil)
+		t.selectWithClicks(curIdx, t.clickCount)
+	} else if t.mouseSelecting && input.MouseButtonPressed(ebiten.MouseButtonLeft) {
+		if curIdx != t.mouseAnchorIndex {
+			t.edit.anchor = t.mouseAnchorIndex
+		} else {
+			t.edit.anchor = -1
+		}
+		t.edit.cursor = curIdx
+		if t.scrollOffset < 0 && x < t.widget.Rect.Min.X+*t.computedParams.ScrollSensitivity {
+			t.scrollOffset = min(0, t.scrollOffset+1)
+		} else if t.scrollOffset > textSize && x > t.widget.Rect.Max.X-*t.computedParams.ScrollSensitivity {
+			t.scrollOffset = max(t.scrollOffset-1, textSize)
+		}
 	}
 
-	return nil
+	if t.mouseSelecting && !input.MouseButtonPressed(ebiten.MouseButtonLeft) {
+		t.mouseSelecting = false
+		t.caret.ResetBlinking()
+	}
+}
+
+// selectWithClicks places the caret at rune index idx for a single click and starts a drag
+// selection, selects the word at idx for a double click and all text for a triple click.
+func (t *TextInput) selectWithClicks(idx int, clicks int) {
+	t.edit.history.breakCoalescing()
+	t.caret.ResetBlinking()
+
+	switch clicks {
+	case 1:
+		t.mouseSelecting = true
+		t.mouseAnchorIndex = idx
+		t.edit.anchor = -1
+		t.edit.cursor = idx
+	case 2:
+		t.mouseSelecting = false
+		if *t.computedParams.Secure {
+			t.SelectAll()
+			return
+		}
+		start, end := textWordAt([]rune(t.edit.text), idx)
+		if start != end {
+			t.edit.anchor = start
+		} else {
+			t.edit.anchor = -1
+		}
+		t.edit.cursor = end
+	default:
+		t.mouseSelecting = false
+		t.SelectAll()
+	}
 }
 
 func (t *TextInput) charsInputState(c string) textInputState {
@@ -627,96 +665,95 @@ func (t *TextInput) charsInputState(c string) textInputState {
 	}
 }
 
-func (t *TextInput) commandState(cmd textInputControlCommand, key ebiten.Key, delay time.Duration, timer *time.Timer, expired *atomic.Value) textInputState {
-	return func() (textInputState, bool) {
-		if !input.KeyPressed(key) {
-			return t.idleState(true), true
-		}
+func (t *TextInput) executeCommand(cmd textInputControlCommand) {
+	t.edit.executeCommand(cmd)
+}
 
-		if timer != nil {
-			if isExpired, _ := expired.Load().(bool); isExpired {
-				return t.idleState(false), true
-			}
-		}
+func (t *TextInput) repeatDelays() (time.Duration, time.Duration) {
+	return *t.computedParams.RepeatDelay, *t.computedParams.RepeatInterval
+}
+
+func (t *TextInput) Insert(c string) {
+	t.init.Do()
+	t.edit.insert(c)
+}
+
+// CursorMoveLeft moves the caret one character to the left. If text is selected, the caret
+// is moved to the start of the selection instead and the selection is removed.
+func (t *TextInput) CursorMoveLeft() {
+	t.init.Do()
+	t.edit.moveLeft()
+}
 
-		if timer == nil {
-			t.commandToFunc[cmd]()
+// CursorMoveRight moves the caret one character to the right. If text is selected, the caret
+// is moved to the end of the selection instead and the selection is removed.
+func (t *TextInput) CursorMoveRight() {
+	t.init.Do()
+	t.edit.moveRight()
+}
 
-			expired = &atomic.Value{}
-			expired.Store(false)
+func (t *TextInput) CursorMoveStart() {
+	t.init.Do()
+	t.edit.moveCursor(0, false)
+}
 
-			timer = time.AfterFunc(delay, func() {
-				expired.Store(true)
-			})
+func (t *TextInput) CursorMoveEnd() {
+	t.init.Do()
+	t.edit.moveCursor(len([]rune(t.edit.text)), false)
+}
 
-			return t.commandState(cmd, key, delay, timer, expired), false
-		}
+// CursorMoveWordLeft moves the caret to the start of the previous word.
+func (t *TextInput) CursorMoveWordLeft() {
+	t.init.Do()
+	t.edit.moveCursor(textWordLeft([]rune(t.edit.text), t.edit.cursor), false)
+}
 
-		return nil, false
-	}
+// CursorMoveWordRight moves the caret to the end of the next word.
+func (t *TextInput) CursorMoveWordRight() {
+	t.init.Do()
+	t.edit.moveCursor(textWordRight([]rune(t.edit.text), t.edit.cursor), false)
 }
 
-func (t *TextInput) Insert(c string) {
+// CursorSelectLeft extends the selection by one character to the left.
+func (t *TextInput) CursorSelectLeft() {
 	t.init.Do()
-	t.DeleteSelectedText()
-	s := string(insertChars([]rune(t.inputText), []rune(c), t.cursorPosition))
-
-	if t.validationFunc != nil {
-		result, replacement := t.validationFunc(s)
-		if !result {
-			if replacement != nil {
-				s = *replacement
-			} else {
-				return
-			}
-		}
-	}
-	t.inputText = s
+	t.edit.moveCursor(t.edit.cursor-1, true)
+}
 
-	t.cursorPosition += len([]rune(c))
-	if t.cursorPosition > len([]rune(t.inputText)) {
-		t.cursorPosition = len([]rune(t.inputText))
-	}
-	t.caret.ResetBlinking()
+// CursorSelectRight extends the selection by one character to the right.
+func (t *TextInput) CursorSelectRight() {
+	t.init.Do()
+	t.edit.moveCursor(t.edit.cursor+1, true)
 }
 
-func (t *TextInput) CursorMoveLeft() {
+// CursorSelectStart extends the selection to the start of the text.
+func (t *TextInput) CursorSelectStart() {
 	t.init.Do()
-	if t.cursorPosition > 0 {
-		t.cursorPosition--
-	}
-	t.caret.ResetBlinking()
+	t.edit.moveCursor(0, true)
 }
 
-func (t *TextInput) CursorMoveRight() {
+// CursorSelectEnd extends the selection to the end of the text.
+func (t *TextInput) CursorSelectEnd() {
 	t.init.Do()
-	if t.cursorPosition < len([]rune(t.inputText)) {
-		t.cursorPosition++
-	}
-	t.caret.ResetBlinking()
+	t.edit.moveCursor(len([]rune(t.edit.text)), true)
 }
 
-func (t *TextInput) CursorMoveStart() {
+// CursorSelectWordLeft extends the selection to the start of the previous word.
+func (t *TextInput) CursorSelectWordLeft() {
 	t.init.Do()
-	t.cursorPosition = 0
-	t.caret.ResetBlinking()
+	t.edit.moveCursor(textWordLeft([]rune(t.edit.text), t.edit.cursor), true)
 }
 
-func (t *TextInput) CursorMoveEnd() {
+// CursorSelectWordRight extends the selection to the end of the next word.
+func (t *TextInput) CursorSelectWordRight() {
 	t.init.Do()
-	t.cursorPosition = len([]rune(t.inputText))
-	t.caret.ResetBlinking()
+	t.edit.moveCursor(textWordRight([]rune(t.edit.text), t.edit.cursor), true)
 }
 
 func (t *TextInput) Backspace() {
 	t.init.Do()
 	if !t.widget.Disabled {
-		if t.dragStartIndex != -1 {
-			t.DeleteSelectedText()
-		} else if t.cursorPosition > 0 {
-			t.inputText = string(removeChar([]rune(t.inputText), t.cursorPosition-1))
-			t.cursorPosition--
-		}
+		t.edit.backspace()
 	}
 	t.DeselectText()
 	t.caret.ResetBlinking()
@@ -725,11 +762,7 @@ func (t *TextInput) Backspace() {
 func (t *TextInput) Delete() {
 	t.init.Do()
 	if !t.widget.Disabled {
-		if t.dragStartIndex != -1 {
-			t.DeleteSelectedText()
-		} else if t.cursorPosition < len([]rune(t.inputText)) {
-			t.inputText = string(removeChar([]rune(t.inputText), t.cursorPosition))
-		}
+		t.edit.delete()
 	}
 	t.DeselectText()
 	t.caret.ResetBlinking()
@@ -743,19 +776,21 @@ func (t *TextInput) submitWithEnter() {
 
 func (t *TextInput) Submit() {
 	t.init.Do()
-	if !*t.computedParams.IgnoreEmptySubmit || len(t.inputText) > 0 {
-		if *t.computedParams.AllowDuplicateSubmit || t.previousSubmittedText == nil || t.inputText != *t.previousSubmittedText {
+	if !*t.computedParams.IgnoreEmptySubmit || len(t.edit.text) > 0 {
+		if *t.computedParams.AllowDuplicateSubmit || t.previousSubmittedText == nil || t.edit.text != *t.previousSubmittedText {
 			t.SubmitEvent.Fire(&TextInputChangedEventArgs{
 				TextInput: t,
-				InputText: t.inputText,
+				InputText: t.edit.text,
 			})
-			previousText := t.inputText
+			previousText := t.edit.text
 			t.previousSubmittedText = &previousText
 		}
 	}
 	if *t.computedParams.ClearOnSubmit {
+		before := t.edit.historyState()
 		t.CursorMoveStart()
-		t.inputText = ""
+		t.edit.text = ""
+		t.edit.recordHistory(before, textHistoryOther)
 	}
 
 	t.DeselectText()
@@ -763,59 +798,83 @@ func (t *TextInput) Submit() {
 
 func (t *TextInput) SelectedText() string {
 	t.init.Do()
-	if t.dragStartIndex != -1 {
-		start := min(t.dragStartIndex, t.cursorPosition)
-		end := max(t.dragStartIndex, t.cursorPosition)
+	return t.edit.selectedText()
+}
+
+// Copy puts the selected text on the clipboard. Nothing is copied from secure inputs.
+func (t *TextInput) Copy() {
+	t.init.Do()
+	if *t.computedParams.Secure {
+		return
+	}
+	t.edit.copy()
+}
+
+// Cut puts the selected text on the clipboard and removes it from the input.
+// Nothing is cut from secure inputs.
+func (t *TextInput) Cut() {
+	t.init.Do()
+	if t.widget.Disabled || *t.computedParams.Secure {
+		return
+	}
+	t.edit.cut()
+}
 
-		return strings.Clone(t.inputText)[start:end]
+// Paste replaces the selected text with the text on the clipboard, or inserts it at the
+// caret if there is no selection. Line breaks are replaced by spaces.
+func (t *TextInput) Paste() {
+	t.init.Do()
+	if t.widget.Disabled {
+		return
 	}
-	return ""
+	t.edit.paste(textInputLineBreakReplacer)
 }
 
 func (t *TextInput) DeselectText() {
-	t.dragStartIndex = -1
+	t.edit.anchor = -1
 }
 
 func (t *TextInput) SelectAll() {
 	t.init.Do()
-	if len(t.inputText) > 0 {
-		t.dragStartIndex = 0
-		t.CursorMoveEnd()
-		if runtime.GOOS == jsUtil.JS && runtime.GOARCH == jsUtil.WASM {
-			dragStartDraw := min(t.cursorPosition, t.dragStartIndex)
-			dragEndDraw := max(t.cursorPosition, t.dragStartIndex)
-			jsUtil.SetCursorPosition(dragStartDraw, dragEndDraw)
-		}
+	t.edit.selectAll()
+	if t.edit.anchor != -1 && runtime.GOOS == jsUtil.JS && runtime.GOARCH == jsUtil.WASM {
+		dragStartDraw, dragEndDraw := t.edit.selectionRange()
+		jsUtil.SetCursorPosition(dragStartDraw, dragEndDraw)
 	}
 }
 
 func (t *TextInput) DeleteSelectedText() {
-	if t.dragStartIndex != -1 {
-		start := min(t.dragStartIndex, t.cursorPosition)
-		end := max(t.dragStartIndex, t.cursorPosition)
-		t.inputText = strings.Replace(t.inputText, t.inputText[start:end], "", 1)
-		if t.cursorPosition > t.dragStartIndex {
-			t.cursorPosition -= (end - start)
-		}
+	t.init.Do()
+	t.edit.deleteSelectedText()
+}
 
-		t.dragStartIndex = -1
-		t.caret.ResetBlinking()
+// Undo reverts the last edit. Consecutively typed characters are reverted together, up to the
+// start of the next word or a pause in typing.
+func (t *TextInput) Undo() {
+	t.init.Do()
+	if t.widget.Disabled {
+		return
 	}
+	t.edit.undo()
 }
 
-func insertChars(r []rune, c []rune, pos int) []rune {
-	res := make([]rune, len(r)+len(c))
-	copy(res, r[:pos])
-	copy(res[pos:], c)
-	copy(res[pos+len(c):], r[pos:])
-	return res
+// Redo reapplies the last edit reverted by Undo.
+func (t *TextInput) Redo() {
+	t.init.Do()
+	if t.widget.Disabled {
+		return
+	}
+	t.edit.redo()
+}
+
+// CanUndo reports whether there is an edit that can be reverted by Undo.
+func (t *TextInput) CanUndo() bool {
+	return t.edit.history.canUndo()
 }
 
-func removeChar(r []rune, pos int) []rune {
-	res := make([]rune, len(r)-1)
-	copy(res, r[:pos])
-	copy(res[pos:], r[pos+1:])
-	return res
+// CanRedo reports whether there is an edit that can be reapplied by Redo.
+func (t *TextInput) CanRedo() bool {
+	return t.edit.history.canRedo()
 }
 
 func (t *TextInput) renderImage(screen *ebiten.Image) {
@@ -852,14 +911,14 @@ func (t *TextInput) drawTextAndCaret(screen *ebiten.Image) {
 	tr := rect
 	tr = tr.Add(img.Point{t.computedParams.Padding.Left, t.computedParams.Padding.Top})
 
-	inputStr := t.inputText
+	inputStr := t.edit.text
 	if *t.computedParams.Secure {
-		inputStr = strings.Repeat("*", len([]rune(t.inputText)))
+		inputStr = strings.Repeat("*", len([]rune(t.edit.text)))
 	}
 
 	cx := 0
 	if t.focused {
-		sub := string([]rune(inputStr)[:t.cursorPosition])
+		sub := string([]rune(inputStr)[:t.edit.cursor])
 		cx = fontAdvance(sub, t.computedParams.Face)
 
 		dx := tr.Min.X + t.scrollOffset + cx + t.caret.Width + t.computedParams.Padding.Right - rect.Max.X
@@ -871,8 +930,8 @@ func (t *TextInput) drawTextAndCaret(screen *ebiten.Image) {
 		if dx < 0 {
 			t.scrollOffset -= dx
 		}
-		if t.dragStartIndex != -1 {
-			dragString := string([]rune(inputStr)[:t.dragStartIndex])
+		if t.edit.anchor != -1 {
+			dragString := string([]rune(inputStr)[:t.edit.anchor])
 			dragXStart := fontAdvance(dragString, t.computedParams.Face)
 
 			dragStartDraw := min(dragXStart, cx)
@@ -889,12 +948,12 @@ func (t *TextInput) drawTextAndCaret(screen *ebiten.Image) {
 	tr = tr.Add(img.Point{t.scrollOffset, 0})
 
 	t.text.SetLocation(tr)
-	if len([]rune(t.inputText)) > 0 {
+	if len([]rune(t.edit.text)) > 0 {
 		t.text.Label = inputStr
 	} else {
 		t.text.Label = t.placeholderText
 	}
-	if (t.widget.Disabled || len([]rune(t.inputText)) == 0) && t.computedParams.Color.Disabled != nil {
+	if (t.widget.Disabled || len([]rune(t.edit.text)) == 0) && t.computedParams.Color.Disabled != nil {
 		t.text.SetColor(t.computedParams.Color.Disabled)
 	} else {
 		t.text.SetColor(t.computedParams.Color.Idle)
@@ -916,7 +975,7 @@ func (t *TextInput) drawTextAndCaret(screen *ebiten.Image) {
 }
 
 func (t *TextInput) GetText() string {
-	return t.inputText
+	return t.edit.text
 }
 
 func (t *TextInput) SetText(text string) {
@@ -925,33 +984,26 @@ func (t *TextInput) SetText(text string) {
 
 func (t *TextInput) setJSText(text string) string {
 	t.setText(text, true)
-	return t.inputText
+	return t.edit.text
 }
 
 func (t *TextInput) setText(text string, isJS bool) {
 	t.init.Do()
-	t.DeselectText()
-	t.inputText = text
-	if t.validationFunc != nil {
-		result, replacement := t.validationFunc(t.inputText)
-		if !result {
-			if replacement != nil {
-				t.inputText = *replacement
-			} else {
-				t.inputText = t.lastInputText
-			}
-		}
+	if isJS {
+		t.edit.setText(text, textHistoryTyping)
+	} else {
+		t.edit.setText(text, textHistoryOther)
 	}
-	if t.inputText != t.lastInputText {
+	if t.edit.text != t.edit.lastText {
 
 		t.ChangedEvent.Fire(&TextInputChangedEventArgs{
 			TextInput: t,
-			InputText: t.inputText,
+			InputText: t.edit.text,
 		})
-		t.lastInputText = t.inputText
+		t.edit.lastText = t.edit.text
 
 		if isJS {
-			t.cursorPosition = jsUtil.GetCursorPosition()
+			t.edit.cursor = jsUtil.GetCursorPosition()
 		} else {
 			t.CursorMoveEnd()
 		}
@@ -965,12 +1017,13 @@ func (t *TextInput) Focus(focused bool) {
 	t.GetWidget().FireFocusEvent(t, focused, img.Point{-1, -1})
 	t.caret.resetBlinking()
 	t.focused = focused
+	t.edit.history.breakCoalescing()
 
 	if focused && runtime.GOOS == jsUtil.JS && runtime.GOARCH == jsUtil.WASM {
-		jsUtil.Prompt(t.mobileInputMode, "Please enter a value.", t.inputText, t.cursorPosition, t.widget.Rect.Min.Y, t.setJSText, t.SelectAll)
+		jsUtil.Prompt(t.mobileInputMode, "Please enter a value.", t.edit.text, t.edit.cursor, t.widget.Rect.Min.Y, t.setJSText, t.SelectAll)
 	}
 	if !focused {
-		t.dragStartIndex = -1
+		t.edit.anchor = -1
 	}
 }
 
@@ -992,6 +1045,16 @@ func (t *TextInput) AddFocus(direction FocusDirection, focus Focuser) {
 
 /** Focuser Interface - End **/
 
+// HandlesDirection reports that the left and right arrow keys move the caret.
+func (t *TextInput) HandlesDirection(direction FocusDirection) bool {
+	return direction == FOCUS_WEST || direction == FOCUS_EAST
+}
+
+// ReceivesText reports that the text input consumes typed characters.
+func (t *TextInput) ReceivesText() bool {
+	return true
+}
+
 func (t *TextInput) createWidget() {
 	t.widget = NewWidget(append([]WidgetOpt{WidgetOpts.TrackHover(true)}, t.widgetOpts...)...)
 	t.widget.focusable = t
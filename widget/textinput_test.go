@@ -3,8 +3,10 @@ package widget
 import (
 	"image/color"
 	"testing"
+	"time"
 
 	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/utilities/clock"
 	"github.com/matryer/is"
 )
 
@@ -42,8 +44,8 @@ func TestTextInput_DoBackspace(t *testing.T) {
 
 	ti := newTextInput(t)
 	ti.SetText("foo")
-	ti.lastInputText = ti.GetText()
-	ti.cursorPosition = 1
+	ti.edit.lastText = ti.GetText()
+	ti.edit.cursor = 1
 	render(ti, t)
 
 	ti.ChangedEvent.AddHandler(func(args interface{}) {
@@ -62,7 +64,7 @@ func TestTextInput_DoBackspace_Disabled(t *testing.T) {
 	ti := newTextInput(t)
 	ti.GetWidget().Disabled = true
 	ti.SetText("foo")
-	ti.cursorPosition = 1
+	ti.edit.cursor = 1
 	render(ti, t)
 
 	ti.ChangedEvent.AddHandler(func(_ interface{}) {
@@ -111,14 +113,274 @@ func TestTextInput_DoInsert(t *testing.T) {
 
 	ti := newTextInput(t)
 	ti.SetText("foo")
-	ti.lastInputText = ti.GetText()
-	ti.cursorPosition = 1
+	ti.edit.lastText = ti.GetText()
+	ti.edit.cursor = 1
 	render(ti, t)
 
 	ti.Insert("ab€c")
 
 	is.Equal(ti.GetText(), "fab€coo")
-	is.Equal(ti.cursorPosition, 5)
+	is.Equal(ti.edit.cursor, 5)
+}
+
+func TestTextInput_Undo_CoalescesTyping(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	ti.Insert("f")
+	ti.Insert("o")
+	ti.Insert("o")
+	ti.CursorMoveLeft()
+	ti.Insert("x")
+
+	is.Equal(ti.GetText(), "foxo")
+	is.True(ti.CanUndo())
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "foo")
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "")
+	is.True(!ti.CanUndo())
+}
+
+func TestTextInput_Undo_Words(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	for _, c := range "foo  bar" {
+		ti.Insert(string(c))
+	}
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "foo")
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "")
+}
+
+func TestTextInput_Undo_Pause(t *testing.T) {
+	is := is.New(t)
+
+	c := clock.NewFake(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	clock.Set(c)
+	t.Cleanup(func() {
+		clock.Set(nil)
+	})
+
+	ti := newTextInput(t)
+	ti.Insert("f")
+	c.Advance(textHistoryPause / 2)
+	ti.Insert("o")
+	c.Advance(textHistoryPause * 2)
+	ti.Insert("o")
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "fo")
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "")
+}
+
+func TestTextInput_Redo(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	ti.Insert("foo")
+	ti.Backspace()
+	ti.Backspace()
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "foo")
+	is.Equal(ti.edit.cursor, 3)
+
+	ti.Redo()
+	is.Equal(ti.GetText(), "f")
+	is.Equal(ti.edit.cursor, 1)
+	is.True(!ti.CanRedo())
+
+	ti.Undo()
+	ti.Insert("d")
+	is.True(!ti.CanRedo()) // a new edit discards the redo history
+}
+
+func TestTextInput_Undo_DeleteSelectedText(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	ti.SetText("héllo")
+	ti.edit.anchor = 1
+	ti.edit.cursor = 3
+	ti.DeleteSelectedText()
+
+	is.Equal(ti.GetText(), "hlo")
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "héllo")
+}
+
+func TestTextInput_Undo_Disabled(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	ti.Insert("foo")
+	ti.GetWidget().Disabled = true
+	ti.Undo()
+
+	is.Equal(ti.GetText(), "foo")
+}
+
+func TestTextInput_HistoryDepth(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t, TextInputOpts.HistoryDepth(2))
+	ti.Insert("a")
+	ti.CursorMoveEnd()
+	ti.Insert("b")
+	ti.CursorMoveEnd()
+	ti.Insert("c")
+
+	ti.Undo()
+	ti.Undo()
+	is.Equal(ti.GetText(), "a")
+	is.True(!ti.CanUndo())
+}
+
+func TestTextInput_CopyPaste(t *testing.T) {
+	is := is.New(t)
+
+	clipboard := NewMemoryClipboard()
+	ti := newTextInput(t, TextInputOpts.Clipboard(clipboard))
+	ti.SetText("héllo")
+	ti.edit.anchor = 0
+	ti.edit.cursor = 2
+	ti.Copy()
+
+	text, err := clipboard.ReadText()
+	is.NoErr(err)
+	is.Equal(text, "hé")
+
+	ti.DeselectText()
+	ti.CursorMoveEnd()
+	ti.Paste()
+	is.Equal(ti.GetText(), "héllohé")
+
+	ti.Undo()
+	is.Equal(ti.GetText(), "héllo")
+}
+
+func TestTextInput_Cut(t *testing.T) {
+	is := is.New(t)
+
+	clipboard := NewMemoryClipboard()
+	ti := newTextInput(t, TextInputOpts.Clipboard(clipboard))
+	ti.SetText("foobar")
+	ti.SelectAll()
+	ti.edit.anchor = 3
+	ti.Cut()
+
+	text, _ := clipboard.ReadText()
+	is.Equal(text, "bar")
+	is.Equal(ti.GetText(), "foo")
+}
+
+func TestTextInput_Paste_ReplacesSelectionAndLineBreaks(t *testing.T) {
+	is := is.New(t)
+
+	clipboard := NewMemoryClipboard()
+	_ = clipboard.WriteText("b\na\r\nr")
+	ti := newTextInput(t, TextInputOpts.Clipboard(clipboard))
+	ti.SetText("foo")
+	ti.SelectAll()
+	ti.Paste()
+
+	is.Equal(ti.GetText(), "b a r")
+}
+
+func TestTextInput_Copy_Secure(t *testing.T) {
+	is := is.New(t)
+
+	clipboard := NewMemoryClipboard()
+	ti := newTextInput(t, TextInputOpts.Clipboard(clipboard), TextInputOpts.Secure(true))
+	ti.SetText("secret")
+	ti.SelectAll()
+	ti.Copy()
+	ti.Cut()
+
+	text, _ := clipboard.ReadText()
+	is.Equal(text, "")
+	is.Equal(ti.GetText(), "secret")
+}
+
+func TestTextInput_Paste_Disabled(t *testing.T) {
+	is := is.New(t)
+
+	clipboard := NewMemoryClipboard()
+	_ = clipboard.WriteText("bar")
+	ti := newTextInput(t, TextInputOpts.Clipboard(clipboard))
+	ti.SetText("foo")
+	ti.GetWidget().Disabled = true
+	ti.Paste()
+
+	is.Equal(ti.GetText(), "foo")
+}
+
+func TestTextInput_CursorSelect(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	ti.SetText("foo bar")
+	ti.CursorMoveStart()
+	ti.CursorSelectRight()
+	ti.CursorSelectRight()
+	is.Equal(ti.SelectedText(), "fo")
+
+	ti.CursorSelectEnd()
+	is.Equal(ti.SelectedText(), "foo bar")
+
+	ti.CursorSelectLeft()
+	is.Equal(ti.SelectedText(), "foo ba")
+
+	ti.CursorMoveLeft()
+	is.Equal(ti.SelectedText(), "")
+	is.Equal(ti.edit.cursor, 0) // collapses to the start of the selection
+}
+
+func TestTextInput_CursorWordJumps(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	ti.SetText("foo, bär baz")
+	ti.CursorMoveStart()
+
+	ti.CursorMoveWordRight()
+	is.Equal(ti.edit.cursor, 3)
+	ti.CursorMoveWordRight()
+	is.Equal(ti.edit.cursor, 8)
+
+	ti.CursorSelectWordLeft()
+	is.Equal(ti.SelectedText(), "bär")
+
+	ti.CursorMoveEnd()
+	ti.CursorMoveWordLeft()
+	is.Equal(ti.edit.cursor, 9)
+}
+
+func TestTextInput_MultiClickSelection(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	ti.SetText("foo bar baz")
+
+	ti.selectWithClicks(5, 1)
+	is.Equal(ti.SelectedText(), "")
+	is.Equal(ti.edit.cursor, 5)
+
+	ti.selectWithClicks(5, 2)
+	is.Equal(ti.SelectedText(), "bar")
+
+	ti.selectWithClicks(5, 3)
+	is.Equal(ti.SelectedText(), "foo bar baz")
 }
 
 func newTextInput(t *testing.T, opts ...TextInputOpt) *TextInput {
@@ -21,8 +21,13 @@ type Theme struct {
 	TabTheme             *TabParams
 	TextInputTheme       *TextInputParams
 	TextAreaTheme        *TextAreaParams
+	TextEditorTheme      *TextEditorParams
 	ListTheme            *ListParams
 	ListComboButtonTheme *ListComboButtonParams
+	TableTheme           *TableParams
+	TreeViewTheme        *TreeViewParams
+	MenuTheme            *MenuParams
+	MenuBarTheme         *MenuBarParams
 }
 
 /*
@@ -8,6 +8,7 @@ import (
 
 	e_image "github.com/ebitenui/ebitenui/image"
 	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/clock"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 )
@@ -246,7 +247,7 @@ func (t *ToolTip) idleState() toolTipState {
 	}
 }
 
-func (t *ToolTip) armedState(p image.Point, timer *time.Timer, expired *atomic.Value) toolTipState {
+func (t *ToolTip) armedState(p image.Point, timer clock.Timer, expired *atomic.Value) toolTipState {
 	return func(parent *Widget) toolTipState {
 		x, y := input.CursorPosition()
 		cp := image.Point{x, y}
@@ -273,7 +274,7 @@ func (t *ToolTip) armedState(p image.Point, timer *time.Timer, expired *atomic.V
 			expired = &atomic.Value{}
 			expired.Store(false)
 
-			timer = time.AfterFunc(t.Delay, func() {
+			timer = clock.AfterFunc(t.Delay, func() {
 				expired.Store(true)
 			})
 
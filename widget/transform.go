@@ -0,0 +1,82 @@
+package widget
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// A RenderTransform changes how a widget and its children are drawn by their parent, without affecting
+// layout or input handling. It is usually animated, see the animation package.
+//
+// Transforms are applied by Containers to their children and by ScrollContainers to their content.
+// Only what a widget draws within its Rect is transformed. The root container of a UI has no parent
+// that draws it, so its Transform has no effect.
+type RenderTransform struct {
+	// OffsetX and OffsetY move the widget from the position its layout gave it.
+	OffsetX float64
+	OffsetY float64
+
+	// ScaleX and ScaleY scale the widget around the center of its Rect.
+	ScaleX float64
+	ScaleY float64
+
+	// Opacity is multiplied with the alpha of everything the widget draws, from 0 (invisible) to 1.
+	Opacity float64
+}
+
+// NewRenderTransform returns a RenderTransform that leaves the widget unchanged.
+func NewRenderTransform() *RenderTransform {
+	return &RenderTransform{
+		ScaleX:  1,
+		ScaleY:  1,
+		Opacity: 1,
+	}
+}
+
+// IsIdentity returns whether t leaves the widget unchanged.
+func (t *RenderTransform) IsIdentity() bool {
+	return t.OffsetX == 0 && t.OffsetY == 0 && t.ScaleX == 1 && t.ScaleY == 1 && t.Opacity == 1
+}
+
+// renderWithTransform renders r, which has the widget w, onto screen. If w has a RenderTransform,
+// r is rendered to an offscreen image the size of w's Rect first, which is then drawn transformed.
+// The offscreen image is kept for the next frame while w is transformed.
+func renderWithTransform(screen *ebiten.Image, w *Widget, r Renderer) {
+	t := w.Transform
+	if t == nil || t.IsIdentity() {
+		releaseTransformImage(w)
+		r.Render(screen)
+		return
+	}
+	if t.Opacity <= 0 || t.ScaleX == 0 || t.ScaleY == 0 || w.Rect.Empty() {
+		return
+	}
+
+	bounds := w.Rect
+	if w.transformImage == nil || w.transformImage.Bounds() != bounds {
+		releaseTransformImage(w)
+		w.transformImage = ebiten.NewImageWithOptions(bounds, nil)
+	} else {
+		w.transformImage.Clear()
+	}
+	r.Render(w.transformImage)
+
+	center := image.Pt((w.Rect.Min.X+w.Rect.Max.X)/2, (w.Rect.Min.Y+w.Rect.Max.Y)/2)
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(float64(bounds.Min.X-center.X), float64(bounds.Min.Y-center.Y))
+	opts.GeoM.Scale(t.ScaleX, t.ScaleY)
+	opts.GeoM.Translate(float64(center.X)+t.OffsetX, float64(center.Y)+t.OffsetY)
+	opts.ColorScale.ScaleAlpha(float32(min(t.Opacity, 1)))
+	opts.Filter = ebiten.FilterLinear
+	screen.DrawImage(w.transformImage, opts)
+}
+
+// releaseTransformImage deallocates the offscreen image of w, if it has one.
+func releaseTransformImage(w *Widget) {
+	if w.transformImage == nil {
+		return
+	}
+	w.transformImage.Deallocate()
+	w.transformImage = nil
+}
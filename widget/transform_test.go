@@ -0,0 +1,65 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestContainer_Render_Transform(t *testing.T) {
+	is := is.New(t)
+
+	var target *ebiten.Image
+	w := NewWidget()
+	m := controlMock{}
+	m.On("GetWidget").Maybe().Return(w)
+	m.On("PreferredSize").Maybe().Return(50, 50)
+	m.On("SetLocation", mock.Anything).Maybe().Run(func(args mock.Arguments) {
+		w.Rect = args.Get(0).(image.Rectangle)
+	})
+	m.On("Render", mock.Anything, mock.Anything).Maybe().Run(func(args mock.Arguments) {
+		target = args.Get(0).(*ebiten.Image)
+	})
+
+	c := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	c.AddChild(&m)
+
+	screen := ebiten.NewImage(100, 100)
+	c.Render(screen)
+	is.Equal(target, screen)
+
+	// A transformed widget is rendered offscreen first.
+	w.Transform = NewRenderTransform()
+	w.Transform.OffsetX = 10
+	c.Render(screen)
+	is.True(target != screen)
+	is.Equal(target.Bounds(), w.Rect)
+
+	// The offscreen image is reused, and released when the transform is reset.
+	offscreen := target
+	c.Render(screen)
+	is.Equal(target, offscreen)
+	w.Transform = nil
+	c.Render(screen)
+	is.Equal(target, screen)
+	is.Equal(w.transformImage, nil)
+
+	// Removing the widget releases the offscreen image as well.
+	w.Transform = NewRenderTransform()
+	w.Transform.OffsetX = 10
+	c.Render(screen)
+	is.True(w.transformImage != nil)
+	c.RemoveChild(&m)
+	is.Equal(w.transformImage, nil)
+	c.AddChild(&m)
+
+	// An invisible widget is not rendered at all.
+	target = nil
+	w.Transform.Opacity = 0
+	c.Render(screen)
+	is.Equal(target, nil)
+}
@@ -0,0 +1,884 @@
+package widget
+
+import (
+	img "image"
+	"image/color"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/constantutil"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/exp/slices"
+)
+
+type TreeViewParams struct {
+	// List configures the list that shows the nodes. Unset fields fall back to the theme's ListTheme.
+	List *ListParams
+
+	// Indent is how far each level of the tree is indented. It is also the width of the expand arrows.
+	Indent *int
+
+	// ExpandIcon and CollapseIcon are shown on collapsed and expanded nodes that have children.
+	// If they are not set, "+" and "-" are shown instead.
+	ExpandIcon   *GraphicImage
+	CollapseIcon *GraphicImage
+}
+
+// TreeViewChildrenFunc returns the children of node. It is called when node is expanded for the first time.
+type TreeViewChildrenFunc func(node any) []any
+
+// TreeViewHasChildrenFunc reports whether node has children, without loading them.
+type TreeViewHasChildrenFunc func(node any) bool
+
+type TreeViewLabelFunc func(node any) string
+
+// TreeViewDropPosition is where a node is dropped relative to the node below the cursor.
+type TreeViewDropPosition int
+
+const (
+	TreeViewDropBefore TreeViewDropPosition = iota
+	TreeViewDropInside
+	TreeViewDropAfter
+)
+
+type TreeView struct {
+	definedParams  TreeViewParams
+	computedParams TreeViewParams
+
+	NodeSelectedEvent    *event.Event
+	ExpandedChangedEvent *event.Event
+	NodeMovedEvent       *event.Event
+
+	widgetOpts      []WidgetOpt
+	listOpts        []ListOpt
+	roots           []any
+	childrenFunc    TreeViewChildrenFunc
+	hasChildrenFunc TreeViewHasChildrenFunc
+	labelFunc       TreeViewLabelFunc
+	canMoveFunc     TreeViewCanMoveFunc
+	draggable       bool
+
+	init      *MultiOnce
+	container *Container
+	list      *List
+	rows      map[any]*Container
+
+	children map[any][]any
+	parents  map[any]any
+	expanded map[any]bool
+	selected any
+
+	tabOrder int
+	focusMap map[FocusDirection]Focuser
+}
+
+type TreeViewOpt func(t *TreeView)
+
+// TreeViewCanMoveFunc reports whether node may be moved to index among the children of parent.
+// parent is nil for the roots.
+type TreeViewCanMoveFunc func(node any, parent any, index int) bool
+
+type TreeViewNodeSelectedEventArgs struct {
+	TreeView     *TreeView
+	Node         any
+	PreviousNode any
+}
+
+type TreeViewNodeSelectedHandlerFunc func(args *TreeViewNodeSelectedEventArgs)
+
+type TreeViewExpandedChangedEventArgs struct {
+	TreeView *TreeView
+	Node     any
+	Expanded bool
+}
+
+type TreeViewExpandedChangedHandlerFunc func(args *TreeViewExpandedChangedEventArgs)
+
+type TreeViewNodeMovedEventArgs struct {
+	TreeView *TreeView
+	Node     any
+	// OldParent and NewParent are nil for the roots.
+	OldParent any
+	NewParent any
+	// Index is the new position of Node among the children of NewParent.
+	Index int
+}
+
+type TreeViewNodeMovedHandlerFunc func(args *TreeViewNodeMovedEventArgs)
+
+// TreeViewDragData is the drag data of a node that is dragged in a TreeView.
+type TreeViewDragData struct {
+	TreeView *TreeView
+	Node     any
+}
+
+type TreeViewOptions struct {
+}
+
+var TreeViewOpts TreeViewOptions
+
+// NewTreeView constructs a new TreeView. A TreeView shows a hierarchy of nodes, of which only the
+// children of expanded nodes are visible. Children are loaded lazily with the ChildrenFunc when a node
+// is expanded for the first time. Nodes are selected, focused and scrolled like the entries of a List,
+// so like List entries, every node must be unique.
+func NewTreeView(opts ...TreeViewOpt) *TreeView {
+	t := &TreeView{
+		NodeSelectedEvent:    &event.Event{},
+		ExpandedChangedEvent: &event.Event{},
+		NodeMovedEvent:       &event.Event{},
+
+		init:     &MultiOnce{},
+		children: map[any][]any{},
+		parents:  map[any]any{},
+		expanded: map[any]bool{},
+		focusMap: make(map[FocusDirection]Focuser),
+	}
+
+	t.init.Append(t.createWidget)
+
+	for _, o := range opts {
+		o(t)
+	}
+
+	return t
+}
+
+func (t *TreeView) Validate() {
+	t.init.Do()
+	t.populateComputedParams()
+
+	if t.labelFunc == nil {
+		panic("TreeView: LabelFunc is required.")
+	}
+	if t.childrenFunc == nil {
+		panic("TreeView: ChildrenFunc is required.")
+	}
+
+	t.initWidget()
+}
+
+func (t *TreeView) populateComputedParams() {
+	params := TreeViewParams{}
+
+	theme := t.GetWidget().GetTheme()
+
+	// Set theme values
+	if theme != nil && theme.TreeViewTheme != nil {
+		params.List = theme.TreeViewTheme.List
+		params.Indent = theme.TreeViewTheme.Indent
+		params.ExpandIcon = theme.TreeViewTheme.ExpandIcon
+		params.CollapseIcon = theme.TreeViewTheme.CollapseIcon
+	}
+
+	// Set definedParam values
+	if t.definedParams.List != nil {
+		params.List = t.definedParams.List
+	}
+	if t.definedParams.Indent != nil {
+		params.Indent = t.definedParams.Indent
+	}
+	if t.definedParams.ExpandIcon != nil {
+		params.ExpandIcon = t.definedParams.ExpandIcon
+	}
+	if t.definedParams.CollapseIcon != nil {
+		params.CollapseIcon = t.definedParams.CollapseIcon
+	}
+
+	// Set defaults
+	if params.List == nil {
+		params.List = &ListParams{}
+	}
+	if params.Indent == nil {
+		params.Indent = constantutil.ConstantToPointer(16)
+	}
+
+	t.computedParams = params
+}
+
+func (o TreeViewOptions) WidgetOpts(opts ...WidgetOpt) TreeViewOpt {
+	return func(t *TreeView) {
+		t.widgetOpts = append(t.widgetOpts, opts...)
+	}
+}
+
+// ListOpts adds options to the list that shows the nodes, for example ListOpts.SliderParams.
+func (o TreeViewOptions) ListOpts(opts ...ListOpt) TreeViewOpt {
+	return func(t *TreeView) {
+		t.listOpts = append(t.listOpts, opts...)
+	}
+}
+
+// ListParams sets the parameters of the list that shows the nodes.
+func (o TreeViewOptions) ListParams(p *ListParams) TreeViewOpt {
+	return func(t *TreeView) {
+		t.definedParams.List = p
+	}
+}
+
+// Roots sets the nodes at the top level of the tree.
+func (o TreeViewOptions) Roots(roots ...any) TreeViewOpt {
+	return func(t *TreeView) {
+		t.roots = roots
+	}
+}
+
+func (o TreeViewOptions) ChildrenFunc(f TreeViewChildrenFunc) TreeViewOpt {
+	return func(t *TreeView) {
+		t.childrenFunc = f
+	}
+}
+
+// HasChildrenFunc decides which nodes show an expand arrow before their children have been loaded.
+// Without it, every node shows an arrow until it has been expanded and turned out to have no children.
+func (o TreeViewOptions) HasChildrenFunc(f TreeViewHasChildrenFunc) TreeViewOpt {
+	return func(t *TreeView) {
+		t.hasChildrenFunc = f
+	}
+}
+
+func (o TreeViewOptions) LabelFunc(f TreeViewLabelFunc) TreeViewOpt {
+	return func(t *TreeView) {
+		t.labelFunc = f
+	}
+}
+
+func (o TreeViewOptions) Indent(i int) TreeViewOpt {
+	return func(t *TreeView) {
+		t.definedParams.Indent = &i
+	}
+}
+
+func (o TreeViewOptions) Icons(expand *GraphicImage, collapse *GraphicImage) TreeViewOpt {
+	return func(t *TreeView) {
+		t.definedParams.ExpandIcon = expand
+		t.definedParams.CollapseIcon = collapse
+	}
+}
+
+// Draggable allows reordering nodes with drag and drop. Dropping a node on the upper or lower edge of
+// another node moves it before or after that node, dropping it on the middle makes it the last child.
+func (o TreeViewOptions) Draggable() TreeViewOpt {
+	return func(t *TreeView) {
+		t.draggable = true
+	}
+}
+
+// CanMoveFunc decides whether a node may be dropped at a place. By default, nodes may be dropped anywhere
+// except inside themselves.
+func (o TreeViewOptions) CanMoveFunc(f TreeViewCanMoveFunc) TreeViewOpt {
+	return func(t *TreeView) {
+		t.canMoveFunc = f
+	}
+}
+
+func (o TreeViewOptions) TabOrder(tabOrder int) TreeViewOpt {
+	return func(t *TreeView) {
+		t.tabOrder = tabOrder
+	}
+}
+
+func (o TreeViewOptions) NodeSelectedHandler(f TreeViewNodeSelectedHandlerFunc) TreeViewOpt {
+	return func(t *TreeView) {
+		t.NodeSelectedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TreeViewNodeSelectedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+func (o TreeViewOptions) ExpandedChangedHandler(f TreeViewExpandedChangedHandlerFunc) TreeViewOpt {
+	return func(t *TreeView) {
+		t.ExpandedChangedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TreeViewExpandedChangedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+// NodeMovedHandler adds a handler that is called after a node has been moved with drag and drop.
+// The handler should make the same change to the data the tree shows.
+func (o TreeViewOptions) NodeMovedHandler(f TreeViewNodeMovedHandlerFunc) TreeViewOpt {
+	return func(t *TreeView) {
+		t.NodeMovedEvent.AddHandler(func(args interface{}) {
+			if arg, ok := args.(*TreeViewNodeMovedEventArgs); ok {
+				f(arg)
+			}
+		})
+	}
+}
+
+/** Focuser Interface - Start **/
+
+func (t *TreeView) Focus(focused bool) {
+	t.init.Do()
+	t.GetWidget().FireFocusEvent(t, focused, img.Point{-1, -1})
+	if t.list != nil {
+		t.list.Focus(focused)
+	}
+}
+
+func (t *TreeView) IsFocused() bool {
+	return t.list != nil && t.list.IsFocused()
+}
+
+func (t *TreeView) TabOrder() int {
+	return t.tabOrder
+}
+
+func (t *TreeView) GetFocus(direction FocusDirection) Focuser {
+	return t.focusMap[direction]
+}
+
+func (t *TreeView) AddFocus(direction FocusDirection, focus Focuser) {
+	t.focusMap[direction] = focus
+}
+
+/** Focuser Interface - End **/
+
+// HandlesDirection reports that the arrow keys move between nodes and expand or collapse them,
+// unless the default keys have been disabled.
+func (t *TreeView) HandlesDirection(direction FocusDirection) bool {
+	if t.list == nil || *t.list.computedParams.DisableDefaultKeys {
+		return false
+	}
+	return direction == FOCUS_NORTH || direction == FOCUS_SOUTH || direction == FOCUS_WEST || direction == FOCUS_EAST
+}
+
+// Activate selects the focused node.
+func (t *TreeView) Activate() {
+	if t.list != nil {
+		t.list.Activate()
+	}
+}
+
+func (t *TreeView) FocusNext() {
+	if t.list != nil {
+		t.list.FocusNext()
+	}
+}
+
+func (t *TreeView) FocusPrevious() {
+	if t.list != nil {
+		t.list.FocusPrevious()
+	}
+}
+
+func (t *TreeView) SelectFocused() {
+	if t.list != nil {
+		t.list.SelectFocused()
+	}
+}
+
+func (t *TreeView) GetWidget() *Widget {
+	t.init.Do()
+	return t.container.GetWidget()
+}
+
+func (t *TreeView) PreferredSize() (int, int) {
+	t.init.Do()
+	return t.container.PreferredSize()
+}
+
+func (t *TreeView) SetLocation(rect img.Rectangle) {
+	t.init.Do()
+	t.container.SetLocation(rect)
+}
+
+func (t *TreeView) RequestRelayout() {
+	t.init.Do()
+	t.container.RequestRelayout()
+}
+
+func (t *TreeView) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
+	t.init.Do()
+	t.container.SetupInputLayer(def)
+}
+
+func (t *TreeView) Render(screen *ebiten.Image) {
+	t.init.Do()
+	t.container.Render(screen)
+}
+
+func (t *TreeView) Update(updObj *UpdateObject) {
+	t.init.Do()
+	t.handleInput()
+	t.container.Update(updObj)
+}
+
+// GetDropTargets implements Dropper, so that nodes can be dropped on other nodes.
+func (t *TreeView) GetDropTargets() []HasWidget {
+	t.init.Do()
+	result := t.container.GetDropTargets()
+	if t.list == nil {
+		return result
+	}
+	for _, node := range t.list.entries {
+		if row, ok := t.rows[node]; ok && row.GetWidget().drop != nil {
+			result = append(result, row)
+		}
+	}
+	return result
+}
+
+func (t *TreeView) handleInput() {
+	if t.list == nil || !t.list.focused || t.GetWidget().Disabled || *t.list.computedParams.DisableDefaultKeys {
+		return
+	}
+	index := t.list.focusIndex
+	if index < 0 || index >= len(t.list.entries) {
+		return
+	}
+	node := t.list.entries[index]
+
+	keymap := t.GetWidget().Keymap()
+	switch {
+	case keymap.KeyJustPressed(KeyActionLeft):
+		if t.expanded[node] {
+			t.Collapse(node)
+		} else if parent, ok := t.parents[node]; ok && parent != nil {
+			t.list.focusEntry(slices.Index(t.list.entries, parent))
+		}
+	case keymap.KeyJustPressed(KeyActionRight):
+		if !t.expanded[node] {
+			t.Expand(node)
+		} else if children := t.children[node]; len(children) > 0 {
+			t.list.focusEntry(index + 1)
+		}
+	}
+}
+
+func (t *TreeView) createWidget() {
+	t.container = NewContainer(
+		ContainerOpts.WidgetOpts(t.widgetOpts...),
+		ContainerOpts.Layout(NewGridLayout(
+			GridLayoutOpts.Columns(1),
+			GridLayoutOpts.Stretch([]bool{true}, []bool{true}),
+		)),
+		ContainerOpts.AutoDisableChildren(),
+	)
+	t.widgetOpts = nil
+}
+
+func (t *TreeView) initWidget() {
+	t.container.RemoveChildren()
+
+	if t.list != nil {
+		t.selected = t.list.SelectedEntry()
+	}
+	t.rows = map[any]*Container{}
+
+	t.list = NewList(
+		ListOpts.Entries(t.visibleNodes()),
+		ListOpts.EntryWidgetFunc(t.createRow),
+		ListOpts.EntrySelectedHandler(func(args *ListEntrySelectedEventArgs) {
+			t.selected = args.Entry
+			t.NodeSelectedEvent.Fire(&TreeViewNodeSelectedEventArgs{
+				TreeView:     t,
+				Node:         args.Entry,
+				PreviousNode: args.PreviousEntry,
+			})
+		}),
+	)
+	t.list.definedParams = *t.computedParams.List
+	t.list.entryFaceRequired = true
+	if t.list.definedParams.EntryTextHorizontalPosition == nil {
+		t.list.definedParams.EntryTextHorizontalPosition = constantutil.ConstantToPointer(TextPositionStart)
+	}
+	for _, o := range t.listOpts {
+		o(t.list)
+	}
+
+	// The list restores its selection when it is validated.
+	if t.selected != nil {
+		t.list.selectedEntry = t.selected
+		t.list.selected = map[any]bool{t.selected: true}
+	}
+	t.container.AddChild(t.list)
+	t.container.Validate()
+}
+
+// visibleNodes returns the roots and the children of the expanded nodes, in the order they are shown.
+func (t *TreeView) visibleNodes() []any {
+	var result []any
+	var add func(nodes []any)
+	add = func(nodes []any) {
+		for _, n := range nodes {
+			result = append(result, n)
+			if t.expanded[n] {
+				add(t.children[n])
+			}
+		}
+	}
+	add(t.roots)
+	return result
+}
+
+// createRow creates the widget of the row for node.
+func (t *TreeView) createRow(node any) PreferredSizeLocateableWidget {
+	indent := *t.computedParams.Indent
+	face := t.list.computedParams.EntryFace
+	textColor := t.list.computedParams.EntryColor.Unselected
+
+	rowOpts := []WidgetOpt{}
+	if t.draggable {
+		rowOpts = append(rowOpts,
+			WidgetOpts.EnableDragAndDrop(NewDragAndDrop(
+				DragAndDropOpts.ContentsCreater(&treeViewDragContents{tree: t}),
+				DragAndDropOpts.ContentsOriginVertical(DND_ANCHOR_START),
+				DragAndDropOpts.ContentsOriginHorizontal(DND_ANCHOR_START),
+			)),
+			WidgetOpts.CanDrop(func(args *DragAndDropDroppedEventArgs) bool {
+				parent, index, ok := t.dropTarget(args, node)
+				return ok && t.canMove(args.Data.(*TreeViewDragData).Node, parent, index)
+			}),
+			WidgetOpts.Dropped(func(args *DragAndDropDroppedEventArgs) {
+				if parent, index, ok := t.dropTarget(args, node); ok {
+					t.Move(args.Data.(*TreeViewDragData).Node, parent, index)
+				}
+			}),
+		)
+	}
+
+	row := NewContainer(
+		ContainerOpts.WidgetOpts(rowOpts...),
+		ContainerOpts.Layout(NewRowLayout(
+			RowLayoutOpts.Direction(DirectionHorizontal),
+			RowLayoutOpts.Padding(&Insets{Left: indent * t.depth(node)}),
+		)),
+	)
+
+	arrow := NewContainer(
+		ContainerOpts.WidgetOpts(
+			WidgetOpts.MinSize(indent, 0),
+			WidgetOpts.LayoutData(RowLayoutData{Stretch: true}),
+		),
+		ContainerOpts.Layout(NewAnchorLayout()),
+	)
+	if t.hasChildren(node) {
+		icon := t.computedParams.ExpandIcon
+		label := "+"
+		if t.expanded[node] {
+			icon = t.computedParams.CollapseIcon
+			label = "-"
+		}
+		buttonOpts := []ButtonOpt{
+			ButtonOpts.WidgetOpts(WidgetOpts.LayoutData(AnchorLayoutData{
+				HorizontalPosition: AnchorLayoutPositionCenter,
+				VerticalPosition:   AnchorLayoutPositionCenter,
+			})),
+			ButtonOpts.Image(&ButtonImage{
+				Idle:    image.NewNineSliceColor(color.Transparent),
+				Pressed: image.NewNineSliceColor(color.Transparent),
+			}),
+			ButtonOpts.ClickedHandler(func(_ *ButtonClickedEventArgs) {
+				t.SetExpanded(node, !t.expanded[node])
+			}),
+		}
+		if icon != nil {
+			buttonOpts = append(buttonOpts, ButtonOpts.Graphic(icon))
+		} else {
+			buttonOpts = append(buttonOpts, ButtonOpts.Text(label, face, &ButtonTextColor{Idle: textColor}))
+		}
+		arrow.AddChild(NewButton(buttonOpts...))
+	}
+	row.AddChild(arrow)
+
+	label := NewText(
+		TextOpts.WidgetOpts(WidgetOpts.LayoutData(RowLayoutData{Stretch: true})),
+		TextOpts.Text(t.labelFunc(node), face, textColor),
+		TextOpts.Position(*t.list.computedParams.EntryTextHorizontalPosition, *t.list.computedParams.EntryTextVerticalPosition),
+	)
+	label.listEntryColor = true
+	row.AddChild(label)
+
+	t.rows[node] = row
+	return row
+}
+
+// hasChildren reports whether node shows an expand arrow.
+func (t *TreeView) hasChildren(node any) bool {
+	if children, ok := t.children[node]; ok {
+		return len(children) > 0
+	}
+	if t.hasChildrenFunc != nil {
+		return t.hasChildrenFunc(node)
+	}
+	return true
+}
+
+func (t *TreeView) depth(node any) int {
+	d := 0
+	for p := t.parents[node]; p != nil; p = t.parents[p] {
+		d++
+	}
+	return d
+}
+
+// loadChildren calls the ChildrenFunc for node unless its children have already been loaded.
+func (t *TreeView) loadChildren(node any) []any {
+	if children, ok := t.children[node]; ok {
+		return children
+	}
+	children := t.childrenFunc(node)
+	t.children[node] = children
+	for _, c := range children {
+		t.parents[c] = node
+	}
+	return children
+}
+
+// Expand shows the children of node, loading them first if needed.
+func (t *TreeView) Expand(node any) {
+	t.SetExpanded(node, true)
+}
+
+// Collapse hides the children of node.
+func (t *TreeView) Collapse(node any) {
+	t.SetExpanded(node, false)
+}
+
+func (t *TreeView) SetExpanded(node any, expanded bool) {
+	t.init.Do()
+	if t.expanded[node] == expanded {
+		return
+	}
+	if expanded {
+		t.loadChildren(node)
+		t.expanded[node] = true
+	} else {
+		delete(t.expanded, node)
+	}
+	t.refresh()
+
+	t.ExpandedChangedEvent.Fire(&TreeViewExpandedChangedEventArgs{
+		TreeView: t,
+		Node:     node,
+		Expanded: expanded,
+	})
+}
+
+func (t *TreeView) IsExpanded(node any) bool {
+	return t.expanded[node]
+}
+
+// ExpandTo expands all ancestors of node that are known to the tree, so that node becomes visible.
+func (t *TreeView) ExpandTo(node any) {
+	for p := t.parents[node]; p != nil; p = t.parents[p] {
+		t.Expand(p)
+	}
+}
+
+// Reload forgets the children of node, so that they are loaded again by the ChildrenFunc.
+// If node is nil, the whole tree is reloaded and all nodes are collapsed.
+func (t *TreeView) Reload(node any) {
+	t.init.Do()
+	if node == nil {
+		t.children = map[any][]any{}
+		t.parents = map[any]any{}
+		t.expanded = map[any]bool{}
+	} else {
+		t.forget(node)
+		if t.expanded[node] {
+			t.loadChildren(node)
+		}
+	}
+	t.refresh()
+}
+
+func (t *TreeView) forget(node any) {
+	for _, c := range t.children[node] {
+		t.forget(c)
+		delete(t.parents, c)
+		delete(t.expanded, c)
+	}
+	delete(t.children, node)
+}
+
+// SetRoots replaces the nodes at the top level of the tree.
+func (t *TreeView) SetRoots(roots ...any) {
+	t.init.Do()
+	t.roots = roots
+	t.Reload(nil)
+}
+
+func (t *TreeView) Roots() []any {
+	return t.roots
+}
+
+// Parent returns the parent of node, or nil if node is a root or its parent has not been loaded.
+func (t *TreeView) Parent(node any) any {
+	return t.parents[node]
+}
+
+// Children returns the children of node if they have been loaded.
+func (t *TreeView) Children(node any) []any {
+	return t.children[node]
+}
+
+func (t *TreeView) SelectedNode() any {
+	t.init.Do()
+	if t.list == nil {
+		return t.selected
+	}
+	return t.list.SelectedEntry()
+}
+
+// SetSelectedNode selects node and expands its ancestors so that it is visible.
+func (t *TreeView) SetSelectedNode(node any) {
+	t.init.Do()
+	t.ExpandTo(node)
+	t.selected = node
+	if t.list != nil {
+		t.list.SetSelectedEntry(node)
+	}
+}
+
+// Move moves node to index among the children of parent, or among the roots if parent is nil,
+// and fires the NodeMovedEvent. Nothing happens if parent is node or one of its descendants.
+func (t *TreeView) Move(node any, parent any, index int) {
+	t.init.Do()
+	if t.isWithin(parent, node) {
+		return
+	}
+	oldParent := t.parents[node]
+	// The slices are cloned because they may belong to the ChildrenFunc or the caller of Roots.
+	siblings := slices.Clone(t.siblings(oldParent))
+	if i := slices.Index(siblings, node); i >= 0 {
+		siblings = slices.Delete(siblings, i, i+1)
+		if oldParent == parent && i < index {
+			index--
+		}
+	}
+	t.setSiblings(oldParent, siblings)
+
+	if parent != nil {
+		t.loadChildren(parent)
+	}
+	siblings = slices.Clone(t.siblings(parent))
+	index = max(0, min(index, len(siblings)))
+	siblings = slices.Insert(siblings, index, node)
+	t.setSiblings(parent, siblings)
+	if parent != nil {
+		t.parents[node] = parent
+	} else {
+		delete(t.parents, node)
+	}
+	t.refresh()
+
+	t.NodeMovedEvent.Fire(&TreeViewNodeMovedEventArgs{
+		TreeView:  t,
+		Node:      node,
+		OldParent: oldParent,
+		NewParent: parent,
+		Index:     index,
+	})
+}
+
+func (t *TreeView) siblings(parent any) []any {
+	if parent == nil {
+		return t.roots
+	}
+	return t.children[parent]
+}
+
+func (t *TreeView) setSiblings(parent any, siblings []any) {
+	if parent == nil {
+		t.roots = siblings
+	} else {
+		t.children[parent] = siblings
+	}
+}
+
+// List returns the list that shows the nodes. It is recreated when the tree is validated.
+func (t *TreeView) List() *List {
+	t.init.Do()
+	return t.list
+}
+
+// refresh recreates the rows after nodes have been expanded, collapsed or moved.
+func (t *TreeView) refresh() {
+	if t.list == nil || !t.list.validated {
+		return
+	}
+	t.rows = map[any]*Container{}
+	if t.list.virtualized {
+		// Recycled rows keep their widget while they show the same node, but its depth or arrow may have changed.
+		for k := range t.list.rowEntries {
+			t.list.rowEntries[k] = nil
+			t.list.rowContents[k].RemoveChildren()
+		}
+	}
+	t.list.replaceEntries(t.visibleNodes())
+}
+
+// dropTarget returns where a node that is dropped at the target position of args on the row of node ends up.
+func (t *TreeView) dropTarget(args *DragAndDropDroppedEventArgs, node any) (any, int, bool) {
+	data, ok := args.Data.(*TreeViewDragData)
+	if !ok || data.TreeView != t {
+		return nil, 0, false
+	}
+
+	parent := t.parents[node]
+	index := slices.Index(t.siblings(parent), node)
+	switch t.dropPosition(args, node) {
+	case TreeViewDropInside:
+		parent, index = node, len(t.loadChildren(node))
+	case TreeViewDropAfter:
+		index++
+	}
+	if t.isWithin(parent, data.Node) {
+		return nil, 0, false
+	}
+	return parent, index, true
+}
+
+// isWithin returns whether n is node or one of its descendants.
+func (t *TreeView) isWithin(n any, node any) bool {
+	for ; n != nil; n = t.parents[n] {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TreeView) dropPosition(args *DragAndDropDroppedEventArgs, node any) TreeViewDropPosition {
+	rect := t.rows[node].GetWidget().Rect
+	y := args.TargetY - rect.Min.Y
+	switch {
+	case y < rect.Dy()/4:
+		return TreeViewDropBefore
+	case y >= rect.Dy()-rect.Dy()/4:
+		return TreeViewDropAfter
+	}
+	return TreeViewDropInside
+}
+
+func (t *TreeView) canMove(node any, parent any, index int) bool {
+	return t.canMoveFunc == nil || t.canMoveFunc(node, parent, index)
+}
+
+// treeViewDragContents creates the widget that is shown while a node is dragged.
+type treeViewDragContents struct {
+	tree *TreeView
+}
+
+func (d *treeViewDragContents) Create(parent HasWidget) (*Container, interface{}) {
+	t := d.tree
+	for node, row := range t.rows {
+		if row != parent {
+			continue
+		}
+		c := NewContainer(
+			ContainerOpts.BackgroundImage(image.NewNineSliceColor(t.list.computedParams.EntryColor.SelectedBackground)),
+			ContainerOpts.Layout(NewAnchorLayout(AnchorLayoutOpts.Padding(t.list.computedParams.EntryTextPadding))),
+		)
+		c.AddChild(NewText(TextOpts.Text(t.labelFunc(node), t.list.computedParams.EntryFace, t.list.computedParams.EntryColor.Selected)))
+		return c, &TreeViewDragData{TreeView: t, Node: node}
+	}
+	return nil, nil
+}
@@ -0,0 +1,217 @@
+package widget
+
+import (
+	img "image"
+	"image/color"
+	"testing"
+
+	"github.com/ebitenui/ebitenui/event"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestTreeView_Expand(t *testing.T) {
+	is := is.New(t)
+
+	loads := map[any]int{}
+	var changes []*TreeViewExpandedChangedEventArgs
+	tree := newTreeView(t, loads, TreeViewOpts.ExpandedChangedHandler(func(args *TreeViewExpandedChangedEventArgs) {
+		changes = append(changes, args)
+	}))
+
+	is.Equal(tree.List().Entries(), []any{"a", "b"})
+	is.Equal(len(loads), 0)
+
+	tree.Expand("a")
+	event.ExecuteDeferred()
+	is.Equal(tree.List().Entries(), []any{"a", "a1", "a2", "b"})
+	is.Equal(tree.Parent("a1"), "a")
+	is.Equal(changes[0].Node, "a")
+	is.True(changes[0].Expanded)
+
+	row := tree.List().buttons[1].content.(*Container)
+	is.Equal(row.layout.(*RowLayout).padding.Left, 10)
+
+	tree.Collapse("a")
+	tree.Expand("a")
+	is.Equal(tree.List().Entries(), []any{"a", "a1", "a2", "b"})
+	is.Equal(loads["a"], 1)
+
+	// Leaves lose their arrow once they turn out to have no children.
+	tree.Expand("a1")
+	row = tree.List().buttons[1].content.(*Container)
+	is.Equal(len(row.Children()[0].(*Container).Children()), 0)
+}
+
+func TestTreeView_Keys(t *testing.T) {
+	is := is.New(t)
+
+	source := input.NewFakeSource()
+	input.SetSource(source)
+	t.Cleanup(func() {
+		input.SetSource(nil)
+	})
+	pressKey := func(tree *TreeView, k ebiten.Key) {
+		source.PressKey(k)
+		input.Update()
+		tree.Update(&UpdateObject{})
+		source.ReleaseKey(k)
+		input.Update()
+		event.ExecuteDeferred()
+	}
+
+	tree := newTreeView(t, map[any]int{})
+	tree.Focus(true)
+
+	pressKey(tree, ebiten.KeyRight)
+	is.True(tree.IsExpanded("a"))
+
+	pressKey(tree, ebiten.KeyRight)
+	is.Equal(tree.List().focusIndex, 1)
+
+	// Left on a collapsed child moves to its parent, then collapses the parent.
+	pressKey(tree, ebiten.KeyLeft)
+	is.Equal(tree.List().focusIndex, 0)
+	pressKey(tree, ebiten.KeyLeft)
+	is.True(!tree.IsExpanded("a"))
+	is.Equal(tree.List().Entries(), []any{"a", "b"})
+}
+
+func TestTreeView_Select(t *testing.T) {
+	is := is.New(t)
+
+	var selected *TreeViewNodeSelectedEventArgs
+	tree := newTreeView(t, map[any]int{}, TreeViewOpts.NodeSelectedHandler(func(args *TreeViewNodeSelectedEventArgs) {
+		selected = args
+	}))
+
+	tree.SetSelectedNode("b2")
+	event.ExecuteDeferred()
+	is.True(!tree.IsExpanded("b"))
+
+	tree.Expand("b")
+	tree.SetSelectedNode("b2")
+	event.ExecuteDeferred()
+	is.Equal(tree.SelectedNode(), "b2")
+	is.Equal(selected.Node, "b2")
+
+	// Collapsing and expanding other nodes keeps the selection.
+	tree.Expand("a")
+	is.Equal(tree.SelectedNode(), "b2")
+
+	// The label of the selected node takes the selected text color.
+	is.Equal(tree.rows["b2"].Children()[1].(*Text).computedParams.Color, color.Black)
+	is.Equal(tree.rows["b1"].Children()[1].(*Text).computedParams.Color, color.White)
+}
+
+func TestTreeView_Move(t *testing.T) {
+	is := is.New(t)
+
+	var moved *TreeViewNodeMovedEventArgs
+	tree := newTreeView(t, map[any]int{},
+		TreeViewOpts.Draggable(),
+		TreeViewOpts.NodeMovedHandler(func(args *TreeViewNodeMovedEventArgs) {
+			moved = args
+		}),
+	)
+	tree.Expand("a")
+	render(tree, t)
+	is.Equal(len(tree.GetDropTargets()), 4)
+
+	drop := func(source any, target any, y int) bool {
+		row := tree.rows[target]
+		args := &DragAndDropDroppedEventArgs{
+			Source:  tree.rows[source],
+			Target:  row,
+			TargetX: row.GetWidget().Rect.Min.X,
+			TargetY: row.GetWidget().Rect.Min.Y + y,
+			Data:    &TreeViewDragData{TreeView: tree, Node: source},
+		}
+		if !row.GetWidget().canDrop(args) {
+			return false
+		}
+		row.GetWidget().drop(args)
+		event.ExecuteDeferred()
+		return true
+	}
+
+	// A node cannot be dropped inside itself.
+	is.True(!drop("a", "a1", 10))
+
+	// Dropping on the lower edge moves after the target.
+	is.True(drop("a1", "a2", tree.rows["a2"].GetWidget().Rect.Dy()-1))
+	is.Equal(tree.Children("a"), []any{"a2", "a1"})
+	is.Equal(moved.Index, 1)
+	render(tree, t)
+
+	// Dropping on the middle moves inside the target.
+	is.True(drop("b", "a2", tree.rows["a2"].GetWidget().Rect.Dy()/2))
+	is.Equal(tree.Roots(), []any{"a"})
+	is.Equal(tree.Parent("b"), "a2")
+	is.Equal(moved.OldParent, nil)
+	is.Equal(moved.NewParent, "a2")
+	is.Equal(tree.List().Entries(), []any{"a", "a2", "a1"})
+
+	tree.Expand("a2")
+	is.Equal(tree.List().Entries(), []any{"a", "a2", "b", "a1"})
+
+	// A node cannot be moved into itself or its descendants.
+	moved = nil
+	tree.Move("a", "a", 0)
+	tree.Move("a", "b", 0)
+	event.ExecuteDeferred()
+	is.Equal(moved, nil)
+	is.Equal(tree.Roots(), []any{"a"})
+	is.Equal(tree.Parent("b"), "a2")
+	tree.ExpandTo("b")
+}
+
+func newTreeView(t *testing.T, loads map[any]int, opts ...TreeViewOpt) *TreeView {
+	t.Helper()
+
+	children := map[any][]any{
+		"a": {"a1", "a2"},
+		"b": {"b1", "b2"},
+	}
+
+	tree := NewTreeView(append([]TreeViewOpt{
+		TreeViewOpts.Roots("a", "b"),
+		TreeViewOpts.ChildrenFunc(func(node any) []any {
+			loads[node]++
+			return children[node]
+		}),
+		TreeViewOpts.LabelFunc(func(node any) string {
+			return node.(string)
+		}),
+		TreeViewOpts.Indent(10),
+		TreeViewOpts.ListParams(&ListParams{
+			EntryFace: loadFont(t),
+			EntryColor: &ListEntryColor{
+				Unselected:         color.White,
+				Selected:           color.Black,
+				DisabledUnselected: color.Gray{Y: 0x80},
+				DisabledSelected:   color.Gray{Y: 0x40},
+				SelectedBackground: color.Transparent,
+			},
+			ScrollContainerImage: &ScrollContainerImage{
+				Idle:     newNineSliceEmpty(t),
+				Disabled: newNineSliceEmpty(t),
+				Mask:     newNineSliceEmpty(t),
+			},
+			Slider: &SliderParams{
+				TrackImage: &SliderTrackImage{},
+				HandleImage: &ButtonImage{
+					Idle:    newNineSliceEmpty(t),
+					Pressed: newNineSliceEmpty(t),
+				},
+			},
+		}),
+	}, opts...)...)
+	event.ExecuteDeferred()
+
+	tree.SetLocation(img.Rect(0, 0, 200, 200))
+	render(tree, t)
+	render(tree, t)
+	return tree
+}
@@ -75,6 +75,27 @@ type Widget struct {
 	// the cursor is inside the widget's Rect.
 	ScrolledEvent *event.Event
 
+	// TapEvent fires an event with *WidgetTapEventArgs when the widget is tapped.
+	TapEvent *event.Event
+
+	// TouchLongPressEvent fires an event with *WidgetTouchLongPressEventArgs when a touch is held on the widget
+	// without moving.
+	TouchLongPressEvent *event.Event
+
+	// SwipeEvent fires an event with *WidgetSwipeEventArgs when a fast single-finger movement that started
+	// within the widget is released.
+	SwipeEvent *event.Event
+
+	// PanEvent fires an event with *WidgetPanEventArgs while one or two fingers that started within
+	// the widget are dragged, and while the movement continues after they are released. If a widget
+	// within this one also handles pans, such as a Slider or a ScrollContainer, only the innermost one
+	// gets them.
+	PanEvent *event.Event
+
+	// PinchEvent fires an event with *WidgetPinchEventArgs while two fingers that started within
+	// the widget are pinched.
+	PinchEvent *event.Event
+
 	FocusEvent *event.Event
 
 	ContextMenuEvent *event.Event
@@ -83,10 +104,25 @@ type Widget struct {
 
 	DragAndDropEvent *event.Event
 
+	// PopupEvent fires an event with *WidgetPopupEventArgs when a popup window such as a Menu is opened or closed.
+	PopupEvent *event.Event
+
 	OnUpdate UpdateFunc
 
 	// Custom Data is a field to allow users to attach data to any widget
 	CustomData any
+
+	// ID identifies the widget for FindByID. IDs are not required to be unique; FindByID returns
+	// the first match.
+	ID string
+
+	// Classes are free-form tags that can be used to find groups of widgets, see HasClass and FindAll.
+	Classes []string
+
+	// Transform changes how the widget is drawn by its parent without affecting layout or input.
+	// It is nil by default. See RenderTransform for which parents apply it.
+	Transform *RenderTransform
+
 	// This allows for non-focusable widgets (Containers) to report hover.
 	TrackHover bool
 
@@ -109,6 +145,7 @@ type Widget struct {
 	inputLayer                  *input.Layer
 	focusable                   Focuser
 	theme                       *Theme
+	keymap                      *Keymap
 	longPressButton             ebiten.MouseButton
 	longPressDuration           int
 	longPressCurrent            int
@@ -117,6 +154,9 @@ type Widget struct {
 	ContextMenuWindow    *Window
 	ContextMenuCloseMode WindowCloseMode
 
+	// PopupMenu is opened at the cursor when the widget is right-clicked.
+	PopupMenu *Menu
+
 	ToolTips []*ToolTip
 
 	DragAndDrop *DragAndDrop
@@ -126,6 +166,7 @@ type Widget struct {
 
 	relayoutParent bool
 	debugMode      bool
+	transformImage *ebiten.Image
 }
 
 // WidgetOpt is a function that configures w.
@@ -178,6 +219,30 @@ type Focuser interface {
 	AddFocus(direction FocusDirection, focus Focuser)
 }
 
+// Activator may be implemented by focusable widgets that can be triggered without a pointer,
+// for example by the gamepad's A button while the widget is focused.
+type Activator interface {
+	Activate()
+}
+
+// Canceler may be implemented by focusable widgets that can dismiss something they opened,
+// for example by the gamepad's B button. Cancel returns false if there was nothing to dismiss.
+type Canceler interface {
+	Cancel() bool
+}
+
+// DirectionalKeyHandler may be implemented by focusable widgets that use the arrow keys themselves.
+// While such a widget is focused, the UI does not move focus in the directions it handles.
+type DirectionalKeyHandler interface {
+	HandlesDirection(direction FocusDirection) bool
+}
+
+// TextReceiver may be implemented by focusable widgets that consume typed characters.
+// Shortcuts that are suppressed while typing do not fire while such a widget is focused.
+type TextReceiver interface {
+	ReceivesText() bool
+}
+
 type Dropper interface {
 	GetDropTargets() []HasWidget
 }
@@ -327,6 +392,11 @@ type WidgetToolTipEventArgs struct { //nolint:golint
 	Show   bool
 }
 
+type WidgetPopupEventArgs struct { //nolint:golint
+	Window *Window
+	Show   bool
+}
+
 type WidgetDragAndDropEventArgs struct { //nolint:golint
 	Window *Window
 	Show   bool
@@ -391,10 +461,16 @@ func NewWidget(opts ...WidgetOpt) *Widget {
 		MouseButtonReleasedEvent:    &event.Event{},
 		MouseButtonClickedEvent:     &event.Event{},
 		ScrolledEvent:               &event.Event{},
+		TapEvent:                    &event.Event{},
+		TouchLongPressEvent:         &event.Event{},
+		SwipeEvent:                  &event.Event{},
+		PanEvent:                    &event.Event{},
+		PinchEvent:                  &event.Event{},
 		FocusEvent:                  &event.Event{},
 		ContextMenuEvent:            &event.Event{},
 		ToolTipEvent:                &event.Event{},
 		DragAndDropEvent:            &event.Event{},
+		PopupEvent:                  &event.Event{},
 		ContextMenuCloseMode:        CLICK,
 		longPressDuration:           ebiten.TPS() / 2,
 		longPressButton:             -1,
@@ -510,6 +586,20 @@ func (o WidgetOptions) CustomData(cd any) WidgetOpt {
 	}
 }
 
+// ID sets the ID of the widget, which can be used to find it with FindByID.
+func (o WidgetOptions) ID(id string) WidgetOpt {
+	return func(w *Widget) {
+		w.ID = id
+	}
+}
+
+// Classes adds class tags to the widget, which can be used to find it with FindAll and HasClass.
+func (o WidgetOptions) Classes(classes ...string) WidgetOpt {
+	return func(w *Widget) {
+		w.Classes = append(w.Classes, classes...)
+	}
+}
+
 func (o WidgetOptions) MinSize(minWidth int, minHeight int) WidgetOpt {
 	return func(w *Widget) {
 		w.MinWidth = minWidth
@@ -529,6 +619,13 @@ func (o WidgetOptions) ContextMenuCloseMode(contextMenuCloseMode WindowCloseMode
 	}
 }
 
+// PopupMenu opens m at the cursor when the widget is right-clicked.
+func (o WidgetOptions) PopupMenu(m *Menu) WidgetOpt {
+	return func(w *Widget) {
+		w.PopupMenu = m
+	}
+}
+
 func (o WidgetOptions) ToolTip(toolTips ...*ToolTip) WidgetOpt {
 	return func(w *Widget) {
 		for _, tt := range toolTips {
@@ -614,6 +711,13 @@ func (o WidgetOptions) LongPressDuration(seconds float64) WidgetOpt {
 	}
 }
 
+// Keymap overrides the key bindings for this widget and its descendants.
+func (o WidgetOptions) Keymap(k *Keymap) WidgetOpt {
+	return func(w *Widget) {
+		w.keymap = k
+	}
+}
+
 func (w *Widget) drawImageOptions(opts *ebiten.DrawImageOptions) {
 	opts.GeoM.Translate(float64(w.Rect.Min.X), float64(w.Rect.Min.Y))
 }
@@ -674,6 +778,15 @@ func (w *Widget) SetVisibility(v Visibility) {
 	}
 }
 
+// SetMinSize changes the minimum size of the Widget
+func (w *Widget) SetMinSize(width int, height int) {
+	if w.MinWidth != width || w.MinHeight != height {
+		w.MinWidth = width
+		w.MinHeight = height
+		w.relayoutParent = true
+	}
+}
+
 // GetVisibility changes the visibility of the Widget
 func (w *Widget) GetVisibility() Visibility {
 	return w.visibility
@@ -745,6 +858,9 @@ func (w *Widget) fireEvents() {
 			if w.ContextMenu != nil {
 				w.FireContextMenuEvent(nil, p)
 			}
+			if w.PopupMenu != nil {
+				w.PopupMenu.openRoot(w, p)
+			}
 			w.longPressButton = ebiten.MouseButtonRight
 			w.longPressCurrent = 0
 		}
@@ -806,7 +922,8 @@ func (w *Widget) fireEvents() {
 			OffsetX: off.X,
 			OffsetY: off.Y,
 		})
-		if w.mouseLeftPressedInside && inside {
+		// A touch that became a pan or pinch, or a mouse drag that scrolled content, is not a click.
+		if w.mouseLeftPressedInside && inside && !internalinput.InputHandler.PointerConsumed {
 			w.MouseButtonClickedEvent.Fire(&WidgetMouseButtonClickedEventArgs{
 				Widget:  w,
 				Button:  ebiten.MouseButtonLeft,
@@ -838,6 +955,8 @@ func (w *Widget) fireEvents() {
 		}
 	}
 
+	w.fireGestureEvents(layer)
+
 	scrollX, scrollY := input.WheelLayer(layer)
 	if inside && (scrollX != 0 || scrollY != 0) {
 		w.ScrolledEvent.Fire(&WidgetScrolledEventArgs{
@@ -867,6 +986,29 @@ func (w *Widget) Parent() *Widget {
 	return w.parent
 }
 
+// HasClass returns whether class is one of the widget's Classes.
+func (w *Widget) HasClass(class string) bool {
+	for _, c := range w.Classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// Ancestors returns the widgets that contain w, starting with its parent and ending with the root
+// container. The internal widgets of composite widgets, such as the FlipBook inside a TabBook,
+// are included.
+func (w *Widget) Ancestors() []HasWidget {
+	var result []HasWidget
+	for p := w.parent; p != nil; p = p.parent {
+		if p.self != nil {
+			result = append(result, p.self)
+		}
+	}
+	return result
+}
+
 func (widget *Widget) FireFocusEvent(w Focuser, focused bool, location image.Point) { //nolint:golint
 	widget.FocusEvent.Fire(&WidgetFocusEventArgs{
 		Widget:   w,
@@ -894,6 +1036,13 @@ func (widget *Widget) FireToolTipEvent(w *Window, show bool) { //nolint:golint
 	})
 }
 
+func (widget *Widget) FirePopupEvent(w *Window, show bool) { //nolint:golint
+	widget.PopupEvent.Fire(&WidgetPopupEventArgs{
+		Window: w,
+		Show:   show,
+	})
+}
+
 func (widget *Widget) FireDragAndDropEvent(w *Window, show bool, dnd *DragAndDrop) { //nolint:golint
 	widget.DragAndDropEvent.Fire(&WidgetDragAndDropEventArgs{
 		Window: w,
@@ -964,3 +1113,27 @@ func (widget *Widget) GetTheme() *Theme {
 	}
 	return nil
 }
+
+// SetKeymap sets the key bindings for this widget and its descendants. If nil, the bindings of the
+// parent are used.
+func (widget *Widget) SetKeymap(keymap *Keymap) {
+	widget.keymap = keymap
+}
+
+// GetKeymap returns the key bindings of the widget or its nearest parent that has them set, or nil.
+func (widget *Widget) GetKeymap() *Keymap {
+	if widget.keymap != nil {
+		return widget.keymap
+	} else if widget.parent != nil {
+		return widget.parent.GetKeymap()
+	}
+	return nil
+}
+
+// Keymap returns the key bindings the widget reacts to, falling back to DefaultKeymap.
+func (widget *Widget) Keymap() *Keymap {
+	if k := widget.GetKeymap(); k != nil {
+		return k
+	}
+	return defaultKeymap
+}
@@ -7,9 +7,12 @@ import (
 	"log"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/ebitenui/ebitenui/event"
 	"github.com/ebitenui/ebitenui/image"
+	"github.com/ebitenui/ebitenui/input"
+	"github.com/ebitenui/ebitenui/utilities/clock"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
@@ -56,6 +59,24 @@ func (s *simpleWidget) Validate() {
 
 }
 
+// wrappingWidget is a simpleWidget that wraps its content like text: when narrowed, it keeps its area
+// by getting taller.
+type wrappingWidget struct {
+	*simpleWidget
+}
+
+func newWrappingWidget(preferredWidth int, preferredHeight int, ld interface{}) *wrappingWidget {
+	return &wrappingWidget{newSimpleWidget(preferredWidth, preferredHeight, ld)}
+}
+
+func (w *wrappingWidget) PreferredSizeFor(width int, _ int) (int, int) {
+	if width <= 0 || width >= w.preferredWidth {
+		return w.PreferredSize()
+	}
+	area := w.preferredWidth * w.preferredHeight
+	return width, (area + width - 1) / width
+}
+
 func loadFont(t *testing.T) *text.Face {
 	t.Helper()
 
@@ -135,3 +156,41 @@ func render(r ValidatedRenderer, t *testing.T) {
 	r.Render(screen)
 	event.ExecuteDeferred()
 }
+
+// useTouch sets a fake input source and clock for updating u. touch moves touch 0 to x, y and runs
+// a frame, frame releases the touch and runs a frame.
+func useTouch(t *testing.T, u Updater) (func(x int, y int), func()) {
+	t.Helper()
+
+	source, frame := useFakeInput(t, u)
+	return func(x int, y int) {
+			source.Touch(0, x, y)
+			frame()
+		}, func() {
+			source.ReleaseTouch(0)
+			frame()
+		}
+}
+
+// useFakeInput sets a fake input source and clock for updating u, and returns the source and a function
+// that updates u with the input of the source and advances the clock by a frame of 1/60s.
+func useFakeInput(t *testing.T, u Updater) (*input.FakeSource, func()) {
+	t.Helper()
+
+	source := input.NewFakeSource()
+	input.SetSource(source)
+	c := clock.NewFake(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	clock.Set(c)
+	t.Cleanup(func() {
+		input.SetSource(nil)
+		clock.Set(nil)
+	})
+
+	return source, func() {
+		input.Update()
+		u.Update(&UpdateObject{})
+		event.ExecuteDeferred()
+		input.AfterUpdate()
+		c.Advance(time.Second / 60)
+	}
+}
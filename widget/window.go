@@ -71,6 +71,9 @@ type Window struct {
 	blockLower     bool
 	originalSize   image.Point
 	init           *MultiOnce
+
+	// popup windows are ephemeral, but still block the input beneath them.
+	popup bool
 }
 
 type WindowOpt func(w *Window)
@@ -251,6 +254,16 @@ func (w *Window) Close() {
 	}
 }
 
+// IsPopup reports whether the window is a popup window, such as the window of a Menu.
+func (w *Window) IsPopup() bool {
+	return w.popup
+}
+
+// This method returns how the window closes itself when clicked.
+func (w *Window) GetCloseMode() WindowCloseMode {
+	return w.closeMode
+}
+
 // This method will set the size and location of this window.
 // This method will account for specified MinSize and MaxSize values.
 func (w *Window) SetLocation(rect image.Rectangle) {
@@ -309,7 +322,7 @@ func (w *Window) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
 	w.container.GetWidget().ElevateToNewInputLayer(&input.Layer{
 		DebugLabel: "window",
 		EventTypes: input.LayerEventTypeAll,
-		BlockLower: w.blockLower && !w.Ephemeral,
+		BlockLower: w.blockLower && (!w.Ephemeral || w.popup),
 		FullScreen: w.Modal,
 		RectFunc: func() image.Rectangle {
 			return w.container.GetWidget().Rect